@@ -3,9 +3,9 @@ package main
 import (
 	"context"
 	"flag"
-	"log"
 
 	"github.com/ipv02/chat-server/internal/app"
+	"github.com/ipv02/chat-server/internal/logger"
 )
 
 var configPath string
@@ -20,11 +20,11 @@ func main() {
 
 	a, err := app.NewApp(ctx, configPath)
 	if err != nil {
-		log.Fatalf("failed to init app: %s", err.Error())
+		logger.Fatal("failed to init app", logger.Err(err))
 	}
 
 	err = a.Run()
 	if err != nil {
-		log.Fatalf("failed to run app: %s", err.Error())
+		logger.Fatal("failed to run app", logger.Err(err))
 	}
 }
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// AddMembers запрос для добавления участников в чат.
+func (i *Implementation) AddMembers(ctx context.Context, req *chat_v1.AddMembersRequest) (*emptypb.Empty, error) {
+	err := i.chatService.AddMembers(ctx, converter.ToChatMembersFromAddReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "added members to chat", slog.Int64("chat_id", req.ChatId))
+
+	return &emptypb.Empty{}, nil
+}
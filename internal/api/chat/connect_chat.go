@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"log/slog"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ConnectChat запрос для получения новых сообщений чата в реальном времени.
+func (i *Implementation) ConnectChat(req *chat_v1.ConnectChatRequest, stream chat_v1.ChatV1_ConnectChatServer) error {
+	err := i.chatService.ConnectChat(stream.Context(), converter.ToChatConnectFromReq(req), func(message *model.Message) error {
+		return stream.Send(converter.ToMessageFromService(message))
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(stream.Context(), "disconnected from chat", slog.Int64("chat_id", req.ChatId))
+
+	return nil
+}
@@ -2,7 +2,7 @@ package chat
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
 	"github.com/ipv02/chat-server/internal/converter"
 	"github.com/ipv02/chat-server/pkg/chat_v1"
@@ -10,17 +10,15 @@ import (
 
 // CreateChat запрос для создания нового чата.
 func (i *Implementation) CreateChat(ctx context.Context, req *chat_v1.CreateChatRequest) (*chat_v1.CreateChatResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
+	chat := converter.ToChatCreateFromReq(req)
+	chat.IdempotencyKey = idempotencyKey(ctx, chat.IdempotencyKey)
 
-	id, err := i.chatService.CreateChat(ctx, converter.ToChatCreateFromReq(req))
+	id, err := i.chatService.CreateChat(ctx, chat)
 	if err != nil {
-		log.Printf("failed to create chat: %v", err)
 		return nil, err
 	}
 
-	log.Printf("created chat: %v", id)
+	slog.InfoContext(ctx, "created chat", slog.Int64("chat_id", id))
 
 	return &chat_v1.CreateChatResponse{
 		Id: id,
@@ -2,25 +2,21 @@ package chat
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
+	"github.com/ipv02/chat-server/internal/converter"
 	"github.com/ipv02/chat-server/pkg/chat_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // DeleteChat запрос для удаления чата.
 func (i *Implementation) DeleteChat(ctx context.Context, req *chat_v1.DeleteChatRequest) (*emptypb.Empty, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
-
-	err := i.chatService.DeleteChat(ctx, req.Id)
+	err := i.chatService.DeleteChat(ctx, converter.ToChatDeleteFromReq(req))
 	if err != nil {
-		log.Printf("failed to delete chat: %v", err)
 		return nil, err
 	}
 
-	log.Printf("deleted chat: %v", req.Id)
+	slog.InfoContext(ctx, "deleted chat", slog.Int64("chat_id", req.Id))
 
 	return &emptypb.Empty{}, nil
 }
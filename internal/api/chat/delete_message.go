@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// DeleteMessage запрос для удаления сообщения.
+func (i *Implementation) DeleteMessage(ctx context.Context, req *chat_v1.DeleteMessageRequest) (*emptypb.Empty, error) {
+	err := i.chatService.DeleteMessage(ctx, converter.ToMessageDeleteFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "deleted message", slog.Int64("message_id", req.MessageId))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// EditMessage запрос для редактирования сообщения.
+func (i *Implementation) EditMessage(ctx context.Context, req *chat_v1.EditMessageRequest) (*emptypb.Empty, error) {
+	err := i.chatService.EditMessage(ctx, converter.ToMessageEditFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "edited message", slog.Int64("message_id", req.MessageId))
+
+	return &emptypb.Empty{}, nil
+}
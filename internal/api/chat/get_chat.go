@@ -0,0 +1,20 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// GetChat запрос для получения чата с участниками и последним сообщением.
+func (i *Implementation) GetChat(ctx context.Context, req *chat_v1.GetChatRequest) (*chat_v1.GetChatResponse, error) {
+	chat, err := i.chatService.GetChat(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chat_v1.GetChatResponse{
+		Chat: converter.ToChatFromService(chat),
+	}, nil
+}
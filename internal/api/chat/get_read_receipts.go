@@ -0,0 +1,18 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// GetReadReceipts запрос для получения участников, прочитавших сообщение.
+func (i *Implementation) GetReadReceipts(ctx context.Context, req *chat_v1.GetReadReceiptsRequest) (*chat_v1.GetReadReceiptsResponse, error) {
+	receipts, err := i.chatService.GetReadReceipts(ctx, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToGetReadReceiptsResponseFromService(receipts), nil
+}
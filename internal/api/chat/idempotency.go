@@ -0,0 +1,29 @@
+package chat
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// idempotencyKeyHeader метаданные, в которых клиент может передать ключ идемпотентности вместо поля запроса
+const idempotencyKeyHeader = "idempotency-key"
+
+// idempotencyKey возвращает ключ идемпотентности из запроса, а если он не задан - из входящих метаданных
+func idempotencyKey(ctx context.Context, fromReq string) string {
+	if fromReq != "" {
+		return fromReq
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(idempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// LeaveChat запрос для выхода пользователя из чата.
+func (i *Implementation) LeaveChat(ctx context.Context, req *chat_v1.LeaveChatRequest) (*emptypb.Empty, error) {
+	err := i.chatService.LeaveChat(ctx, converter.ToChatLeaveFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "left chat", slog.Int64("chat_id", req.ChatId))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ListChats запрос для получения чатов пользователя.
+func (i *Implementation) ListChats(ctx context.Context, req *chat_v1.ListChatsRequest) (*chat_v1.ListChatsResponse, error) {
+	filter, err := converter.ToChatListFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := i.chatService.ListChats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToListChatsResponseFromService(list), nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ListMembers запрос для получения участников чата.
+func (i *Implementation) ListMembers(ctx context.Context, req *chat_v1.ListMembersRequest) (*chat_v1.ListMembersResponse, error) {
+	filter, err := converter.ToMemberListFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := i.chatService.ListMembers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToListMembersResponseFromService(list), nil
+}
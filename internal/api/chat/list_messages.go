@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ListMessages запрос для получения истории сообщений чата.
+func (i *Implementation) ListMessages(ctx context.Context, req *chat_v1.ListMessagesRequest) (*chat_v1.ListMessagesResponse, error) {
+	filter, err := converter.ToMessageListFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := i.chatService.ListMessages(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToListMessagesResponseFromService(list), nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ListThread запрос для получения ответов в треде.
+func (i *Implementation) ListThread(ctx context.Context, req *chat_v1.ListThreadRequest) (*chat_v1.ListThreadResponse, error) {
+	filter, err := converter.ToThreadFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := i.chatService.ListThread(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToListThreadResponseFromService(thread), nil
+}
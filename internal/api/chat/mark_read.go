@@ -0,0 +1,20 @@
+package chat
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// MarkRead запрос для отметки сообщений чата прочитанными.
+func (i *Implementation) MarkRead(ctx context.Context, req *chat_v1.MarkReadRequest) (*emptypb.Empty, error) {
+	err := i.chatService.MarkRead(ctx, converter.ToChatReadFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// PinMessage запрос для закрепления или открепления сообщения.
+func (i *Implementation) PinMessage(ctx context.Context, req *chat_v1.PinMessageRequest) (*emptypb.Empty, error) {
+	err := i.chatService.PinMessage(ctx, converter.ToMessagePinFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "pinned message", slog.Int64("message_id", req.MessageId), slog.Bool("pinned", req.Pinned))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// RemoveMembers запрос для удаления участников из чата.
+func (i *Implementation) RemoveMembers(ctx context.Context, req *chat_v1.RemoveMembersRequest) (*emptypb.Empty, error) {
+	err := i.chatService.RemoveMembers(ctx, converter.ToChatMembersFromRemoveReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "removed members from chat", slog.Int64("chat_id", req.ChatId))
+
+	return &emptypb.Empty{}, nil
+}
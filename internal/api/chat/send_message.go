@@ -2,7 +2,7 @@ package chat
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -12,17 +12,16 @@ import (
 
 // SendMessage запрос для отправки сообщения в чат.
 func (i *Implementation) SendMessage(ctx context.Context, req *chat_v1.SendMessageRequest) (*emptypb.Empty, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
+	chat := converter.ToChatSendMessage(req)
+	chat.IdempotencyKey = idempotencyKey(ctx, chat.IdempotencyKey)
 
-	err := i.chatService.SendMessage(ctx, converter.ToChatSendMessage(req))
+	err := i.chatService.SendMessage(ctx, chat)
 	if err != nil {
-		log.Printf("failed to send message: %v", err)
 		return nil, err
 	}
 
-	log.Printf("sent message: %v", req)
+	// Текст сообщения в лог не пишется
+	slog.InfoContext(ctx, "sent message", slog.Int64("chat_id", req.ChatId))
 
 	return &emptypb.Empty{}, nil
 }
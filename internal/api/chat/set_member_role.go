@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// SetMemberRole запрос для изменения роли участника чата.
+func (i *Implementation) SetMemberRole(ctx context.Context, req *chat_v1.SetMemberRoleRequest) (*emptypb.Empty, error) {
+	err := i.chatService.SetMemberRole(ctx, converter.ToChatMemberRoleFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "set member role",
+		slog.Int64("chat_id", req.ChatId), slog.String("member_id", req.MemberId), slog.String("role", req.Role.String()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/service"
+	serviceMocks "github.com/ipv02/chat-server/internal/service/mocks"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+type connectChatStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*chat_v1.Message
+}
+
+func (s *connectChatStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectChatStream) Send(message *chat_v1.Message) error {
+	s.sent = append(s.sent, message)
+	return nil
+}
+
+func TestConnectChat(t *testing.T) {
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		userID    = gofakeit.Name()
+		createdAt = gofakeit.Date()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &chat_v1.ConnectChatRequest{
+			ChatId: chatID,
+			UserId: userID,
+		}
+
+		serviceReq = &model.ChatConnect{
+			ChatID: chatID,
+			UserID: userID,
+		}
+
+		message = &model.Message{
+			ID:        gofakeit.Int64(),
+			ChatID:    chatID,
+			From:      gofakeit.Name(),
+			Text:      gofakeit.City(),
+			CreatedAt: createdAt,
+		}
+
+		sent = []*chat_v1.Message{
+			{
+				Id:        message.ID,
+				ChatId:    chatID,
+				From:      message.From,
+				Text:      message.Text,
+				CreatedAt: timestamppb.New(createdAt),
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		want            []*chat_v1.Message
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: sent,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ConnectChatMock.Set(func(_ context.Context, chat *model.ChatConnect, send func(message *model.Message) error) error {
+					require.Equal(t, serviceReq, chat)
+					return send(message)
+				})
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ConnectChatMock.Set(func(_ context.Context, _ *model.ChatConnect, _ func(message *model.Message) error) error {
+					return serviceErr
+				})
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatServiceMock := tt.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			stream := &connectChatStream{ctx: ctx}
+			err := api.ConnectChat(req, stream)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, stream.sent)
+		})
+	}
+}
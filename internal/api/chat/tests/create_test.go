@@ -9,6 +9,7 @@ import (
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/ipv02/chat-server/internal/api/chat"
 	"github.com/ipv02/chat-server/internal/model"
@@ -33,23 +34,36 @@ func TestCreate(t *testing.T) {
 		usersID = []string{
 			strconv.FormatInt(gofakeit.Int64(), 10),
 		}
-		chatName = gofakeit.Name()
+		chatName  = gofakeit.Name()
+		creatorID = strconv.FormatInt(gofakeit.Int64(), 10)
 
 		serviceErr = fmt.Errorf("service error")
 
 		req = &chat_v1.CreateChatRequest{
-			UsersId:  usersID,
-			ChatName: chatName,
+			UsersId:   usersID,
+			ChatName:  chatName,
+			CreatorId: creatorID,
 		}
 
 		serviceReq = &model.ChatCreate{
-			UsersID:  usersID,
-			ChatName: chatName,
+			UsersID:   usersID,
+			ChatName:  chatName,
+			CreatorID: creatorID,
 		}
 
 		res = &chat_v1.CreateChatResponse{
 			Id: id,
 		}
+
+		idempotencyKey = gofakeit.UUID()
+		metadataCtx    = metadata.NewIncomingContext(ctx, metadata.Pairs("idempotency-key", idempotencyKey))
+
+		idempotentServiceReq = &model.ChatCreate{
+			UsersID:        usersID,
+			ChatName:       chatName,
+			CreatorID:      creatorID,
+			IdempotencyKey: idempotencyKey,
+		}
 	)
 
 	tests := []struct {
@@ -73,6 +87,39 @@ func TestCreate(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "idempotency key in request case",
+			args: args{
+				ctx: metadataCtx,
+				req: &chat_v1.CreateChatRequest{
+					UsersId:        usersID,
+					ChatName:       chatName,
+					CreatorId:      creatorID,
+					IdempotencyKey: idempotencyKey,
+				},
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.CreateChatMock.Expect(metadataCtx, idempotentServiceReq).Return(id, nil)
+				return mock
+			},
+		},
+		{
+			name: "idempotency key in metadata case",
+			args: args{
+				ctx: metadataCtx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.CreateChatMock.Expect(metadataCtx, idempotentServiceReq).Return(id, nil)
+				return mock
+			},
+		},
 		{
 			name: "service error case",
 			args: args{
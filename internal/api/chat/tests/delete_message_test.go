@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/service"
+	serviceMocks "github.com/ipv02/chat-server/internal/service/mocks"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+func TestDeleteMessage(t *testing.T) {
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *chat_v1.DeleteMessageRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		messageID = int64(gofakeit.Number(1, 1000))
+		userID    = gofakeit.Name()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &chat_v1.DeleteMessageRequest{
+			MessageId: messageID,
+			UserId:    userID,
+		}
+
+		serviceReq = &model.MessageDelete{
+			MessageID: messageID,
+			UserID:    userID,
+		}
+
+		res = &emptypb.Empty{}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *emptypb.Empty
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, serviceReq).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.DeleteMessageMock.Expect(ctx, serviceReq).Return(serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatServiceMock := tt.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.DeleteMessage(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
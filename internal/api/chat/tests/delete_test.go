@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/model"
 	"github.com/ipv02/chat-server/internal/service"
 	serviceMocks "github.com/ipv02/chat-server/internal/service/mocks"
 	"github.com/ipv02/chat-server/pkg/chat_v1"
@@ -27,12 +29,19 @@ func TestDelete(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		id = int64(123) // gofakeit.Int64()
+		id     = int64(gofakeit.Number(1, 1000))
+		userID = gofakeit.Name()
 
 		serviceErr = fmt.Errorf("service error")
 
 		req = &chat_v1.DeleteChatRequest{
-			Id: id,
+			Id:     id,
+			UserId: userID,
+		}
+
+		serviceReq = &model.ChatDelete{
+			ID:     id,
+			UserID: userID,
 		}
 
 		res = &emptypb.Empty{}
@@ -55,7 +64,7 @@ func TestDelete(t *testing.T) {
 			err:  nil,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.DeleteChatMock.Expect(ctx, id).Return(nil)
+				mock.DeleteChatMock.Expect(ctx, serviceReq).Return(nil)
 				return mock
 			},
 		},
@@ -69,7 +78,7 @@ func TestDelete(t *testing.T) {
 			err:  serviceErr,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.DeleteChatMock.Expect(ctx, id).Return(serviceErr)
+				mock.DeleteChatMock.Expect(ctx, serviceReq).Return(serviceErr)
 				return mock
 			},
 		},
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/service"
+	serviceMocks "github.com/ipv02/chat-server/internal/service/mocks"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+func TestGetChat(t *testing.T) {
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *chat_v1.GetChatRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID        = int64(gofakeit.Number(1, 1000))
+		chatName      = gofakeit.Name()
+		userID        = gofakeit.Name()
+		text          = gofakeit.City()
+		createdAt     = gofakeit.Date().UTC()
+		lastMessageAt = createdAt.Add(time.Hour)
+		messageID     = int64(gofakeit.Number(1, 1000))
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &chat_v1.GetChatRequest{
+			Id: chatID,
+		}
+
+		serviceRes = &model.Chat{
+			ID:   chatID,
+			Name: chatName,
+			Members: []*model.Member{
+				{UserID: userID, Role: model.RoleOwner},
+			},
+			CreatedAt:     createdAt,
+			UpdatedAt:     lastMessageAt,
+			LastMessageAt: &lastMessageAt,
+			LastMessage: &model.Message{
+				ID:        messageID,
+				ChatID:    chatID,
+				From:      userID,
+				Text:      text,
+				CreatedAt: lastMessageAt,
+			},
+		}
+
+		res = &chat_v1.GetChatResponse{
+			Chat: &chat_v1.Chat{
+				Id:   chatID,
+				Name: chatName,
+				Members: []*chat_v1.Member{
+					{UserId: userID, Role: chat_v1.Role_ROLE_OWNER},
+				},
+				CreatedAt:     timestamppb.New(createdAt),
+				UpdatedAt:     timestamppb.New(lastMessageAt),
+				LastMessageAt: timestamppb.New(lastMessageAt),
+				LastMessage: &chat_v1.Message{
+					Id:        messageID,
+					ChatId:    chatID,
+					From:      userID,
+					Text:      text,
+					CreatedAt: timestamppb.New(lastMessageAt),
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *chat_v1.GetChatResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetChatMock.Expect(ctx, chatID).Return(serviceRes, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetChatMock.Expect(ctx, chatID).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatServiceMock := tt.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.GetChat(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/service"
+	serviceMocks "github.com/ipv02/chat-server/internal/service/mocks"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+func TestListChats(t *testing.T) {
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *chat_v1.ListChatsRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		chatName  = gofakeit.Name()
+		userID    = gofakeit.Name()
+		createdAt = gofakeit.Date().UTC()
+
+		cursor = &model.ChatCursor{
+			LastActivityAt: createdAt,
+			ID:             chatID,
+		}
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &chat_v1.ListChatsRequest{
+			UserId:   userID,
+			PageSize: 1,
+			Cursor:   converter.EncodeChatCursor(cursor),
+		}
+
+		serviceReq = &model.ChatListFilter{
+			UserID: userID,
+			Limit:  1,
+			Cursor: cursor,
+		}
+
+		serviceRes = &model.ChatList{
+			Chats: []*model.Chat{
+				{
+					ID:          chatID,
+					Name:        chatName,
+					CreatedAt:   createdAt,
+					UpdatedAt:   createdAt,
+					UnreadCount: 2,
+				},
+			},
+			NextCursor: cursor,
+		}
+
+		res = &chat_v1.ListChatsResponse{
+			Chats: []*chat_v1.Chat{
+				{
+					Id:          chatID,
+					Name:        chatName,
+					CreatedAt:   timestamppb.New(createdAt),
+					UpdatedAt:   timestamppb.New(createdAt),
+					UnreadCount: 2,
+				},
+			},
+			NextCursor: converter.EncodeChatCursor(cursor),
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *chat_v1.ListChatsResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListChatsMock.Expect(ctx, serviceReq).Return(serviceRes, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListChatsMock.Expect(ctx, serviceReq).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatServiceMock := tt.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.ListChats(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
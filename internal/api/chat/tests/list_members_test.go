@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/service"
+	serviceMocks "github.com/ipv02/chat-server/internal/service/mocks"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+func TestListMembers(t *testing.T) {
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *chat_v1.ListMembersRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = int64(gofakeit.Number(1, 1000))
+		userID = strconv.FormatInt(gofakeit.Int64(), 10)
+
+		cursor = &model.MemberCursor{
+			UserID: userID,
+		}
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &chat_v1.ListMembersRequest{
+			ChatId:   chatID,
+			PageSize: 1,
+			Cursor:   converter.EncodeMemberCursor(cursor),
+		}
+
+		serviceReq = &model.MemberListFilter{
+			ChatID: chatID,
+			Limit:  1,
+			Cursor: cursor,
+		}
+
+		serviceRes = &model.MemberList{
+			Members: []*model.Member{
+				{UserID: userID},
+			},
+			NextCursor: cursor,
+		}
+
+		res = &chat_v1.ListMembersResponse{
+			Members: []*chat_v1.Member{
+				{UserId: userID},
+			},
+			NextCursor: converter.EncodeMemberCursor(cursor),
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *chat_v1.ListMembersResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListMembersMock.Expect(ctx, serviceReq).Return(serviceRes, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid cursor case",
+			args: args{
+				ctx: ctx,
+				req: &chat_v1.ListMembersRequest{
+					ChatId:   chatID,
+					PageSize: 1,
+					Cursor:   base64.RawURLEncoding.EncodeToString([]byte("1 or 1=1")),
+				},
+			},
+			want: nil,
+			err:  converter.ErrInvalidCursor,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListMembersMock.Expect(ctx, serviceReq).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatServiceMock := tt.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.ListMembers(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/service"
+	serviceMocks "github.com/ipv02/chat-server/internal/service/mocks"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+func TestListMessages(t *testing.T) {
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	type args struct {
+		ctx context.Context
+		req *chat_v1.ListMessagesRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		messageID = int64(gofakeit.Number(1, 1000))
+		from      = gofakeit.Name()
+		text      = gofakeit.City()
+		createdAt = gofakeit.Date().UTC()
+
+		cursor = &model.MessageCursor{
+			CreatedAt: createdAt,
+			ID:        messageID,
+		}
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &chat_v1.ListMessagesRequest{
+			ChatId:   chatID,
+			PageSize: 1,
+			Cursor:   converter.EncodeMessageCursor(cursor),
+		}
+
+		serviceReq = &model.MessageListFilter{
+			ChatID: chatID,
+			Limit:  1,
+			Cursor: cursor,
+		}
+
+		serviceRes = &model.MessageList{
+			Messages: []*model.Message{
+				{
+					ID:        messageID,
+					ChatID:    chatID,
+					From:      from,
+					Text:      text,
+					CreatedAt: createdAt,
+				},
+			},
+			NextCursor: cursor,
+		}
+
+		res = &chat_v1.ListMessagesResponse{
+			Messages: []*chat_v1.Message{
+				{
+					Id:        messageID,
+					ChatId:    chatID,
+					From:      from,
+					Text:      text,
+					CreatedAt: timestamppb.New(createdAt),
+				},
+			},
+			NextCursor: converter.EncodeMessageCursor(cursor),
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *chat_v1.ListMessagesResponse
+		err             error
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListMessagesMock.Expect(ctx, serviceReq).Return(serviceRes, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  serviceErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.ListMessagesMock.Expect(ctx, serviceReq).Return(nil, serviceErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatServiceMock := tt.chatServiceMock(mc)
+			api := chat.NewImplementation(chatServiceMock)
+
+			res, err := api.ListMessages(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
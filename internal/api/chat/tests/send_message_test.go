@@ -30,22 +30,28 @@ func TestSendMessage(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
+		chatID    = int64(gofakeit.Number(1, 1000))
 		from      = gofakeit.Name()
 		text      = gofakeit.City()
-		timestamp = gofakeit.Date()
+		timestamp = timestamppb.New(gofakeit.Date())
+		replyToID = int64(gofakeit.Number(1, 1000))
 
 		serviceErr = fmt.Errorf("service error")
 
 		req = &chat_v1.SendMessageRequest{
-			From:      from,
-			Text:      text,
-			Timestamp: timestamppb.New(timestamp),
+			ChatId:           chatID,
+			From:             from,
+			Text:             text,
+			Timestamp:        timestamp,
+			ReplyToMessageId: replyToID,
 		}
 
 		serviceReq = &model.ChatSendMessage{
-			From:      from,
-			Text:      text,
-			Timestamp: timestamppb.New(timestamp),
+			ChatID:           chatID,
+			From:             from,
+			Text:             text,
+			Timestamp:        timestamp,
+			ReplyToMessageID: replyToID,
 		}
 
 		res = &emptypb.Empty{}
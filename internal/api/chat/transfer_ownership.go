@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/converter"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// TransferOwnership запрос для передачи владения чатом другому участнику.
+func (i *Implementation) TransferOwnership(ctx context.Context, req *chat_v1.TransferOwnershipRequest) (*emptypb.Empty, error) {
+	err := i.chatService.TransferOwnership(ctx, converter.ToChatOwnershipTransferFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "transferred chat ownership",
+		slog.Int64("chat_id", req.ChatId), slog.String("new_owner_id", req.NewOwnerId))
+
+	return &emptypb.Empty{}, nil
+}
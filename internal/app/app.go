@@ -2,23 +2,45 @@ package app
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net"
+	"net/http"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/ipv02/chat-server/internal/closer"
 	"github.com/ipv02/chat-server/internal/config"
+	"github.com/ipv02/chat-server/internal/gateway"
+	"github.com/ipv02/chat-server/internal/interceptor"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/metric"
+	"github.com/ipv02/chat-server/internal/tracing"
 	"github.com/ipv02/chat-server/pkg/chat_v1"
 )
 
+const (
+	httpReadHeaderTimeout    = 5 * time.Second
+	metricsReadHeaderTimeout = 5 * time.Second
+
+	// idempotencyCleanupBatchSize сколько просроченных ключей идемпотентности удаляется одним запросом
+	idempotencyCleanupBatchSize = 1000
+)
+
 // App представляет приложение с конфигурационным файлом, провайдером и сервером
 type App struct {
 	configPath      string
 	serviceProvider *serviceProvider
 	grpcServer      *grpc.Server
+	httpServer      *http.Server
+	metricsServer   *http.Server
 }
 
 // NewApp создает новый экземпляр App, инициализируя зависимости
@@ -33,21 +55,47 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	return a, nil
 }
 
-// Run запускает gRPC сервер и гарантирует закрытие всех ресурсов при завершении работы
+// Run запускает серверы и фоновые обработчики и возвращает управление после остановки.
+// Остановку запускает SIGINT/SIGTERM, ресурсы освобождаются по фазам closer.
 func (a *App) Run() error {
-	defer func() {
-		closer.CloseAll()
-		closer.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a.runBackgroundWorkers(ctx, cancel)
+
+	go func() {
+		err := a.runHTTPServer()
+		if err != nil {
+			logger.Fatal("failed to run http server", logger.Err(err))
+		}
 	}()
 
-	return a.runGRPCServer()
+	go func() {
+		err := a.runMetricsServer()
+		if err != nil {
+			logger.Fatal("failed to run metrics server", logger.Err(err))
+		}
+	}()
+
+	err := a.runGRPCServer()
+	if err != nil {
+		return errors.Join(err, closer.CloseAll())
+	}
+
+	// Serve возвращает nil только после GracefulStop, то есть остановка уже идет
+	return closer.Wait()
 }
 
 func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initConfig,
 		a.initServiceProvider,
+		a.initLogger,
+		a.initShutdown,
+		a.initTracing,
 		a.initGRPCServer,
+		a.initHTTPServer,
+		a.initMetricsServer,
 	}
 
 	for _, f := range inits {
@@ -74,18 +122,234 @@ func (a *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initLogger(_ context.Context) error {
+	cfg := a.serviceProvider.LoggerConfig()
+
+	return logger.Init(logger.Options{
+		Level:  cfg.Level(),
+		Format: cfg.Format(),
+	})
+}
+
+func (a *App) initShutdown(_ context.Context) error {
+	closer.Configure(closer.Options{
+		PhaseTimeout: a.serviceProvider.ShutdownConfig().PhaseTimeout(),
+		Timeout:      a.serviceProvider.ShutdownConfig().Timeout(),
+	})
+
+	return nil
+}
+
+func (a *App) initTracing(ctx context.Context) error {
+	cfg := a.serviceProvider.TracingConfig()
+
+	shutdown, err := tracing.Init(ctx, tracing.Options{
+		ServiceName:  cfg.ServiceName(),
+		Exporter:     cfg.Exporter(),
+		OTLPEndpoint: cfg.OTLPEndpoint(),
+		StdoutPath:   cfg.StdoutPath(),
+		SampleRatio:  cfg.SampleRatio(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Спаны сбрасываются после остановки серверов, чтобы не потерять спаны последних запросов
+	closer.Add(closer.PhaseFlush, "tracing", shutdown)
+
+	return nil
+}
+
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	unary, stream := a.grpcInterceptors()
+
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		// Продолжает входящий W3C контекст трассировки и открывает спан на каждый запрос
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
 
 	reflection.Register(a.grpcServer)
 
+	healthpb.RegisterHealthServer(a.grpcServer, a.serviceProvider.HealthChecker(ctx).Server())
+
 	chat_v1.RegisterChatV1Server(a.grpcServer, a.serviceProvider.ChatImpl(ctx))
 
+	closer.Add(closer.PhaseStopAccepting, "grpc health", func(closeCtx context.Context) error {
+		return a.stopServing(ctx, closeCtx)
+	})
+	closer.Add(closer.PhaseDrain, "grpc server", a.stopGRPCServer)
+	closer.Add(closer.PhaseDrain, "chat streams", func(context.Context) error {
+		a.serviceProvider.Hub().Close()
+		return nil
+	})
+
+	return nil
+}
+
+// initHTTPServer поднимает REST gateway, который ходит в gRPC сервер по сети,
+// чтобы запросы проходили ту же цепочку интерсепторов, что и запросы gRPC клиентов
+func (a *App) initHTTPServer(ctx context.Context) error {
+	conn, err := grpc.NewClient(
+		a.serviceProvider.GRPCConfig().Address(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return err
+	}
+
+	closer.Add(closer.PhaseFlush, "gateway connection", func(context.Context) error {
+		return conn.Close()
+	})
+
+	handler, err := gateway.New(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	a.httpServer = &http.Server{
+		Addr:              a.serviceProvider.HTTPConfig().Address(),
+		Handler:           handler,
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+		ErrorLog:          logger.StdLogger(slog.LevelError),
+	}
+
+	closer.Add(closer.PhaseDrain, "http server", a.httpServer.Shutdown)
+
 	return nil
 }
 
+// initMetricsServer поднимает служебный HTTP сервер с метриками и проверками для оркестратора
+func (a *App) initMetricsServer(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metric.Handler())
+	mux.Handle("/healthz", a.serviceProvider.HealthChecker(ctx).LivenessHandler())
+	mux.Handle("/readyz", a.serviceProvider.HealthChecker(ctx).ReadinessHandler())
+
+	a.metricsServer = &http.Server{
+		Addr:              a.serviceProvider.MetricsConfig().Address(),
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+		ErrorLog:          logger.StdLogger(slog.LevelError),
+	}
+
+	// Сервер проверок работает до конца drain, чтобы оркестратор видел /readyz 503, а не ошибку соединения
+	closer.Add(closer.PhaseFlush, "metrics server", a.metricsServer.Shutdown)
+
+	return nil
+}
+
+// grpcInterceptors собирает цепочку интерсепторов, включенных в конфигурации, от внешнего к внутреннему.
+// Метрики и access log видят итоговый код ответа, а ошибки аутентификации, валидации и паники проходят через отображение ошибок.
+// Аутентификация обязательна и не отключается конфигурацией, проверка доступа во внешнем сервисе идет после нее.
+// Последним контекст помечается для чтения из основной БД, если клиент передал x-read-primary.
+func (a *App) grpcInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	cfg := a.serviceProvider.InterceptorConfig()
+
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+
+	if cfg.RequestIDEnabled() {
+		unary = append(unary, interceptor.RequestIDUnaryInterceptor)
+		stream = append(stream, interceptor.RequestIDStreamInterceptor)
+	}
+
+	if cfg.MetricsEnabled() {
+		unary = append(unary, interceptor.MetricsUnaryInterceptor)
+		stream = append(stream, interceptor.MetricsStreamInterceptor)
+	}
+
+	if cfg.AccessLogEnabled() {
+		unary = append(unary, interceptor.AccessLogUnaryInterceptor)
+		stream = append(stream, interceptor.AccessLogStreamInterceptor)
+	}
+
+	if cfg.RecoveryEnabled() {
+		unary = append(unary, interceptor.RecoveryUnaryInterceptor)
+		stream = append(stream, interceptor.RecoveryStreamInterceptor)
+	}
+
+	unary = append(unary, interceptor.ErrorsUnaryInterceptor, a.serviceProvider.AuthInterceptor().Unary)
+	stream = append(stream, interceptor.ErrorsStreamInterceptor, a.serviceProvider.AuthInterceptor().Stream)
+
+	if a.serviceProvider.AccessConfig().Enabled() {
+		unary = append(unary, a.serviceProvider.AccessInterceptor().Unary)
+		stream = append(stream, a.serviceProvider.AccessInterceptor().Stream)
+	}
+
+	if cfg.ValidationEnabled() {
+		unary = append(unary, interceptor.ValidateUnaryInterceptor)
+		stream = append(stream, interceptor.ValidateStreamInterceptor)
+	}
+
+	unary = append(unary, interceptor.ReadPrimaryUnaryInterceptor)
+	stream = append(stream, interceptor.ReadPrimaryStreamInterceptor)
+
+	return unary, stream
+}
+
+func (a *App) runHTTPServer() error {
+	slog.Info("HTTP server is running", slog.String("address", a.serviceProvider.HTTPConfig().Address()))
+
+	err := a.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runMetricsServer() error {
+	slog.Info("metrics server is running", slog.String("address", a.serviceProvider.MetricsConfig().Address()))
+
+	err := a.metricsServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// stopServing переводит health в NOT_SERVING и дает балансировщику время исключить экземпляр,
+// прежде чем сервер перестанет принимать соединения
+func (a *App) stopServing(ctx context.Context, closeCtx context.Context) error {
+	a.serviceProvider.HealthChecker(ctx).Shutdown()
+
+	timer := time.NewTimer(a.serviceProvider.HealthConfig().ShutdownDelay())
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-closeCtx.Done():
+		return closeCtx.Err()
+	}
+}
+
+// stopGRPCServer перестает принимать соединения и ждет завершения текущих запросов.
+// Если они не успевают до конца фазы, соединения закрываются принудительно.
+func (a *App) stopGRPCServer(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		a.grpcServer.Stop()
+		return fmt.Errorf("forced stop: %w", ctx.Err())
+	}
+}
+
 func (a *App) runGRPCServer() error {
-	log.Printf("GRPC server is running on %s", a.serviceProvider.GRPCConfig().Address())
+	slog.Info("GRPC server is running", slog.String("address", a.serviceProvider.GRPCConfig().Address()))
 
 	list, err := net.Listen("tcp", a.serviceProvider.GRPCConfig().Address())
 	if err != nil {
@@ -99,3 +363,70 @@ func (a *App) runGRPCServer() error {
 
 	return nil
 }
+
+// runBackgroundWorkers запускает фоновые обработчики, остановка дожидается их завершения в фазе flush
+func (a *App) runBackgroundWorkers(ctx context.Context, cancel context.CancelFunc) {
+	var wg sync.WaitGroup
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		a.runMessageListener(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		a.serviceProvider.HealthChecker(ctx).Run(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		a.runIdempotencyCleanup(ctx)
+	}()
+
+	closer.Add(closer.PhaseFlush, "background workers", func(closeCtx context.Context) error {
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-closeCtx.Done():
+			return closeCtx.Err()
+		}
+	})
+}
+
+// runIdempotencyCleanup периодически удаляет просроченные ключи идемпотентности, пока не будет отменен контекст
+func (a *App) runIdempotencyCleanup(ctx context.Context) {
+	ticker := time.NewTicker(a.serviceProvider.ChatConfig().IdempotencyCleanupInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		deleted, err := a.serviceProvider.ChatRepository(ctx).DeleteExpiredIdempotencyKeys(ctx, idempotencyCleanupBatchSize)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to delete expired idempotency keys", logger.Err(err))
+		}
+
+		if deleted > 0 {
+			slog.InfoContext(ctx, "deleted expired idempotency keys", slog.Int64("count", deleted))
+		}
+	}
+}
+
+// runMessageListener пересылает подписчикам этого экземпляра сообщения, отправленные через другие экземпляры
+func (a *App) runMessageListener(ctx context.Context) {
+	err := a.serviceProvider.ChatRepository(ctx).ListenMessages(ctx, a.serviceProvider.Hub().Publish)
+	if err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "message listener stopped", logger.Err(err))
+	}
+}
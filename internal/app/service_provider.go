@@ -2,28 +2,58 @@ package app
 
 import (
 	"context"
-	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/ipv02/chat-server/internal/api/chat"
+	"github.com/ipv02/chat-server/internal/auth"
 	"github.com/ipv02/chat-server/internal/client/db"
 	"github.com/ipv02/chat-server/internal/client/db/pg"
 	"github.com/ipv02/chat-server/internal/client/db/transaction"
+	"github.com/ipv02/chat-server/internal/client/rpc"
+	"github.com/ipv02/chat-server/internal/client/rpc/access"
 	"github.com/ipv02/chat-server/internal/closer"
 	"github.com/ipv02/chat-server/internal/config"
 	"github.com/ipv02/chat-server/internal/config/env"
+	"github.com/ipv02/chat-server/internal/health"
+	"github.com/ipv02/chat-server/internal/hub"
+	"github.com/ipv02/chat-server/internal/interceptor"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/metric"
 	"github.com/ipv02/chat-server/internal/repository"
 	chatRepository "github.com/ipv02/chat-server/internal/repository/chat"
 	"github.com/ipv02/chat-server/internal/service"
 	chatService "github.com/ipv02/chat-server/internal/service/chat"
+	"github.com/ipv02/chat-server/pkg/access_v1"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
 )
 
 type serviceProvider struct {
-	pgConfig   config.PGConfig
-	grpcConfig config.GRPCConfig
+	pgConfig          config.PGConfig
+	grpcConfig        config.GRPCConfig
+	httpConfig        config.HTTPConfig
+	metricsConfig     config.MetricsConfig
+	tracingConfig     config.TracingConfig
+	loggerConfig      config.LoggerConfig
+	healthConfig      config.HealthConfig
+	shutdownConfig    config.ShutdownConfig
+	txConfig          config.TransactionConfig
+	interceptorConfig config.InterceptorConfig
+	authConfig        config.AuthConfig
+	accessConfig      config.AccessConfig
+	chatConfig        config.ChatConfig
 
 	dbClient       db.Client
 	txManager      db.TxManager
 	chatRepository repository.ChatRepository
+	accessClient   rpc.AccessClient
+
+	hub           *hub.Hub
+	healthChecker *health.Checker
+
+	authInterceptor   *interceptor.AuthInterceptor
+	accessInterceptor *interceptor.AccessInterceptor
 
 	chatService service.ChatService
 
@@ -39,7 +69,7 @@ func (s *serviceProvider) PgConfig() config.PGConfig {
 	if s.pgConfig == nil {
 		cfg, err := env.NewPGConfig()
 		if err != nil {
-			log.Fatalf("failed to get pg config: %s", err.Error())
+			logger.Fatal("failed to get pg config", logger.Err(err))
 		}
 
 		s.pgConfig = cfg
@@ -53,7 +83,7 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	if s.grpcConfig == nil {
 		cfg, err := env.NewGRPCConfig()
 		if err != nil {
-			log.Fatalf("failed to get grpc config: %s", err.Error())
+			logger.Fatal("failed to get grpc config", logger.Err(err))
 		}
 
 		s.grpcConfig = cfg
@@ -62,20 +92,230 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	return s.grpcConfig
 }
 
+// HTTPConfig представляет конфигурацию HTTP сервера REST API
+func (s *serviceProvider) HTTPConfig() config.HTTPConfig {
+	if s.httpConfig == nil {
+		cfg, err := env.NewHTTPConfig()
+		if err != nil {
+			logger.Fatal("failed to get http config", logger.Err(err))
+		}
+
+		s.httpConfig = cfg
+	}
+
+	return s.httpConfig
+}
+
+// MetricsConfig представляет конфигурацию HTTP сервера метрик
+func (s *serviceProvider) MetricsConfig() config.MetricsConfig {
+	if s.metricsConfig == nil {
+		cfg, err := env.NewMetricsConfig()
+		if err != nil {
+			logger.Fatal("failed to get metrics config", logger.Err(err))
+		}
+
+		s.metricsConfig = cfg
+	}
+
+	return s.metricsConfig
+}
+
+// HealthConfig представляет настройки проверки готовности сервера
+func (s *serviceProvider) HealthConfig() config.HealthConfig {
+	if s.healthConfig == nil {
+		cfg, err := env.NewHealthConfig()
+		if err != nil {
+			logger.Fatal("failed to get health config", logger.Err(err))
+		}
+
+		s.healthConfig = cfg
+	}
+
+	return s.healthConfig
+}
+
+// LoggerConfig представляет настройки логирования
+func (s *serviceProvider) LoggerConfig() config.LoggerConfig {
+	if s.loggerConfig == nil {
+		cfg, err := env.NewLoggerConfig()
+		if err != nil {
+			logger.Fatal("failed to get logger config", logger.Err(err))
+		}
+
+		s.loggerConfig = cfg
+	}
+
+	return s.loggerConfig
+}
+
+// ShutdownConfig представляет ограничения времени остановки сервера
+func (s *serviceProvider) ShutdownConfig() config.ShutdownConfig {
+	if s.shutdownConfig == nil {
+		cfg, err := env.NewShutdownConfig()
+		if err != nil {
+			logger.Fatal("failed to get shutdown config", logger.Err(err))
+		}
+
+		s.shutdownConfig = cfg
+	}
+
+	return s.shutdownConfig
+}
+
+// TransactionConfig представляет настройки повтора транзакций
+func (s *serviceProvider) TransactionConfig() config.TransactionConfig {
+	if s.txConfig == nil {
+		cfg, err := env.NewTransactionConfig()
+		if err != nil {
+			logger.Fatal("failed to get transaction config", logger.Err(err))
+		}
+
+		s.txConfig = cfg
+	}
+
+	return s.txConfig
+}
+
+// TracingConfig представляет настройки трассировки
+func (s *serviceProvider) TracingConfig() config.TracingConfig {
+	if s.tracingConfig == nil {
+		cfg, err := env.NewTracingConfig()
+		if err != nil {
+			logger.Fatal("failed to get tracing config", logger.Err(err))
+		}
+
+		s.tracingConfig = cfg
+	}
+
+	return s.tracingConfig
+}
+
+// InterceptorConfig представляет настройки интерсепторов gRPC сервера
+func (s *serviceProvider) InterceptorConfig() config.InterceptorConfig {
+	if s.interceptorConfig == nil {
+		cfg, err := env.NewInterceptorConfig()
+		if err != nil {
+			logger.Fatal("failed to get interceptor config", logger.Err(err))
+		}
+
+		s.interceptorConfig = cfg
+	}
+
+	return s.interceptorConfig
+}
+
+// AuthConfig представляет настройки проверки токенов доступа
+func (s *serviceProvider) AuthConfig() config.AuthConfig {
+	if s.authConfig == nil {
+		cfg, err := env.NewAuthConfig()
+		if err != nil {
+			logger.Fatal("failed to get auth config", logger.Err(err))
+		}
+
+		s.authConfig = cfg
+	}
+
+	return s.authConfig
+}
+
+// AuthInterceptor возвращает интерсептор аутентификации
+func (s *serviceProvider) AuthInterceptor() *interceptor.AuthInterceptor {
+	if s.authInterceptor == nil {
+		verifier, err := auth.NewVerifier(s.AuthConfig().Keys(), s.AuthConfig().Issuer(), s.AuthConfig().Audience())
+		if err != nil {
+			logger.Fatal("failed to create token verifier", logger.Err(err))
+		}
+
+		s.authInterceptor = interceptor.NewAuthInterceptor(verifier, s.AuthConfig().PublicMethods())
+	}
+
+	return s.authInterceptor
+}
+
+// AccessConfig представляет настройки клиента сервиса авторизации
+func (s *serviceProvider) AccessConfig() config.AccessConfig {
+	if s.accessConfig == nil {
+		cfg, err := env.NewAccessConfig()
+		if err != nil {
+			logger.Fatal("failed to get access config", logger.Err(err))
+		}
+
+		s.accessConfig = cfg
+	}
+
+	return s.accessConfig
+}
+
+// AccessClient возвращает клиент сервиса авторизации
+func (s *serviceProvider) AccessClient() rpc.AccessClient {
+	if s.accessClient == nil {
+		conn, err := grpc.NewClient(s.AccessConfig().Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			logger.Fatal("failed to create access service connection", logger.Err(err))
+		}
+
+		// Соединение нужно запросам до конца фазы drain
+		closer.Add(closer.PhaseFlush, "access client", func(context.Context) error {
+			return conn.Close()
+		})
+
+		s.accessClient = access.NewClient(access_v1.NewAccessV1Client(conn), access.Options{
+			Timeout:         s.AccessConfig().Timeout(),
+			CacheTTL:        s.AccessConfig().CacheTTL(),
+			FailOpenMethods: s.AccessConfig().FailOpenMethods(),
+		})
+	}
+
+	return s.accessClient
+}
+
+// AccessInterceptor возвращает интерсептор проверки доступа к методам чата
+func (s *serviceProvider) AccessInterceptor() *interceptor.AccessInterceptor {
+	if s.accessInterceptor == nil {
+		s.accessInterceptor = interceptor.NewAccessInterceptor(s.AccessClient())
+	}
+
+	return s.accessInterceptor
+}
+
+// ChatConfig представляет настройки бизнес-логики чатов
+func (s *serviceProvider) ChatConfig() config.ChatConfig {
+	if s.chatConfig == nil {
+		cfg, err := env.NewChatConfig()
+		if err != nil {
+			logger.Fatal("failed to get chat config", logger.Err(err))
+		}
+
+		s.chatConfig = cfg
+	}
+
+	return s.chatConfig
+}
+
 // DBClient клиент для работы с базой данных
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
-		cl, err := pg.New(ctx, s.PgConfig().DSN())
+		cl, err := pg.New(ctx, s.PgConfig().DSN(), s.PgConfig().ReplicaDSNs(), pg.Options{
+			LogQueries:           s.LoggerConfig().SQL(),
+			ReplicaRetryInterval: s.PgConfig().ReplicaRetryInterval(),
+		})
 		if err != nil {
-			log.Fatalf("failed to connect to database: %s", err.Error())
+			logger.Fatal("failed to connect to database", logger.Err(err))
 		}
 
 		err = cl.DB().Ping(ctx)
 		if err != nil {
-			log.Fatalf("failed to ping database: %s", err.Error())
+			logger.Fatal("failed to ping database", logger.Err(err))
 		}
 
-		closer.Add(cl.Close)
+		err = metric.Register(metric.NewPoolCollector(cl.DB().Stat))
+		if err != nil {
+			logger.Fatal("failed to register db pool metrics", logger.Err(err))
+		}
+
+		closer.Add(closer.PhaseCloseDB, "db client", func(context.Context) error {
+			return cl.Close()
+		})
 
 		s.dbClient = cl
 	}
@@ -86,7 +326,12 @@ func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 // TxManager возвращает экземпляр менеджера транзакций
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
-		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB())
+		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB(), transaction.Options{
+			MaxRetries:  s.TransactionConfig().MaxRetries(),
+			MinBackoff:  s.TransactionConfig().MinBackoff(),
+			MaxBackoff:  s.TransactionConfig().MaxBackoff(),
+			RetryBudget: s.TransactionConfig().RetryBudget(),
+		})
 	}
 
 	return s.txManager
@@ -101,10 +346,39 @@ func (s *serviceProvider) ChatRepository(ctx context.Context) repository.ChatRep
 	return s.chatRepository
 }
 
+// Hub возвращает хаб рассылки новых сообщений подписчикам чатов
+func (s *serviceProvider) Hub() *hub.Hub {
+	if s.hub == nil {
+		s.hub = hub.New(hub.DefaultBufferSize)
+	}
+
+	return s.hub
+}
+
+// HealthChecker возвращает проверку готовности, которая управляет статусом gRPC health сервиса
+func (s *serviceProvider) HealthChecker(ctx context.Context) *health.Checker {
+	if s.healthChecker == nil {
+		s.healthChecker = health.NewChecker(
+			s.DBClient(ctx).DB(),
+			s.HealthConfig().CheckInterval(),
+			s.HealthConfig().CheckTimeout(),
+			chat_v1.ChatV1_ServiceDesc.ServiceName,
+		)
+	}
+
+	return s.healthChecker
+}
+
 // ChatService возвращает экземпляр сервиса
 func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
 	if s.chatService == nil {
-		s.chatService = chatService.NewService(s.ChatRepository(ctx), s.TxManager(ctx))
+		s.chatService = chatService.NewService(
+			s.ChatRepository(ctx),
+			s.TxManager(ctx),
+			s.Hub(),
+			s.ChatConfig().EditWindow(),
+			s.ChatConfig().IdempotencyTTL(),
+		)
 	}
 
 	return s.chatService
@@ -0,0 +1,118 @@
+package apperr
+
+import "strings"
+
+// Kind класс доменной ошибки, по нему ошибка отображается в код ответа
+type Kind int
+
+const (
+	// KindInternal непредвиденная ошибка, подробности не передаются клиенту
+	KindInternal Kind = iota
+	// KindNotFound запрошенная сущность не существует
+	KindNotFound
+	// KindAlreadyExists сущность с такими данными уже существует
+	KindAlreadyExists
+	// KindPermissionDenied у пользователя нет прав на действие
+	KindPermissionDenied
+	// KindConflict текущее состояние сущности не позволяет выполнить действие
+	KindConflict
+	// KindInvalidInput некорректные входные данные
+	KindInvalidInput
+	// KindAborted операция прервана конкурентной транзакцией и может быть повторена
+	KindAborted
+	// KindResourceExhausted исчерпан ресурс, выделенный клиенту
+	KindResourceExhausted
+	// KindUnauthenticated вызывающий не прошел аутентификацию
+	KindUnauthenticated
+	// KindUnavailable внешняя зависимость временно недоступна, запрос можно повторить позже
+	KindUnavailable
+)
+
+// FieldViolation описание ошибки в конкретном поле запроса
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error доменная ошибка. Message передается клиенту, Err остается на сервере для логов
+type Error struct {
+	Kind       Kind
+	Message    string
+	Violations []FieldViolation
+	Err        error
+}
+
+// Error реализует интерфейс error
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Message)
+
+	for _, v := range e.Violations {
+		b.WriteString("; ")
+		b.WriteString(v.Field)
+		b.WriteString(": ")
+		b.WriteString(v.Description)
+	}
+
+	if e.Err != nil {
+		b.WriteString(": ")
+		b.WriteString(e.Err.Error())
+	}
+
+	return b.String()
+}
+
+// Unwrap возвращает исходную ошибку
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NotFound создает ошибку отсутствующей сущности
+func NotFound(message string) *Error {
+	return &Error{Kind: KindNotFound, Message: message}
+}
+
+// AlreadyExists создает ошибку уже существующей сущности
+func AlreadyExists(message string) *Error {
+	return &Error{Kind: KindAlreadyExists, Message: message}
+}
+
+// PermissionDenied создает ошибку недостатка прав
+func PermissionDenied(message string) *Error {
+	return &Error{Kind: KindPermissionDenied, Message: message}
+}
+
+// Conflict создает ошибку несовместимого с действием состояния
+func Conflict(message string) *Error {
+	return &Error{Kind: KindConflict, Message: message}
+}
+
+// InvalidInput создает ошибку некорректных входных данных с описанием полей
+func InvalidInput(message string, violations ...FieldViolation) *Error {
+	return &Error{Kind: KindInvalidInput, Message: message, Violations: violations}
+}
+
+// Aborted создает ошибку операции, прерванной конкурентной транзакцией
+func Aborted(message string) *Error {
+	return &Error{Kind: KindAborted, Message: message}
+}
+
+// ResourceExhausted создает ошибку исчерпанного ресурса
+func ResourceExhausted(message string) *Error {
+	return &Error{Kind: KindResourceExhausted, Message: message}
+}
+
+// Unauthenticated создает ошибку отсутствующей или недействительной аутентификации
+func Unauthenticated(message string) *Error {
+	return &Error{Kind: KindUnauthenticated, Message: message}
+}
+
+// Unavailable создает ошибку недоступной внешней зависимости
+func Unavailable(message string) *Error {
+	return &Error{Kind: KindUnavailable, Message: message}
+}
+
+// Wrap создает ошибку указанного класса, сохраняя исходную ошибку для логов
+func Wrap(err error, kind Kind, message string) *Error {
+	return &Error{Kind: kind, Message: message, Err: err}
+}
@@ -0,0 +1,45 @@
+package apperr
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgconn"
+)
+
+// Коды ошибок PostgreSQL, которые отображаются в доменные ошибки
+const (
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgNotNullViolation     = "23502"
+	pgCheckViolation       = "23514"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgLockNotAvailable     = "55P03"
+
+	// pgDataExceptionClass класс ошибок некорректных значений: переполнение, неверный формат, слишком длинная строка
+	pgDataExceptionClass = "22"
+)
+
+// FromDB переводит ошибку PostgreSQL в доменную, сохраняя исходную для логов.
+// Текст ошибки базы данных клиенту не передается. Остальные ошибки возвращаются без изменений.
+func FromDB(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch {
+	case pgErr.Code == pgUniqueViolation:
+		return Wrap(err, KindAlreadyExists, "entity already exists")
+	case pgErr.Code == pgForeignKeyViolation:
+		return Wrap(err, KindConflict, "referenced entity does not exist or is still in use")
+	case pgErr.Code == pgSerializationFailure, pgErr.Code == pgDeadlockDetected, pgErr.Code == pgLockNotAvailable:
+		return Wrap(err, KindAborted, "request conflicted with a concurrent update, retry it")
+	case pgErr.Code == pgNotNullViolation, pgErr.Code == pgCheckViolation,
+		strings.HasPrefix(pgErr.Code, pgDataExceptionClass):
+		return Wrap(err, KindInvalidInput, "invalid input")
+	}
+
+	return err
+}
@@ -0,0 +1,83 @@
+package apperr
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// internalMessage текст, который получает клиент вместо подробностей непредвиденной ошибки
+const internalMessage = "internal error"
+
+var kindCodes = map[Kind]codes.Code{
+	KindInternal:          codes.Internal,
+	KindNotFound:          codes.NotFound,
+	KindAlreadyExists:     codes.AlreadyExists,
+	KindPermissionDenied:  codes.PermissionDenied,
+	KindConflict:          codes.FailedPrecondition,
+	KindInvalidInput:      codes.InvalidArgument,
+	KindAborted:           codes.Aborted,
+	KindResourceExhausted: codes.ResourceExhausted,
+	KindUnauthenticated:   codes.Unauthenticated,
+	KindUnavailable:       codes.Unavailable,
+}
+
+// ToStatus отображает ошибку в статус gRPC. Доменные ошибки и ошибки PostgreSQL получают свой код,
+// ошибки некорректного ввода - описание полей в errdetails.BadRequest. Уже готовые статусы
+// и отмена контекста передаются как есть, все остальное скрывается за codes.Internal.
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *Error
+	if errors.As(FromDB(err), &appErr) {
+		return appErrorStatus(appErr)
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.New(codes.Canceled, context.Canceled.Error())
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.New(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	return status.New(codes.Internal, internalMessage)
+}
+
+func appErrorStatus(err *Error) *status.Status {
+	code, ok := kindCodes[err.Kind]
+	if !ok || code == codes.Internal {
+		return status.New(codes.Internal, internalMessage)
+	}
+
+	st := status.New(code, err.Message)
+	if len(err.Violations) == 0 {
+		return st
+	}
+
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(err.Violations)),
+	}
+	for _, v := range err.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	withDetails, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st
+	}
+
+	return withDetails
+}
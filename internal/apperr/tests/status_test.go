@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+
+	"github.com/ipv02/chat-server/internal/apperr"
+)
+
+func TestToStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		code       codes.Code
+		message    string
+		violations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:    "domain error case",
+			err:     apperr.NotFound("chat not found"),
+			code:    codes.NotFound,
+			message: "chat not found",
+		},
+		{
+			name:    "wrapped domain error case",
+			err:     errors.Wrap(apperr.Conflict("message has been deleted"), "failed executing code inside transaction"),
+			code:    codes.FailedPrecondition,
+			message: "message has been deleted",
+		},
+		{
+			name: "invalid input case",
+			err: apperr.InvalidInput("invalid request", apperr.FieldViolation{
+				Field:       "chat_id",
+				Description: "chat id must be greater than 0",
+			}),
+			code:    codes.InvalidArgument,
+			message: "invalid request",
+			violations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "chat_id", Description: "chat id must be greater than 0"},
+			},
+		},
+		{
+			name:    "unique violation case",
+			err:     &pgconn.PgError{Code: "23505", Message: "duplicate key value violates unique constraint \"chat_pkey\""},
+			code:    codes.AlreadyExists,
+			message: "entity already exists",
+		},
+		{
+			name:    "foreign key violation case",
+			err:     errors.Wrap(&pgconn.PgError{Code: "23503", Message: "insert or update violates foreign key"}, "tx commit failed"),
+			code:    codes.FailedPrecondition,
+			message: "referenced entity does not exist or is still in use",
+		},
+		{
+			name:    "serialization failure case",
+			err:     &pgconn.PgError{Code: "40001", Message: "could not serialize access"},
+			code:    codes.Aborted,
+			message: "request conflicted with a concurrent update, retry it",
+		},
+		{
+			name:    "data exception case",
+			err:     &pgconn.PgError{Code: "22001", Message: "value too long for type character varying(255)"},
+			code:    codes.InvalidArgument,
+			message: "invalid input",
+		},
+		{
+			name:    "unknown database error case",
+			err:     &pgconn.PgError{Code: "42P01", Message: "relation \"chat\" does not exist"},
+			code:    codes.Internal,
+			message: "internal error",
+		},
+		{
+			name:    "unknown error case",
+			err:     fmt.Errorf("dial tcp 10.0.0.1:5432: connection refused"),
+			code:    codes.Internal,
+			message: "internal error",
+		},
+		{
+			name:    "context canceled case",
+			err:     errors.Wrap(context.Canceled, "failed executing code inside transaction"),
+			code:    codes.Canceled,
+			message: context.Canceled.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			st := apperr.ToStatus(tt.err)
+			require.Equal(t, tt.code, st.Code())
+			require.Equal(t, tt.message, st.Message())
+
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					violations = append(violations, badRequest.GetFieldViolations()...)
+				}
+			}
+
+			require.Len(t, violations, len(tt.violations))
+			for i := range tt.violations {
+				require.Equal(t, tt.violations[i].GetField(), violations[i].GetField())
+				require.Equal(t, tt.violations[i].GetDescription(), violations[i].GetDescription())
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+)
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = "42"
+		oldSecret = []byte("old-secret")
+		newSecret = []byte("new-secret")
+	)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	verifier, err := auth.NewVerifier([]auth.Key{
+		{ID: "hs-old", Algorithm: auth.AlgorithmHS256, Secret: oldSecret},
+		{ID: "hs-new", Algorithm: auth.AlgorithmHS256, Secret: newSecret},
+		{ID: "rs", Algorithm: auth.AlgorithmRS256, PublicKey: &rsaKey.PublicKey},
+	}, "auth-service", "chat-server")
+	require.NoError(t, err)
+
+	claims := func(exp time.Time) jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Subject:   userID,
+			Issuer:    "auth-service",
+			Audience:  jwt.ClaimStrings{"chat-server"},
+			ExpiresAt: jwt.NewNumericDate(exp),
+		}
+	}
+
+	sign := func(method jwt.SigningMethod, kid string, c jwt.Claims, key interface{}) string {
+		token := jwt.NewWithClaims(method, c)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+
+		signed, errSign := token.SignedString(key)
+		require.NoError(t, errSign)
+
+		return signed
+	}
+
+	valid := claims(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+		err   error
+	}{
+		{
+			name:  "hs256 old key case",
+			token: sign(jwt.SigningMethodHS256, "hs-old", valid, oldSecret),
+			want:  userID,
+		},
+		{
+			name:  "hs256 new key case",
+			token: sign(jwt.SigningMethodHS256, "hs-new", valid, newSecret),
+			want:  userID,
+		},
+		{
+			name:  "rs256 case",
+			token: sign(jwt.SigningMethodRS256, "rs", valid, rsaKey),
+			want:  userID,
+		},
+		{
+			name:  "wrong secret case",
+			token: sign(jwt.SigningMethodHS256, "hs-new", valid, oldSecret),
+			err:   auth.ErrInvalidToken,
+		},
+		{
+			name:  "unknown kid case",
+			token: sign(jwt.SigningMethodHS256, "hs-removed", valid, oldSecret),
+			err:   auth.ErrInvalidToken,
+		},
+		{
+			name:  "missing kid with several keys case",
+			token: sign(jwt.SigningMethodHS256, "", valid, oldSecret),
+			err:   auth.ErrInvalidToken,
+		},
+		{
+			name:  "algorithm does not match key case",
+			token: sign(jwt.SigningMethodHS256, "rs", valid, []byte("guessed")),
+			err:   auth.ErrInvalidToken,
+		},
+		{
+			name:  "expired token case",
+			token: sign(jwt.SigningMethodHS256, "hs-new", claims(time.Now().Add(-time.Minute)), newSecret),
+			err:   auth.ErrInvalidToken,
+		},
+		{
+			name: "wrong audience case",
+			token: sign(jwt.SigningMethodHS256, "hs-new", jwt.RegisteredClaims{
+				Subject:   userID,
+				Issuer:    "auth-service",
+				Audience:  jwt.ClaimStrings{"other-service"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}, newSecret),
+			err: auth.ErrInvalidToken,
+		},
+		{
+			name: "missing subject case",
+			token: sign(jwt.SigningMethodHS256, "hs-new", jwt.RegisteredClaims{
+				Issuer:    "auth-service",
+				Audience:  jwt.ClaimStrings{"chat-server"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}, newSecret),
+			err: auth.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, errVerify := verifier.Verify(tt.token)
+			if tt.err != nil {
+				require.ErrorIs(t, errVerify, tt.err)
+				return
+			}
+
+			require.NoError(t, errVerify)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
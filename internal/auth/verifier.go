@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// AlgorithmHS256 подпись общим секретом
+	AlgorithmHS256 = "HS256"
+	// AlgorithmRS256 подпись закрытым ключом RSA, проверка открытым
+	AlgorithmRS256 = "RS256"
+)
+
+var (
+	// ErrInvalidToken токен поврежден, просрочен или подписан неизвестным ключом
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrUnknownKey токен подписан ключом, которого нет в конфигурации
+	ErrUnknownKey = errors.New("unknown signing key")
+)
+
+// Key ключ проверки подписи токенов доступа. Для HS256 задается Secret, для RS256 - PublicKey
+type Key struct {
+	ID        string
+	Algorithm string
+	Secret    []byte
+	PublicKey *rsa.PublicKey
+}
+
+// Verifier проверяет токены доступа по локально настроенным ключам.
+// Ключи различаются по заголовку kid, что позволяет ротировать их без простоя.
+type Verifier struct {
+	keys     map[string]Key
+	issuer   string
+	audience string
+}
+
+// NewVerifier создает проверку токенов. Пустые issuer и audience не проверяются
+func NewVerifier(keys []Key, issuer, audience string) (*Verifier, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one signing key is required")
+	}
+
+	byID := make(map[string]Key, len(keys))
+	for _, key := range keys {
+		if _, ok := byID[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate signing key id %q", key.ID)
+		}
+
+		switch key.Algorithm {
+		case AlgorithmHS256:
+			if len(key.Secret) == 0 {
+				return nil, fmt.Errorf("signing key %q: secret is required", key.ID)
+			}
+		case AlgorithmRS256:
+			if key.PublicKey == nil {
+				return nil, fmt.Errorf("signing key %q: public key is required", key.ID)
+			}
+		default:
+			return nil, fmt.Errorf("signing key %q: unsupported algorithm %q", key.ID, key.Algorithm)
+		}
+
+		byID[key.ID] = key
+	}
+
+	return &Verifier{
+		keys:     byID,
+		issuer:   issuer,
+		audience: audience,
+	}, nil
+}
+
+// Verify проверяет подпись и срок действия токена и возвращает ID пользователя из claim sub
+func (v *Verifier) Verify(token string) (string, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{AlgorithmHS256, AlgorithmRS256}),
+		jwt.WithExpirationRequired(),
+	}
+
+	if v.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.issuer))
+	}
+
+	if v.audience != "" {
+		opts = append(opts, jwt.WithAudience(v.audience))
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, v.keyFunc, opts...)
+	if err != nil {
+		return "", errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	if claims.Subject == "" {
+		return "", errors.Wrap(ErrInvalidToken, "subject is required")
+	}
+
+	return claims.Subject, nil
+}
+
+// keyFunc выбирает ключ по kid. Алгоритм токена должен совпадать с алгоритмом ключа,
+// иначе открытый ключ RSA можно было бы использовать как секрет HS256.
+func (v *Verifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	key, err := v.lookupKey(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("signing key %q does not accept %s", key.ID, token.Method.Alg())
+	}
+
+	if key.Algorithm == AlgorithmHS256 {
+		return key.Secret, nil
+	}
+
+	return key.PublicKey, nil
+}
+
+// lookupKey возвращает ключ из заголовка kid. Без kid токен принимается, только если настроен единственный ключ
+func (v *Verifier) lookupKey(token *jwt.Token) (Key, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if len(v.keys) == 1 {
+			for _, key := range v.keys {
+				return key, nil
+			}
+		}
+
+		return Key{}, ErrUnknownKey
+	}
+
+	key, ok := v.keys[kid]
+	if !ok {
+		return Key{}, ErrUnknownKey
+	}
+
+	return key, nil
+}
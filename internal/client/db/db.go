@@ -5,6 +5,7 @@ import (
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 // Handler - функция, которая выполняется в транзакции
@@ -12,13 +13,33 @@ type Handler func(ctx context.Context) error
 
 // Client клиент для работы с БД
 type Client interface {
+	// DB возвращает основную БД, в которую идут записи и транзакции
 	DB() DB
+	// Replica возвращает БД для запросов только на чтение, допускающих отставание данных, например, истории и списков.
+	// Запросы распределяются по репликам, а внутри транзакции, с WithPrimary или без доступных реплик выполняются
+	// в основной БД. Записи, транзакции и LISTEN/NOTIFY всегда идут в основную БД.
+	Replica() DB
 	Close() error
 }
 
-// TxManager менеджер транзакций, который выполняет указанный пользователем обработчик в транзакции
+// TxManager менеджер транзакций, который выполняет указанный пользователем обработчик в транзакции.
+// Транзакция, откатившаяся из-за ошибки сериализации (40001) или взаимоблокировки (40P01), выполняется повторно,
+// поэтому обработчик не должен иметь побочных эффектов вне БД. Повторяется только внешняя транзакция:
+// вложенный вызов выполняется в уже открытой транзакции и не может требовать более строгих гарантий, чем она.
 type TxManager interface {
 	ReadCommitted(ctx context.Context, f Handler) error
+	RepeatableRead(ctx context.Context, f Handler) error
+	Serializable(ctx context.Context, f Handler) error
+	// ReadOnly выполняет обработчик в транзакции только для чтения, все запросы которой видят один снимок данных
+	ReadOnly(ctx context.Context, f Handler) error
+	// ReadOnlySerializable ждет безопасного снимка (DEFERRABLE), после чего транзакция не откатывается из-за сериализации
+	ReadOnlySerializable(ctx context.Context, f Handler) error
+	// Transaction выполняет обработчик с произвольными уровнем изоляции, режимом доступа и DEFERRABLE
+	Transaction(ctx context.Context, opts pgx.TxOptions, f Handler) error
+	// Savepoint выполняет обработчик внутри открытой транзакции под SAVEPOINT: при ошибке откатываются только
+	// изменения обработчика, а внешняя транзакция может продолжить работу. Вне транзакции обработчик
+	// выполняется в отдельной транзакции read committed.
+	Savepoint(ctx context.Context, f Handler) error
 }
 
 // Query обертка над запросом, хранящая имя запроса и сам запрос
@@ -28,6 +49,12 @@ type Query struct {
 	QueryRaw string
 }
 
+// Sensitive помечает аргумент запроса, значение которого нельзя писать в логи, например, текст сообщения.
+// Клиент БД передает в драйвер само значение Value, а в лог запроса попадает заглушка.
+type Sensitive struct {
+	Value interface{}
+}
+
 // Transactor интерфейс для работы с транзакциями
 type Transactor interface {
 	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
@@ -57,10 +84,29 @@ type Pinger interface {
 	Ping(ctx context.Context) error
 }
 
+// Notifier интерфейс для отправки уведомлений через NOTIFY.
+// Внутри транзакции уведомление доставляется только после ее коммита.
+type Notifier interface {
+	Notify(ctx context.Context, channel string, payload string) error
+}
+
+// Listener интерфейс для получения уведомлений через LISTEN
+type Listener interface {
+	Listen(ctx context.Context, channel string, handler func(payload string)) error
+}
+
+// PoolStater интерфейс для получения статистики пула соединений
+type PoolStater interface {
+	Stat() *pgxpool.Stat
+}
+
 // DB интерфейс для работы с БД
 type DB interface {
 	SQLExecer
 	Transactor
 	Pinger
+	Notifier
+	Listener
+	PoolStater
 	Close()
 }
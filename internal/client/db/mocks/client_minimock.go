@@ -0,0 +1,655 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/chat-server/internal/client/db.Client -o client_minimock.go -n ClientMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_db "github.com/ipv02/chat-server/internal/client/db"
+)
+
+// ClientMock implements mm_db.Client
+type ClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClose          func() (err error)
+	funcCloseOrigin    string
+	inspectFuncClose   func()
+	afterCloseCounter  uint64
+	beforeCloseCounter uint64
+	CloseMock          mClientMockClose
+
+	funcDB          func() (d1 mm_db.DB)
+	funcDBOrigin    string
+	inspectFuncDB   func()
+	afterDBCounter  uint64
+	beforeDBCounter uint64
+	DBMock          mClientMockDB
+
+	funcReplica          func() (d1 mm_db.DB)
+	funcReplicaOrigin    string
+	inspectFuncReplica   func()
+	afterReplicaCounter  uint64
+	beforeReplicaCounter uint64
+	ReplicaMock          mClientMockReplica
+}
+
+// NewClientMock returns a mock for mm_db.Client
+func NewClientMock(t minimock.Tester) *ClientMock {
+	m := &ClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CloseMock = mClientMockClose{mock: m}
+
+	m.DBMock = mClientMockDB{mock: m}
+
+	m.ReplicaMock = mClientMockReplica{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mClientMockClose struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockCloseExpectation
+	expectations       []*ClientMockCloseExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockCloseExpectation specifies expectation struct of the Client.Close
+type ClientMockCloseExpectation struct {
+	mock *ClientMock
+
+	results      *ClientMockCloseResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ClientMockCloseResults contains results of the Client.Close
+type ClientMockCloseResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClose *mClientMockClose) Optional() *mClientMockClose {
+	mmClose.optional = true
+	return mmClose
+}
+
+// Expect sets up expected params for Client.Close
+func (mmClose *mClientMockClose) Expect() *mClientMockClose {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("ClientMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &ClientMockCloseExpectation{}
+	}
+
+	return mmClose
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.Close
+func (mmClose *mClientMockClose) Inspect(f func()) *mClientMockClose {
+	if mmClose.mock.inspectFuncClose != nil {
+		mmClose.mock.t.Fatalf("Inspect function is already set for ClientMock.Close")
+	}
+
+	mmClose.mock.inspectFuncClose = f
+
+	return mmClose
+}
+
+// Return sets up results that will be returned by Client.Close
+func (mmClose *mClientMockClose) Return(err error) *ClientMock {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("ClientMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &ClientMockCloseExpectation{mock: mmClose.mock}
+	}
+	mmClose.defaultExpectation.results = &ClientMockCloseResults{err}
+	mmClose.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClose.mock
+}
+
+// Set uses given function f to mock the Client.Close method
+func (mmClose *mClientMockClose) Set(f func() (err error)) *ClientMock {
+	if mmClose.defaultExpectation != nil {
+		mmClose.mock.t.Fatalf("Default expectation is already set for the Client.Close method")
+	}
+
+	if len(mmClose.expectations) > 0 {
+		mmClose.mock.t.Fatalf("Some expectations are already set for the Client.Close method")
+	}
+
+	mmClose.mock.funcClose = f
+	mmClose.mock.funcCloseOrigin = minimock.CallerInfo(1)
+	return mmClose.mock
+}
+
+// Times sets number of times Client.Close should be invoked
+func (mmClose *mClientMockClose) Times(n uint64) *mClientMockClose {
+	if n == 0 {
+		mmClose.mock.t.Fatalf("Times of ClientMock.Close mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClose.expectedInvocations, n)
+	mmClose.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClose
+}
+
+func (mmClose *mClientMockClose) invocationsDone() bool {
+	if len(mmClose.expectations) == 0 && mmClose.defaultExpectation == nil && mmClose.mock.funcClose == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClose.mock.afterCloseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClose.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Close implements mm_db.Client
+func (mmClose *ClientMock) Close() (err error) {
+	mm_atomic.AddUint64(&mmClose.beforeCloseCounter, 1)
+	defer mm_atomic.AddUint64(&mmClose.afterCloseCounter, 1)
+
+	mmClose.t.Helper()
+
+	if mmClose.inspectFuncClose != nil {
+		mmClose.inspectFuncClose()
+	}
+
+	if mmClose.CloseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClose.CloseMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmClose.CloseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClose.t.Fatal("No results are set for the ClientMock.Close")
+		}
+		return (*mm_results).err
+	}
+	if mmClose.funcClose != nil {
+		return mmClose.funcClose()
+	}
+	mmClose.t.Fatalf("Unexpected call to ClientMock.Close.")
+	return
+}
+
+// CloseAfterCounter returns a count of finished ClientMock.Close invocations
+func (mmClose *ClientMock) CloseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.afterCloseCounter)
+}
+
+// CloseBeforeCounter returns a count of ClientMock.Close invocations
+func (mmClose *ClientMock) CloseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.beforeCloseCounter)
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockCloseDone() bool {
+	if m.CloseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloseMock.invocationsDone()
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (m *ClientMock) MinimockCloseInspect() {
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.Close")
+		}
+	}
+
+	afterCloseCounter := mm_atomic.LoadUint64(&m.afterCloseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseMock.defaultExpectation != nil && afterCloseCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Close at\n%s", m.CloseMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClose != nil && afterCloseCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Close at\n%s", m.funcCloseOrigin)
+	}
+
+	if !m.CloseMock.invocationsDone() && afterCloseCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.Close at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CloseMock.expectedInvocations), m.CloseMock.expectedInvocationsOrigin, afterCloseCounter)
+	}
+}
+
+type mClientMockDB struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockDBExpectation
+	expectations       []*ClientMockDBExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockDBExpectation specifies expectation struct of the Client.DB
+type ClientMockDBExpectation struct {
+	mock *ClientMock
+
+	results      *ClientMockDBResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ClientMockDBResults contains results of the Client.DB
+type ClientMockDBResults struct {
+	d1 mm_db.DB
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDB *mClientMockDB) Optional() *mClientMockDB {
+	mmDB.optional = true
+	return mmDB
+}
+
+// Expect sets up expected params for Client.DB
+func (mmDB *mClientMockDB) Expect() *mClientMockDB {
+	if mmDB.mock.funcDB != nil {
+		mmDB.mock.t.Fatalf("ClientMock.DB mock is already set by Set")
+	}
+
+	if mmDB.defaultExpectation == nil {
+		mmDB.defaultExpectation = &ClientMockDBExpectation{}
+	}
+
+	return mmDB
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.DB
+func (mmDB *mClientMockDB) Inspect(f func()) *mClientMockDB {
+	if mmDB.mock.inspectFuncDB != nil {
+		mmDB.mock.t.Fatalf("Inspect function is already set for ClientMock.DB")
+	}
+
+	mmDB.mock.inspectFuncDB = f
+
+	return mmDB
+}
+
+// Return sets up results that will be returned by Client.DB
+func (mmDB *mClientMockDB) Return(d1 mm_db.DB) *ClientMock {
+	if mmDB.mock.funcDB != nil {
+		mmDB.mock.t.Fatalf("ClientMock.DB mock is already set by Set")
+	}
+
+	if mmDB.defaultExpectation == nil {
+		mmDB.defaultExpectation = &ClientMockDBExpectation{mock: mmDB.mock}
+	}
+	mmDB.defaultExpectation.results = &ClientMockDBResults{d1}
+	mmDB.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDB.mock
+}
+
+// Set uses given function f to mock the Client.DB method
+func (mmDB *mClientMockDB) Set(f func() (d1 mm_db.DB)) *ClientMock {
+	if mmDB.defaultExpectation != nil {
+		mmDB.mock.t.Fatalf("Default expectation is already set for the Client.DB method")
+	}
+
+	if len(mmDB.expectations) > 0 {
+		mmDB.mock.t.Fatalf("Some expectations are already set for the Client.DB method")
+	}
+
+	mmDB.mock.funcDB = f
+	mmDB.mock.funcDBOrigin = minimock.CallerInfo(1)
+	return mmDB.mock
+}
+
+// Times sets number of times Client.DB should be invoked
+func (mmDB *mClientMockDB) Times(n uint64) *mClientMockDB {
+	if n == 0 {
+		mmDB.mock.t.Fatalf("Times of ClientMock.DB mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDB.expectedInvocations, n)
+	mmDB.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDB
+}
+
+func (mmDB *mClientMockDB) invocationsDone() bool {
+	if len(mmDB.expectations) == 0 && mmDB.defaultExpectation == nil && mmDB.mock.funcDB == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDB.mock.afterDBCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDB.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DB implements mm_db.Client
+func (mmDB *ClientMock) DB() (d1 mm_db.DB) {
+	mm_atomic.AddUint64(&mmDB.beforeDBCounter, 1)
+	defer mm_atomic.AddUint64(&mmDB.afterDBCounter, 1)
+
+	mmDB.t.Helper()
+
+	if mmDB.inspectFuncDB != nil {
+		mmDB.inspectFuncDB()
+	}
+
+	if mmDB.DBMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDB.DBMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmDB.DBMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDB.t.Fatal("No results are set for the ClientMock.DB")
+		}
+		return (*mm_results).d1
+	}
+	if mmDB.funcDB != nil {
+		return mmDB.funcDB()
+	}
+	mmDB.t.Fatalf("Unexpected call to ClientMock.DB.")
+	return
+}
+
+// DBAfterCounter returns a count of finished ClientMock.DB invocations
+func (mmDB *ClientMock) DBAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDB.afterDBCounter)
+}
+
+// DBBeforeCounter returns a count of ClientMock.DB invocations
+func (mmDB *ClientMock) DBBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDB.beforeDBCounter)
+}
+
+// MinimockDBDone returns true if the count of the DB invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockDBDone() bool {
+	if m.DBMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DBMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DBMock.invocationsDone()
+}
+
+// MinimockDBInspect logs each unmet expectation
+func (m *ClientMock) MinimockDBInspect() {
+	for _, e := range m.DBMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.DB")
+		}
+	}
+
+	afterDBCounter := mm_atomic.LoadUint64(&m.afterDBCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DBMock.defaultExpectation != nil && afterDBCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.DB at\n%s", m.DBMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDB != nil && afterDBCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.DB at\n%s", m.funcDBOrigin)
+	}
+
+	if !m.DBMock.invocationsDone() && afterDBCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.DB at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DBMock.expectedInvocations), m.DBMock.expectedInvocationsOrigin, afterDBCounter)
+	}
+}
+
+type mClientMockReplica struct {
+	optional           bool
+	mock               *ClientMock
+	defaultExpectation *ClientMockReplicaExpectation
+	expectations       []*ClientMockReplicaExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientMockReplicaExpectation specifies expectation struct of the Client.Replica
+type ClientMockReplicaExpectation struct {
+	mock *ClientMock
+
+	results      *ClientMockReplicaResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ClientMockReplicaResults contains results of the Client.Replica
+type ClientMockReplicaResults struct {
+	d1 mm_db.DB
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReplica *mClientMockReplica) Optional() *mClientMockReplica {
+	mmReplica.optional = true
+	return mmReplica
+}
+
+// Expect sets up expected params for Client.Replica
+func (mmReplica *mClientMockReplica) Expect() *mClientMockReplica {
+	if mmReplica.mock.funcReplica != nil {
+		mmReplica.mock.t.Fatalf("ClientMock.Replica mock is already set by Set")
+	}
+
+	if mmReplica.defaultExpectation == nil {
+		mmReplica.defaultExpectation = &ClientMockReplicaExpectation{}
+	}
+
+	return mmReplica
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.Replica
+func (mmReplica *mClientMockReplica) Inspect(f func()) *mClientMockReplica {
+	if mmReplica.mock.inspectFuncReplica != nil {
+		mmReplica.mock.t.Fatalf("Inspect function is already set for ClientMock.Replica")
+	}
+
+	mmReplica.mock.inspectFuncReplica = f
+
+	return mmReplica
+}
+
+// Return sets up results that will be returned by Client.Replica
+func (mmReplica *mClientMockReplica) Return(d1 mm_db.DB) *ClientMock {
+	if mmReplica.mock.funcReplica != nil {
+		mmReplica.mock.t.Fatalf("ClientMock.Replica mock is already set by Set")
+	}
+
+	if mmReplica.defaultExpectation == nil {
+		mmReplica.defaultExpectation = &ClientMockReplicaExpectation{mock: mmReplica.mock}
+	}
+	mmReplica.defaultExpectation.results = &ClientMockReplicaResults{d1}
+	mmReplica.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReplica.mock
+}
+
+// Set uses given function f to mock the Client.Replica method
+func (mmReplica *mClientMockReplica) Set(f func() (d1 mm_db.DB)) *ClientMock {
+	if mmReplica.defaultExpectation != nil {
+		mmReplica.mock.t.Fatalf("Default expectation is already set for the Client.Replica method")
+	}
+
+	if len(mmReplica.expectations) > 0 {
+		mmReplica.mock.t.Fatalf("Some expectations are already set for the Client.Replica method")
+	}
+
+	mmReplica.mock.funcReplica = f
+	mmReplica.mock.funcReplicaOrigin = minimock.CallerInfo(1)
+	return mmReplica.mock
+}
+
+// Times sets number of times Client.Replica should be invoked
+func (mmReplica *mClientMockReplica) Times(n uint64) *mClientMockReplica {
+	if n == 0 {
+		mmReplica.mock.t.Fatalf("Times of ClientMock.Replica mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReplica.expectedInvocations, n)
+	mmReplica.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReplica
+}
+
+func (mmReplica *mClientMockReplica) invocationsDone() bool {
+	if len(mmReplica.expectations) == 0 && mmReplica.defaultExpectation == nil && mmReplica.mock.funcReplica == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReplica.mock.afterReplicaCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplica.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Replica implements mm_db.Client
+func (mmReplica *ClientMock) Replica() (d1 mm_db.DB) {
+	mm_atomic.AddUint64(&mmReplica.beforeReplicaCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplica.afterReplicaCounter, 1)
+
+	mmReplica.t.Helper()
+
+	if mmReplica.inspectFuncReplica != nil {
+		mmReplica.inspectFuncReplica()
+	}
+
+	if mmReplica.ReplicaMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplica.ReplicaMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmReplica.ReplicaMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplica.t.Fatal("No results are set for the ClientMock.Replica")
+		}
+		return (*mm_results).d1
+	}
+	if mmReplica.funcReplica != nil {
+		return mmReplica.funcReplica()
+	}
+	mmReplica.t.Fatalf("Unexpected call to ClientMock.Replica.")
+	return
+}
+
+// ReplicaAfterCounter returns a count of finished ClientMock.Replica invocations
+func (mmReplica *ClientMock) ReplicaAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplica.afterReplicaCounter)
+}
+
+// ReplicaBeforeCounter returns a count of ClientMock.Replica invocations
+func (mmReplica *ClientMock) ReplicaBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplica.beforeReplicaCounter)
+}
+
+// MinimockReplicaDone returns true if the count of the Replica invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockReplicaDone() bool {
+	if m.ReplicaMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReplicaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReplicaMock.invocationsDone()
+}
+
+// MinimockReplicaInspect logs each unmet expectation
+func (m *ClientMock) MinimockReplicaInspect() {
+	for _, e := range m.ReplicaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ClientMock.Replica")
+		}
+	}
+
+	afterReplicaCounter := mm_atomic.LoadUint64(&m.afterReplicaCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplicaMock.defaultExpectation != nil && afterReplicaCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Replica at\n%s", m.ReplicaMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplica != nil && afterReplicaCounter < 1 {
+		m.t.Errorf("Expected call to ClientMock.Replica at\n%s", m.funcReplicaOrigin)
+	}
+
+	if !m.ReplicaMock.invocationsDone() && afterReplicaCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientMock.Replica at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplicaMock.expectedInvocations), m.ReplicaMock.expectedInvocationsOrigin, afterReplicaCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCloseInspect()
+
+			m.MinimockDBInspect()
+
+			m.MinimockReplicaInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCloseDone() &&
+		m.MinimockDBDone() &&
+		m.MinimockReplicaDone()
+}
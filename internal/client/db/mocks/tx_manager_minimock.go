@@ -0,0 +1,2579 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/ipv02/chat-server/internal/client/db.TxManager -o tx_manager_minimock.go -n TxManagerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_db "github.com/ipv02/chat-server/internal/client/db"
+	"github.com/jackc/pgx/v4"
+)
+
+// TxManagerMock implements mm_db.TxManager
+type TxManagerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcReadCommitted          func(ctx context.Context, f mm_db.Handler) (err error)
+	funcReadCommittedOrigin    string
+	inspectFuncReadCommitted   func(ctx context.Context, f mm_db.Handler)
+	afterReadCommittedCounter  uint64
+	beforeReadCommittedCounter uint64
+	ReadCommittedMock          mTxManagerMockReadCommitted
+
+	funcReadOnly          func(ctx context.Context, f mm_db.Handler) (err error)
+	funcReadOnlyOrigin    string
+	inspectFuncReadOnly   func(ctx context.Context, f mm_db.Handler)
+	afterReadOnlyCounter  uint64
+	beforeReadOnlyCounter uint64
+	ReadOnlyMock          mTxManagerMockReadOnly
+
+	funcReadOnlySerializable          func(ctx context.Context, f mm_db.Handler) (err error)
+	funcReadOnlySerializableOrigin    string
+	inspectFuncReadOnlySerializable   func(ctx context.Context, f mm_db.Handler)
+	afterReadOnlySerializableCounter  uint64
+	beforeReadOnlySerializableCounter uint64
+	ReadOnlySerializableMock          mTxManagerMockReadOnlySerializable
+
+	funcRepeatableRead          func(ctx context.Context, f mm_db.Handler) (err error)
+	funcRepeatableReadOrigin    string
+	inspectFuncRepeatableRead   func(ctx context.Context, f mm_db.Handler)
+	afterRepeatableReadCounter  uint64
+	beforeRepeatableReadCounter uint64
+	RepeatableReadMock          mTxManagerMockRepeatableRead
+
+	funcSavepoint          func(ctx context.Context, f mm_db.Handler) (err error)
+	funcSavepointOrigin    string
+	inspectFuncSavepoint   func(ctx context.Context, f mm_db.Handler)
+	afterSavepointCounter  uint64
+	beforeSavepointCounter uint64
+	SavepointMock          mTxManagerMockSavepoint
+
+	funcSerializable          func(ctx context.Context, f mm_db.Handler) (err error)
+	funcSerializableOrigin    string
+	inspectFuncSerializable   func(ctx context.Context, f mm_db.Handler)
+	afterSerializableCounter  uint64
+	beforeSerializableCounter uint64
+	SerializableMock          mTxManagerMockSerializable
+
+	funcTransaction          func(ctx context.Context, opts pgx.TxOptions, f mm_db.Handler) (err error)
+	funcTransactionOrigin    string
+	inspectFuncTransaction   func(ctx context.Context, opts pgx.TxOptions, f mm_db.Handler)
+	afterTransactionCounter  uint64
+	beforeTransactionCounter uint64
+	TransactionMock          mTxManagerMockTransaction
+}
+
+// NewTxManagerMock returns a mock for mm_db.TxManager
+func NewTxManagerMock(t minimock.Tester) *TxManagerMock {
+	m := &TxManagerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ReadCommittedMock = mTxManagerMockReadCommitted{mock: m}
+	m.ReadCommittedMock.callArgs = []*TxManagerMockReadCommittedParams{}
+
+	m.ReadOnlyMock = mTxManagerMockReadOnly{mock: m}
+	m.ReadOnlyMock.callArgs = []*TxManagerMockReadOnlyParams{}
+
+	m.ReadOnlySerializableMock = mTxManagerMockReadOnlySerializable{mock: m}
+	m.ReadOnlySerializableMock.callArgs = []*TxManagerMockReadOnlySerializableParams{}
+
+	m.RepeatableReadMock = mTxManagerMockRepeatableRead{mock: m}
+	m.RepeatableReadMock.callArgs = []*TxManagerMockRepeatableReadParams{}
+
+	m.SavepointMock = mTxManagerMockSavepoint{mock: m}
+	m.SavepointMock.callArgs = []*TxManagerMockSavepointParams{}
+
+	m.SerializableMock = mTxManagerMockSerializable{mock: m}
+	m.SerializableMock.callArgs = []*TxManagerMockSerializableParams{}
+
+	m.TransactionMock = mTxManagerMockTransaction{mock: m}
+	m.TransactionMock.callArgs = []*TxManagerMockTransactionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTxManagerMockReadCommitted struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockReadCommittedExpectation
+	expectations       []*TxManagerMockReadCommittedExpectation
+
+	callArgs []*TxManagerMockReadCommittedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockReadCommittedExpectation specifies expectation struct of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockReadCommittedParams
+	paramPtrs          *TxManagerMockReadCommittedParamPtrs
+	expectationOrigins TxManagerMockReadCommittedExpectationOrigins
+	results            *TxManagerMockReadCommittedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockReadCommittedParams contains parameters of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedParams struct {
+	ctx context.Context
+	f   mm_db.Handler
+}
+
+// TxManagerMockReadCommittedParamPtrs contains pointers to parameters of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedParamPtrs struct {
+	ctx *context.Context
+	f   *mm_db.Handler
+}
+
+// TxManagerMockReadCommittedResults contains results of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedResults struct {
+	err error
+}
+
+// TxManagerMockReadCommittedOrigins contains origins of expectations of the TxManager.ReadCommitted
+type TxManagerMockReadCommittedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originF   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReadCommitted *mTxManagerMockReadCommitted) Optional() *mTxManagerMockReadCommitted {
+	mmReadCommitted.optional = true
+	return mmReadCommitted
+}
+
+// Expect sets up expected params for TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) Expect(ctx context.Context, f mm_db.Handler) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{}
+	}
+
+	if mmReadCommitted.defaultExpectation.paramPtrs != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by ExpectParams functions")
+	}
+
+	mmReadCommitted.defaultExpectation.params = &TxManagerMockReadCommittedParams{ctx, f}
+	mmReadCommitted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReadCommitted.expectations {
+		if minimock.Equal(e.params, mmReadCommitted.defaultExpectation.params) {
+			mmReadCommitted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadCommitted.defaultExpectation.params)
+		}
+	}
+
+	return mmReadCommitted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) ExpectCtxParam1(ctx context.Context) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{}
+	}
+
+	if mmReadCommitted.defaultExpectation.params != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Expect")
+	}
+
+	if mmReadCommitted.defaultExpectation.paramPtrs == nil {
+		mmReadCommitted.defaultExpectation.paramPtrs = &TxManagerMockReadCommittedParamPtrs{}
+	}
+	mmReadCommitted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReadCommitted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReadCommitted
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) ExpectFParam2(f mm_db.Handler) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{}
+	}
+
+	if mmReadCommitted.defaultExpectation.params != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Expect")
+	}
+
+	if mmReadCommitted.defaultExpectation.paramPtrs == nil {
+		mmReadCommitted.defaultExpectation.paramPtrs = &TxManagerMockReadCommittedParamPtrs{}
+	}
+	mmReadCommitted.defaultExpectation.paramPtrs.f = &f
+	mmReadCommitted.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmReadCommitted
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) Inspect(f func(ctx context.Context, f mm_db.Handler)) *mTxManagerMockReadCommitted {
+	if mmReadCommitted.mock.inspectFuncReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("Inspect function is already set for TxManagerMock.ReadCommitted")
+	}
+
+	mmReadCommitted.mock.inspectFuncReadCommitted = f
+
+	return mmReadCommitted
+}
+
+// Return sets up results that will be returned by TxManager.ReadCommitted
+func (mmReadCommitted *mTxManagerMockReadCommitted) Return(err error) *TxManagerMock {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	if mmReadCommitted.defaultExpectation == nil {
+		mmReadCommitted.defaultExpectation = &TxManagerMockReadCommittedExpectation{mock: mmReadCommitted.mock}
+	}
+	mmReadCommitted.defaultExpectation.results = &TxManagerMockReadCommittedResults{err}
+	mmReadCommitted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReadCommitted.mock
+}
+
+// Set uses given function f to mock the TxManager.ReadCommitted method
+func (mmReadCommitted *mTxManagerMockReadCommitted) Set(f func(ctx context.Context, f mm_db.Handler) (err error)) *TxManagerMock {
+	if mmReadCommitted.defaultExpectation != nil {
+		mmReadCommitted.mock.t.Fatalf("Default expectation is already set for the TxManager.ReadCommitted method")
+	}
+
+	if len(mmReadCommitted.expectations) > 0 {
+		mmReadCommitted.mock.t.Fatalf("Some expectations are already set for the TxManager.ReadCommitted method")
+	}
+
+	mmReadCommitted.mock.funcReadCommitted = f
+	mmReadCommitted.mock.funcReadCommittedOrigin = minimock.CallerInfo(1)
+	return mmReadCommitted.mock
+}
+
+// When sets expectation for the TxManager.ReadCommitted which will trigger the result defined by the following
+// Then helper
+func (mmReadCommitted *mTxManagerMockReadCommitted) When(ctx context.Context, f mm_db.Handler) *TxManagerMockReadCommittedExpectation {
+	if mmReadCommitted.mock.funcReadCommitted != nil {
+		mmReadCommitted.mock.t.Fatalf("TxManagerMock.ReadCommitted mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockReadCommittedExpectation{
+		mock:               mmReadCommitted.mock,
+		params:             &TxManagerMockReadCommittedParams{ctx, f},
+		expectationOrigins: TxManagerMockReadCommittedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReadCommitted.expectations = append(mmReadCommitted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.ReadCommitted return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockReadCommittedExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockReadCommittedResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.ReadCommitted should be invoked
+func (mmReadCommitted *mTxManagerMockReadCommitted) Times(n uint64) *mTxManagerMockReadCommitted {
+	if n == 0 {
+		mmReadCommitted.mock.t.Fatalf("Times of TxManagerMock.ReadCommitted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReadCommitted.expectedInvocations, n)
+	mmReadCommitted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReadCommitted
+}
+
+func (mmReadCommitted *mTxManagerMockReadCommitted) invocationsDone() bool {
+	if len(mmReadCommitted.expectations) == 0 && mmReadCommitted.defaultExpectation == nil && mmReadCommitted.mock.funcReadCommitted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReadCommitted.mock.afterReadCommittedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReadCommitted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReadCommitted implements mm_db.TxManager
+func (mmReadCommitted *TxManagerMock) ReadCommitted(ctx context.Context, f mm_db.Handler) (err error) {
+	mm_atomic.AddUint64(&mmReadCommitted.beforeReadCommittedCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadCommitted.afterReadCommittedCounter, 1)
+
+	mmReadCommitted.t.Helper()
+
+	if mmReadCommitted.inspectFuncReadCommitted != nil {
+		mmReadCommitted.inspectFuncReadCommitted(ctx, f)
+	}
+
+	mm_params := TxManagerMockReadCommittedParams{ctx, f}
+
+	// Record call args
+	mmReadCommitted.ReadCommittedMock.mutex.Lock()
+	mmReadCommitted.ReadCommittedMock.callArgs = append(mmReadCommitted.ReadCommittedMock.callArgs, &mm_params)
+	mmReadCommitted.ReadCommittedMock.mutex.Unlock()
+
+	for _, e := range mmReadCommitted.ReadCommittedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReadCommitted.ReadCommittedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadCommitted.ReadCommittedMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadCommitted.ReadCommittedMock.defaultExpectation.params
+		mm_want_ptrs := mmReadCommitted.ReadCommittedMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockReadCommittedParams{ctx, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadCommitted.t.Errorf("TxManagerMock.ReadCommitted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadCommitted.ReadCommittedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmReadCommitted.t.Errorf("TxManagerMock.ReadCommitted got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadCommitted.ReadCommittedMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadCommitted.t.Errorf("TxManagerMock.ReadCommitted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReadCommitted.ReadCommittedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadCommitted.ReadCommittedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadCommitted.t.Fatal("No results are set for the TxManagerMock.ReadCommitted")
+		}
+		return (*mm_results).err
+	}
+	if mmReadCommitted.funcReadCommitted != nil {
+		return mmReadCommitted.funcReadCommitted(ctx, f)
+	}
+	mmReadCommitted.t.Fatalf("Unexpected call to TxManagerMock.ReadCommitted. %v %v", ctx, f)
+	return
+}
+
+// ReadCommittedAfterCounter returns a count of finished TxManagerMock.ReadCommitted invocations
+func (mmReadCommitted *TxManagerMock) ReadCommittedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadCommitted.afterReadCommittedCounter)
+}
+
+// ReadCommittedBeforeCounter returns a count of TxManagerMock.ReadCommitted invocations
+func (mmReadCommitted *TxManagerMock) ReadCommittedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadCommitted.beforeReadCommittedCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.ReadCommitted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadCommitted *mTxManagerMockReadCommitted) Calls() []*TxManagerMockReadCommittedParams {
+	mmReadCommitted.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockReadCommittedParams, len(mmReadCommitted.callArgs))
+	copy(argCopy, mmReadCommitted.callArgs)
+
+	mmReadCommitted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadCommittedDone returns true if the count of the ReadCommitted invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockReadCommittedDone() bool {
+	if m.ReadCommittedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReadCommittedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReadCommittedMock.invocationsDone()
+}
+
+// MinimockReadCommittedInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockReadCommittedInspect() {
+	for _, e := range m.ReadCommittedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReadCommittedCounter := mm_atomic.LoadUint64(&m.afterReadCommittedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadCommittedMock.defaultExpectation != nil && afterReadCommittedCounter < 1 {
+		if m.ReadCommittedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s", m.ReadCommittedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s with params: %#v", m.ReadCommittedMock.defaultExpectation.expectationOrigins.origin, *m.ReadCommittedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadCommitted != nil && afterReadCommittedCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.ReadCommitted at\n%s", m.funcReadCommittedOrigin)
+	}
+
+	if !m.ReadCommittedMock.invocationsDone() && afterReadCommittedCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.ReadCommitted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReadCommittedMock.expectedInvocations), m.ReadCommittedMock.expectedInvocationsOrigin, afterReadCommittedCounter)
+	}
+}
+
+type mTxManagerMockReadOnly struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockReadOnlyExpectation
+	expectations       []*TxManagerMockReadOnlyExpectation
+
+	callArgs []*TxManagerMockReadOnlyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockReadOnlyExpectation specifies expectation struct of the TxManager.ReadOnly
+type TxManagerMockReadOnlyExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockReadOnlyParams
+	paramPtrs          *TxManagerMockReadOnlyParamPtrs
+	expectationOrigins TxManagerMockReadOnlyExpectationOrigins
+	results            *TxManagerMockReadOnlyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockReadOnlyParams contains parameters of the TxManager.ReadOnly
+type TxManagerMockReadOnlyParams struct {
+	ctx context.Context
+	f   mm_db.Handler
+}
+
+// TxManagerMockReadOnlyParamPtrs contains pointers to parameters of the TxManager.ReadOnly
+type TxManagerMockReadOnlyParamPtrs struct {
+	ctx *context.Context
+	f   *mm_db.Handler
+}
+
+// TxManagerMockReadOnlyResults contains results of the TxManager.ReadOnly
+type TxManagerMockReadOnlyResults struct {
+	err error
+}
+
+// TxManagerMockReadOnlyOrigins contains origins of expectations of the TxManager.ReadOnly
+type TxManagerMockReadOnlyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originF   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReadOnly *mTxManagerMockReadOnly) Optional() *mTxManagerMockReadOnly {
+	mmReadOnly.optional = true
+	return mmReadOnly
+}
+
+// Expect sets up expected params for TxManager.ReadOnly
+func (mmReadOnly *mTxManagerMockReadOnly) Expect(ctx context.Context, f mm_db.Handler) *mTxManagerMockReadOnly {
+	if mmReadOnly.mock.funcReadOnly != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by Set")
+	}
+
+	if mmReadOnly.defaultExpectation == nil {
+		mmReadOnly.defaultExpectation = &TxManagerMockReadOnlyExpectation{}
+	}
+
+	if mmReadOnly.defaultExpectation.paramPtrs != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by ExpectParams functions")
+	}
+
+	mmReadOnly.defaultExpectation.params = &TxManagerMockReadOnlyParams{ctx, f}
+	mmReadOnly.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReadOnly.expectations {
+		if minimock.Equal(e.params, mmReadOnly.defaultExpectation.params) {
+			mmReadOnly.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadOnly.defaultExpectation.params)
+		}
+	}
+
+	return mmReadOnly
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.ReadOnly
+func (mmReadOnly *mTxManagerMockReadOnly) ExpectCtxParam1(ctx context.Context) *mTxManagerMockReadOnly {
+	if mmReadOnly.mock.funcReadOnly != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by Set")
+	}
+
+	if mmReadOnly.defaultExpectation == nil {
+		mmReadOnly.defaultExpectation = &TxManagerMockReadOnlyExpectation{}
+	}
+
+	if mmReadOnly.defaultExpectation.params != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by Expect")
+	}
+
+	if mmReadOnly.defaultExpectation.paramPtrs == nil {
+		mmReadOnly.defaultExpectation.paramPtrs = &TxManagerMockReadOnlyParamPtrs{}
+	}
+	mmReadOnly.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReadOnly.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReadOnly
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.ReadOnly
+func (mmReadOnly *mTxManagerMockReadOnly) ExpectFParam2(f mm_db.Handler) *mTxManagerMockReadOnly {
+	if mmReadOnly.mock.funcReadOnly != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by Set")
+	}
+
+	if mmReadOnly.defaultExpectation == nil {
+		mmReadOnly.defaultExpectation = &TxManagerMockReadOnlyExpectation{}
+	}
+
+	if mmReadOnly.defaultExpectation.params != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by Expect")
+	}
+
+	if mmReadOnly.defaultExpectation.paramPtrs == nil {
+		mmReadOnly.defaultExpectation.paramPtrs = &TxManagerMockReadOnlyParamPtrs{}
+	}
+	mmReadOnly.defaultExpectation.paramPtrs.f = &f
+	mmReadOnly.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmReadOnly
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.ReadOnly
+func (mmReadOnly *mTxManagerMockReadOnly) Inspect(f func(ctx context.Context, f mm_db.Handler)) *mTxManagerMockReadOnly {
+	if mmReadOnly.mock.inspectFuncReadOnly != nil {
+		mmReadOnly.mock.t.Fatalf("Inspect function is already set for TxManagerMock.ReadOnly")
+	}
+
+	mmReadOnly.mock.inspectFuncReadOnly = f
+
+	return mmReadOnly
+}
+
+// Return sets up results that will be returned by TxManager.ReadOnly
+func (mmReadOnly *mTxManagerMockReadOnly) Return(err error) *TxManagerMock {
+	if mmReadOnly.mock.funcReadOnly != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by Set")
+	}
+
+	if mmReadOnly.defaultExpectation == nil {
+		mmReadOnly.defaultExpectation = &TxManagerMockReadOnlyExpectation{mock: mmReadOnly.mock}
+	}
+	mmReadOnly.defaultExpectation.results = &TxManagerMockReadOnlyResults{err}
+	mmReadOnly.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReadOnly.mock
+}
+
+// Set uses given function f to mock the TxManager.ReadOnly method
+func (mmReadOnly *mTxManagerMockReadOnly) Set(f func(ctx context.Context, f mm_db.Handler) (err error)) *TxManagerMock {
+	if mmReadOnly.defaultExpectation != nil {
+		mmReadOnly.mock.t.Fatalf("Default expectation is already set for the TxManager.ReadOnly method")
+	}
+
+	if len(mmReadOnly.expectations) > 0 {
+		mmReadOnly.mock.t.Fatalf("Some expectations are already set for the TxManager.ReadOnly method")
+	}
+
+	mmReadOnly.mock.funcReadOnly = f
+	mmReadOnly.mock.funcReadOnlyOrigin = minimock.CallerInfo(1)
+	return mmReadOnly.mock
+}
+
+// When sets expectation for the TxManager.ReadOnly which will trigger the result defined by the following
+// Then helper
+func (mmReadOnly *mTxManagerMockReadOnly) When(ctx context.Context, f mm_db.Handler) *TxManagerMockReadOnlyExpectation {
+	if mmReadOnly.mock.funcReadOnly != nil {
+		mmReadOnly.mock.t.Fatalf("TxManagerMock.ReadOnly mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockReadOnlyExpectation{
+		mock:               mmReadOnly.mock,
+		params:             &TxManagerMockReadOnlyParams{ctx, f},
+		expectationOrigins: TxManagerMockReadOnlyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReadOnly.expectations = append(mmReadOnly.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.ReadOnly return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockReadOnlyExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockReadOnlyResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.ReadOnly should be invoked
+func (mmReadOnly *mTxManagerMockReadOnly) Times(n uint64) *mTxManagerMockReadOnly {
+	if n == 0 {
+		mmReadOnly.mock.t.Fatalf("Times of TxManagerMock.ReadOnly mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReadOnly.expectedInvocations, n)
+	mmReadOnly.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReadOnly
+}
+
+func (mmReadOnly *mTxManagerMockReadOnly) invocationsDone() bool {
+	if len(mmReadOnly.expectations) == 0 && mmReadOnly.defaultExpectation == nil && mmReadOnly.mock.funcReadOnly == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReadOnly.mock.afterReadOnlyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReadOnly.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReadOnly implements mm_db.TxManager
+func (mmReadOnly *TxManagerMock) ReadOnly(ctx context.Context, f mm_db.Handler) (err error) {
+	mm_atomic.AddUint64(&mmReadOnly.beforeReadOnlyCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadOnly.afterReadOnlyCounter, 1)
+
+	mmReadOnly.t.Helper()
+
+	if mmReadOnly.inspectFuncReadOnly != nil {
+		mmReadOnly.inspectFuncReadOnly(ctx, f)
+	}
+
+	mm_params := TxManagerMockReadOnlyParams{ctx, f}
+
+	// Record call args
+	mmReadOnly.ReadOnlyMock.mutex.Lock()
+	mmReadOnly.ReadOnlyMock.callArgs = append(mmReadOnly.ReadOnlyMock.callArgs, &mm_params)
+	mmReadOnly.ReadOnlyMock.mutex.Unlock()
+
+	for _, e := range mmReadOnly.ReadOnlyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReadOnly.ReadOnlyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadOnly.ReadOnlyMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadOnly.ReadOnlyMock.defaultExpectation.params
+		mm_want_ptrs := mmReadOnly.ReadOnlyMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockReadOnlyParams{ctx, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadOnly.t.Errorf("TxManagerMock.ReadOnly got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadOnly.ReadOnlyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmReadOnly.t.Errorf("TxManagerMock.ReadOnly got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadOnly.ReadOnlyMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadOnly.t.Errorf("TxManagerMock.ReadOnly got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReadOnly.ReadOnlyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadOnly.ReadOnlyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadOnly.t.Fatal("No results are set for the TxManagerMock.ReadOnly")
+		}
+		return (*mm_results).err
+	}
+	if mmReadOnly.funcReadOnly != nil {
+		return mmReadOnly.funcReadOnly(ctx, f)
+	}
+	mmReadOnly.t.Fatalf("Unexpected call to TxManagerMock.ReadOnly. %v %v", ctx, f)
+	return
+}
+
+// ReadOnlyAfterCounter returns a count of finished TxManagerMock.ReadOnly invocations
+func (mmReadOnly *TxManagerMock) ReadOnlyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadOnly.afterReadOnlyCounter)
+}
+
+// ReadOnlyBeforeCounter returns a count of TxManagerMock.ReadOnly invocations
+func (mmReadOnly *TxManagerMock) ReadOnlyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadOnly.beforeReadOnlyCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.ReadOnly.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadOnly *mTxManagerMockReadOnly) Calls() []*TxManagerMockReadOnlyParams {
+	mmReadOnly.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockReadOnlyParams, len(mmReadOnly.callArgs))
+	copy(argCopy, mmReadOnly.callArgs)
+
+	mmReadOnly.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadOnlyDone returns true if the count of the ReadOnly invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockReadOnlyDone() bool {
+	if m.ReadOnlyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReadOnlyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReadOnlyMock.invocationsDone()
+}
+
+// MinimockReadOnlyInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockReadOnlyInspect() {
+	for _, e := range m.ReadOnlyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.ReadOnly at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReadOnlyCounter := mm_atomic.LoadUint64(&m.afterReadOnlyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadOnlyMock.defaultExpectation != nil && afterReadOnlyCounter < 1 {
+		if m.ReadOnlyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.ReadOnly at\n%s", m.ReadOnlyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.ReadOnly at\n%s with params: %#v", m.ReadOnlyMock.defaultExpectation.expectationOrigins.origin, *m.ReadOnlyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadOnly != nil && afterReadOnlyCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.ReadOnly at\n%s", m.funcReadOnlyOrigin)
+	}
+
+	if !m.ReadOnlyMock.invocationsDone() && afterReadOnlyCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.ReadOnly at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReadOnlyMock.expectedInvocations), m.ReadOnlyMock.expectedInvocationsOrigin, afterReadOnlyCounter)
+	}
+}
+
+type mTxManagerMockReadOnlySerializable struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockReadOnlySerializableExpectation
+	expectations       []*TxManagerMockReadOnlySerializableExpectation
+
+	callArgs []*TxManagerMockReadOnlySerializableParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockReadOnlySerializableExpectation specifies expectation struct of the TxManager.ReadOnlySerializable
+type TxManagerMockReadOnlySerializableExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockReadOnlySerializableParams
+	paramPtrs          *TxManagerMockReadOnlySerializableParamPtrs
+	expectationOrigins TxManagerMockReadOnlySerializableExpectationOrigins
+	results            *TxManagerMockReadOnlySerializableResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockReadOnlySerializableParams contains parameters of the TxManager.ReadOnlySerializable
+type TxManagerMockReadOnlySerializableParams struct {
+	ctx context.Context
+	f   mm_db.Handler
+}
+
+// TxManagerMockReadOnlySerializableParamPtrs contains pointers to parameters of the TxManager.ReadOnlySerializable
+type TxManagerMockReadOnlySerializableParamPtrs struct {
+	ctx *context.Context
+	f   *mm_db.Handler
+}
+
+// TxManagerMockReadOnlySerializableResults contains results of the TxManager.ReadOnlySerializable
+type TxManagerMockReadOnlySerializableResults struct {
+	err error
+}
+
+// TxManagerMockReadOnlySerializableOrigins contains origins of expectations of the TxManager.ReadOnlySerializable
+type TxManagerMockReadOnlySerializableExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originF   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) Optional() *mTxManagerMockReadOnlySerializable {
+	mmReadOnlySerializable.optional = true
+	return mmReadOnlySerializable
+}
+
+// Expect sets up expected params for TxManager.ReadOnlySerializable
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) Expect(ctx context.Context, f mm_db.Handler) *mTxManagerMockReadOnlySerializable {
+	if mmReadOnlySerializable.mock.funcReadOnlySerializable != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by Set")
+	}
+
+	if mmReadOnlySerializable.defaultExpectation == nil {
+		mmReadOnlySerializable.defaultExpectation = &TxManagerMockReadOnlySerializableExpectation{}
+	}
+
+	if mmReadOnlySerializable.defaultExpectation.paramPtrs != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by ExpectParams functions")
+	}
+
+	mmReadOnlySerializable.defaultExpectation.params = &TxManagerMockReadOnlySerializableParams{ctx, f}
+	mmReadOnlySerializable.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReadOnlySerializable.expectations {
+		if minimock.Equal(e.params, mmReadOnlySerializable.defaultExpectation.params) {
+			mmReadOnlySerializable.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadOnlySerializable.defaultExpectation.params)
+		}
+	}
+
+	return mmReadOnlySerializable
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.ReadOnlySerializable
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) ExpectCtxParam1(ctx context.Context) *mTxManagerMockReadOnlySerializable {
+	if mmReadOnlySerializable.mock.funcReadOnlySerializable != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by Set")
+	}
+
+	if mmReadOnlySerializable.defaultExpectation == nil {
+		mmReadOnlySerializable.defaultExpectation = &TxManagerMockReadOnlySerializableExpectation{}
+	}
+
+	if mmReadOnlySerializable.defaultExpectation.params != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by Expect")
+	}
+
+	if mmReadOnlySerializable.defaultExpectation.paramPtrs == nil {
+		mmReadOnlySerializable.defaultExpectation.paramPtrs = &TxManagerMockReadOnlySerializableParamPtrs{}
+	}
+	mmReadOnlySerializable.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReadOnlySerializable.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReadOnlySerializable
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.ReadOnlySerializable
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) ExpectFParam2(f mm_db.Handler) *mTxManagerMockReadOnlySerializable {
+	if mmReadOnlySerializable.mock.funcReadOnlySerializable != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by Set")
+	}
+
+	if mmReadOnlySerializable.defaultExpectation == nil {
+		mmReadOnlySerializable.defaultExpectation = &TxManagerMockReadOnlySerializableExpectation{}
+	}
+
+	if mmReadOnlySerializable.defaultExpectation.params != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by Expect")
+	}
+
+	if mmReadOnlySerializable.defaultExpectation.paramPtrs == nil {
+		mmReadOnlySerializable.defaultExpectation.paramPtrs = &TxManagerMockReadOnlySerializableParamPtrs{}
+	}
+	mmReadOnlySerializable.defaultExpectation.paramPtrs.f = &f
+	mmReadOnlySerializable.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmReadOnlySerializable
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.ReadOnlySerializable
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) Inspect(f func(ctx context.Context, f mm_db.Handler)) *mTxManagerMockReadOnlySerializable {
+	if mmReadOnlySerializable.mock.inspectFuncReadOnlySerializable != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("Inspect function is already set for TxManagerMock.ReadOnlySerializable")
+	}
+
+	mmReadOnlySerializable.mock.inspectFuncReadOnlySerializable = f
+
+	return mmReadOnlySerializable
+}
+
+// Return sets up results that will be returned by TxManager.ReadOnlySerializable
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) Return(err error) *TxManagerMock {
+	if mmReadOnlySerializable.mock.funcReadOnlySerializable != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by Set")
+	}
+
+	if mmReadOnlySerializable.defaultExpectation == nil {
+		mmReadOnlySerializable.defaultExpectation = &TxManagerMockReadOnlySerializableExpectation{mock: mmReadOnlySerializable.mock}
+	}
+	mmReadOnlySerializable.defaultExpectation.results = &TxManagerMockReadOnlySerializableResults{err}
+	mmReadOnlySerializable.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReadOnlySerializable.mock
+}
+
+// Set uses given function f to mock the TxManager.ReadOnlySerializable method
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) Set(f func(ctx context.Context, f mm_db.Handler) (err error)) *TxManagerMock {
+	if mmReadOnlySerializable.defaultExpectation != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("Default expectation is already set for the TxManager.ReadOnlySerializable method")
+	}
+
+	if len(mmReadOnlySerializable.expectations) > 0 {
+		mmReadOnlySerializable.mock.t.Fatalf("Some expectations are already set for the TxManager.ReadOnlySerializable method")
+	}
+
+	mmReadOnlySerializable.mock.funcReadOnlySerializable = f
+	mmReadOnlySerializable.mock.funcReadOnlySerializableOrigin = minimock.CallerInfo(1)
+	return mmReadOnlySerializable.mock
+}
+
+// When sets expectation for the TxManager.ReadOnlySerializable which will trigger the result defined by the following
+// Then helper
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) When(ctx context.Context, f mm_db.Handler) *TxManagerMockReadOnlySerializableExpectation {
+	if mmReadOnlySerializable.mock.funcReadOnlySerializable != nil {
+		mmReadOnlySerializable.mock.t.Fatalf("TxManagerMock.ReadOnlySerializable mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockReadOnlySerializableExpectation{
+		mock:               mmReadOnlySerializable.mock,
+		params:             &TxManagerMockReadOnlySerializableParams{ctx, f},
+		expectationOrigins: TxManagerMockReadOnlySerializableExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReadOnlySerializable.expectations = append(mmReadOnlySerializable.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.ReadOnlySerializable return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockReadOnlySerializableExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockReadOnlySerializableResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.ReadOnlySerializable should be invoked
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) Times(n uint64) *mTxManagerMockReadOnlySerializable {
+	if n == 0 {
+		mmReadOnlySerializable.mock.t.Fatalf("Times of TxManagerMock.ReadOnlySerializable mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReadOnlySerializable.expectedInvocations, n)
+	mmReadOnlySerializable.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReadOnlySerializable
+}
+
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) invocationsDone() bool {
+	if len(mmReadOnlySerializable.expectations) == 0 && mmReadOnlySerializable.defaultExpectation == nil && mmReadOnlySerializable.mock.funcReadOnlySerializable == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReadOnlySerializable.mock.afterReadOnlySerializableCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReadOnlySerializable.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReadOnlySerializable implements mm_db.TxManager
+func (mmReadOnlySerializable *TxManagerMock) ReadOnlySerializable(ctx context.Context, f mm_db.Handler) (err error) {
+	mm_atomic.AddUint64(&mmReadOnlySerializable.beforeReadOnlySerializableCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadOnlySerializable.afterReadOnlySerializableCounter, 1)
+
+	mmReadOnlySerializable.t.Helper()
+
+	if mmReadOnlySerializable.inspectFuncReadOnlySerializable != nil {
+		mmReadOnlySerializable.inspectFuncReadOnlySerializable(ctx, f)
+	}
+
+	mm_params := TxManagerMockReadOnlySerializableParams{ctx, f}
+
+	// Record call args
+	mmReadOnlySerializable.ReadOnlySerializableMock.mutex.Lock()
+	mmReadOnlySerializable.ReadOnlySerializableMock.callArgs = append(mmReadOnlySerializable.ReadOnlySerializableMock.callArgs, &mm_params)
+	mmReadOnlySerializable.ReadOnlySerializableMock.mutex.Unlock()
+
+	for _, e := range mmReadOnlySerializable.ReadOnlySerializableMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation.params
+		mm_want_ptrs := mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockReadOnlySerializableParams{ctx, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadOnlySerializable.t.Errorf("TxManagerMock.ReadOnlySerializable got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmReadOnlySerializable.t.Errorf("TxManagerMock.ReadOnlySerializable got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadOnlySerializable.t.Errorf("TxManagerMock.ReadOnlySerializable got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadOnlySerializable.ReadOnlySerializableMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadOnlySerializable.t.Fatal("No results are set for the TxManagerMock.ReadOnlySerializable")
+		}
+		return (*mm_results).err
+	}
+	if mmReadOnlySerializable.funcReadOnlySerializable != nil {
+		return mmReadOnlySerializable.funcReadOnlySerializable(ctx, f)
+	}
+	mmReadOnlySerializable.t.Fatalf("Unexpected call to TxManagerMock.ReadOnlySerializable. %v %v", ctx, f)
+	return
+}
+
+// ReadOnlySerializableAfterCounter returns a count of finished TxManagerMock.ReadOnlySerializable invocations
+func (mmReadOnlySerializable *TxManagerMock) ReadOnlySerializableAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadOnlySerializable.afterReadOnlySerializableCounter)
+}
+
+// ReadOnlySerializableBeforeCounter returns a count of TxManagerMock.ReadOnlySerializable invocations
+func (mmReadOnlySerializable *TxManagerMock) ReadOnlySerializableBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadOnlySerializable.beforeReadOnlySerializableCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.ReadOnlySerializable.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadOnlySerializable *mTxManagerMockReadOnlySerializable) Calls() []*TxManagerMockReadOnlySerializableParams {
+	mmReadOnlySerializable.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockReadOnlySerializableParams, len(mmReadOnlySerializable.callArgs))
+	copy(argCopy, mmReadOnlySerializable.callArgs)
+
+	mmReadOnlySerializable.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadOnlySerializableDone returns true if the count of the ReadOnlySerializable invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockReadOnlySerializableDone() bool {
+	if m.ReadOnlySerializableMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReadOnlySerializableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReadOnlySerializableMock.invocationsDone()
+}
+
+// MinimockReadOnlySerializableInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockReadOnlySerializableInspect() {
+	for _, e := range m.ReadOnlySerializableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.ReadOnlySerializable at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReadOnlySerializableCounter := mm_atomic.LoadUint64(&m.afterReadOnlySerializableCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadOnlySerializableMock.defaultExpectation != nil && afterReadOnlySerializableCounter < 1 {
+		if m.ReadOnlySerializableMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.ReadOnlySerializable at\n%s", m.ReadOnlySerializableMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.ReadOnlySerializable at\n%s with params: %#v", m.ReadOnlySerializableMock.defaultExpectation.expectationOrigins.origin, *m.ReadOnlySerializableMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadOnlySerializable != nil && afterReadOnlySerializableCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.ReadOnlySerializable at\n%s", m.funcReadOnlySerializableOrigin)
+	}
+
+	if !m.ReadOnlySerializableMock.invocationsDone() && afterReadOnlySerializableCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.ReadOnlySerializable at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReadOnlySerializableMock.expectedInvocations), m.ReadOnlySerializableMock.expectedInvocationsOrigin, afterReadOnlySerializableCounter)
+	}
+}
+
+type mTxManagerMockRepeatableRead struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockRepeatableReadExpectation
+	expectations       []*TxManagerMockRepeatableReadExpectation
+
+	callArgs []*TxManagerMockRepeatableReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockRepeatableReadExpectation specifies expectation struct of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockRepeatableReadParams
+	paramPtrs          *TxManagerMockRepeatableReadParamPtrs
+	expectationOrigins TxManagerMockRepeatableReadExpectationOrigins
+	results            *TxManagerMockRepeatableReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockRepeatableReadParams contains parameters of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadParams struct {
+	ctx context.Context
+	f   mm_db.Handler
+}
+
+// TxManagerMockRepeatableReadParamPtrs contains pointers to parameters of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadParamPtrs struct {
+	ctx *context.Context
+	f   *mm_db.Handler
+}
+
+// TxManagerMockRepeatableReadResults contains results of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadResults struct {
+	err error
+}
+
+// TxManagerMockRepeatableReadOrigins contains origins of expectations of the TxManager.RepeatableRead
+type TxManagerMockRepeatableReadExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originF   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Optional() *mTxManagerMockRepeatableRead {
+	mmRepeatableRead.optional = true
+	return mmRepeatableRead
+}
+
+// Expect sets up expected params for TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Expect(ctx context.Context, f mm_db.Handler) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{}
+	}
+
+	if mmRepeatableRead.defaultExpectation.paramPtrs != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by ExpectParams functions")
+	}
+
+	mmRepeatableRead.defaultExpectation.params = &TxManagerMockRepeatableReadParams{ctx, f}
+	mmRepeatableRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRepeatableRead.expectations {
+		if minimock.Equal(e.params, mmRepeatableRead.defaultExpectation.params) {
+			mmRepeatableRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRepeatableRead.defaultExpectation.params)
+		}
+	}
+
+	return mmRepeatableRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) ExpectCtxParam1(ctx context.Context) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{}
+	}
+
+	if mmRepeatableRead.defaultExpectation.params != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Expect")
+	}
+
+	if mmRepeatableRead.defaultExpectation.paramPtrs == nil {
+		mmRepeatableRead.defaultExpectation.paramPtrs = &TxManagerMockRepeatableReadParamPtrs{}
+	}
+	mmRepeatableRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRepeatableRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRepeatableRead
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) ExpectFParam2(f mm_db.Handler) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{}
+	}
+
+	if mmRepeatableRead.defaultExpectation.params != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Expect")
+	}
+
+	if mmRepeatableRead.defaultExpectation.paramPtrs == nil {
+		mmRepeatableRead.defaultExpectation.paramPtrs = &TxManagerMockRepeatableReadParamPtrs{}
+	}
+	mmRepeatableRead.defaultExpectation.paramPtrs.f = &f
+	mmRepeatableRead.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmRepeatableRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Inspect(f func(ctx context.Context, f mm_db.Handler)) *mTxManagerMockRepeatableRead {
+	if mmRepeatableRead.mock.inspectFuncRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("Inspect function is already set for TxManagerMock.RepeatableRead")
+	}
+
+	mmRepeatableRead.mock.inspectFuncRepeatableRead = f
+
+	return mmRepeatableRead
+}
+
+// Return sets up results that will be returned by TxManager.RepeatableRead
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Return(err error) *TxManagerMock {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	if mmRepeatableRead.defaultExpectation == nil {
+		mmRepeatableRead.defaultExpectation = &TxManagerMockRepeatableReadExpectation{mock: mmRepeatableRead.mock}
+	}
+	mmRepeatableRead.defaultExpectation.results = &TxManagerMockRepeatableReadResults{err}
+	mmRepeatableRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRepeatableRead.mock
+}
+
+// Set uses given function f to mock the TxManager.RepeatableRead method
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Set(f func(ctx context.Context, f mm_db.Handler) (err error)) *TxManagerMock {
+	if mmRepeatableRead.defaultExpectation != nil {
+		mmRepeatableRead.mock.t.Fatalf("Default expectation is already set for the TxManager.RepeatableRead method")
+	}
+
+	if len(mmRepeatableRead.expectations) > 0 {
+		mmRepeatableRead.mock.t.Fatalf("Some expectations are already set for the TxManager.RepeatableRead method")
+	}
+
+	mmRepeatableRead.mock.funcRepeatableRead = f
+	mmRepeatableRead.mock.funcRepeatableReadOrigin = minimock.CallerInfo(1)
+	return mmRepeatableRead.mock
+}
+
+// When sets expectation for the TxManager.RepeatableRead which will trigger the result defined by the following
+// Then helper
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) When(ctx context.Context, f mm_db.Handler) *TxManagerMockRepeatableReadExpectation {
+	if mmRepeatableRead.mock.funcRepeatableRead != nil {
+		mmRepeatableRead.mock.t.Fatalf("TxManagerMock.RepeatableRead mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockRepeatableReadExpectation{
+		mock:               mmRepeatableRead.mock,
+		params:             &TxManagerMockRepeatableReadParams{ctx, f},
+		expectationOrigins: TxManagerMockRepeatableReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRepeatableRead.expectations = append(mmRepeatableRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.RepeatableRead return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockRepeatableReadExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockRepeatableReadResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.RepeatableRead should be invoked
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Times(n uint64) *mTxManagerMockRepeatableRead {
+	if n == 0 {
+		mmRepeatableRead.mock.t.Fatalf("Times of TxManagerMock.RepeatableRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRepeatableRead.expectedInvocations, n)
+	mmRepeatableRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRepeatableRead
+}
+
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) invocationsDone() bool {
+	if len(mmRepeatableRead.expectations) == 0 && mmRepeatableRead.defaultExpectation == nil && mmRepeatableRead.mock.funcRepeatableRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRepeatableRead.mock.afterRepeatableReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRepeatableRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RepeatableRead implements mm_db.TxManager
+func (mmRepeatableRead *TxManagerMock) RepeatableRead(ctx context.Context, f mm_db.Handler) (err error) {
+	mm_atomic.AddUint64(&mmRepeatableRead.beforeRepeatableReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmRepeatableRead.afterRepeatableReadCounter, 1)
+
+	mmRepeatableRead.t.Helper()
+
+	if mmRepeatableRead.inspectFuncRepeatableRead != nil {
+		mmRepeatableRead.inspectFuncRepeatableRead(ctx, f)
+	}
+
+	mm_params := TxManagerMockRepeatableReadParams{ctx, f}
+
+	// Record call args
+	mmRepeatableRead.RepeatableReadMock.mutex.Lock()
+	mmRepeatableRead.RepeatableReadMock.callArgs = append(mmRepeatableRead.RepeatableReadMock.callArgs, &mm_params)
+	mmRepeatableRead.RepeatableReadMock.mutex.Unlock()
+
+	for _, e := range mmRepeatableRead.RepeatableReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRepeatableRead.RepeatableReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRepeatableRead.RepeatableReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmRepeatableRead.RepeatableReadMock.defaultExpectation.params
+		mm_want_ptrs := mmRepeatableRead.RepeatableReadMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockRepeatableReadParams{ctx, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRepeatableRead.t.Errorf("TxManagerMock.RepeatableRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepeatableRead.RepeatableReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmRepeatableRead.t.Errorf("TxManagerMock.RepeatableRead got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepeatableRead.RepeatableReadMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRepeatableRead.t.Errorf("TxManagerMock.RepeatableRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRepeatableRead.RepeatableReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRepeatableRead.RepeatableReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRepeatableRead.t.Fatal("No results are set for the TxManagerMock.RepeatableRead")
+		}
+		return (*mm_results).err
+	}
+	if mmRepeatableRead.funcRepeatableRead != nil {
+		return mmRepeatableRead.funcRepeatableRead(ctx, f)
+	}
+	mmRepeatableRead.t.Fatalf("Unexpected call to TxManagerMock.RepeatableRead. %v %v", ctx, f)
+	return
+}
+
+// RepeatableReadAfterCounter returns a count of finished TxManagerMock.RepeatableRead invocations
+func (mmRepeatableRead *TxManagerMock) RepeatableReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepeatableRead.afterRepeatableReadCounter)
+}
+
+// RepeatableReadBeforeCounter returns a count of TxManagerMock.RepeatableRead invocations
+func (mmRepeatableRead *TxManagerMock) RepeatableReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepeatableRead.beforeRepeatableReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.RepeatableRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRepeatableRead *mTxManagerMockRepeatableRead) Calls() []*TxManagerMockRepeatableReadParams {
+	mmRepeatableRead.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockRepeatableReadParams, len(mmRepeatableRead.callArgs))
+	copy(argCopy, mmRepeatableRead.callArgs)
+
+	mmRepeatableRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRepeatableReadDone returns true if the count of the RepeatableRead invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockRepeatableReadDone() bool {
+	if m.RepeatableReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RepeatableReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RepeatableReadMock.invocationsDone()
+}
+
+// MinimockRepeatableReadInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockRepeatableReadInspect() {
+	for _, e := range m.RepeatableReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRepeatableReadCounter := mm_atomic.LoadUint64(&m.afterRepeatableReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RepeatableReadMock.defaultExpectation != nil && afterRepeatableReadCounter < 1 {
+		if m.RepeatableReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s", m.RepeatableReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s with params: %#v", m.RepeatableReadMock.defaultExpectation.expectationOrigins.origin, *m.RepeatableReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRepeatableRead != nil && afterRepeatableReadCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.RepeatableRead at\n%s", m.funcRepeatableReadOrigin)
+	}
+
+	if !m.RepeatableReadMock.invocationsDone() && afterRepeatableReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.RepeatableRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RepeatableReadMock.expectedInvocations), m.RepeatableReadMock.expectedInvocationsOrigin, afterRepeatableReadCounter)
+	}
+}
+
+type mTxManagerMockSavepoint struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockSavepointExpectation
+	expectations       []*TxManagerMockSavepointExpectation
+
+	callArgs []*TxManagerMockSavepointParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockSavepointExpectation specifies expectation struct of the TxManager.Savepoint
+type TxManagerMockSavepointExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockSavepointParams
+	paramPtrs          *TxManagerMockSavepointParamPtrs
+	expectationOrigins TxManagerMockSavepointExpectationOrigins
+	results            *TxManagerMockSavepointResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockSavepointParams contains parameters of the TxManager.Savepoint
+type TxManagerMockSavepointParams struct {
+	ctx context.Context
+	f   mm_db.Handler
+}
+
+// TxManagerMockSavepointParamPtrs contains pointers to parameters of the TxManager.Savepoint
+type TxManagerMockSavepointParamPtrs struct {
+	ctx *context.Context
+	f   *mm_db.Handler
+}
+
+// TxManagerMockSavepointResults contains results of the TxManager.Savepoint
+type TxManagerMockSavepointResults struct {
+	err error
+}
+
+// TxManagerMockSavepointOrigins contains origins of expectations of the TxManager.Savepoint
+type TxManagerMockSavepointExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originF   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSavepoint *mTxManagerMockSavepoint) Optional() *mTxManagerMockSavepoint {
+	mmSavepoint.optional = true
+	return mmSavepoint
+}
+
+// Expect sets up expected params for TxManager.Savepoint
+func (mmSavepoint *mTxManagerMockSavepoint) Expect(ctx context.Context, f mm_db.Handler) *mTxManagerMockSavepoint {
+	if mmSavepoint.mock.funcSavepoint != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by Set")
+	}
+
+	if mmSavepoint.defaultExpectation == nil {
+		mmSavepoint.defaultExpectation = &TxManagerMockSavepointExpectation{}
+	}
+
+	if mmSavepoint.defaultExpectation.paramPtrs != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by ExpectParams functions")
+	}
+
+	mmSavepoint.defaultExpectation.params = &TxManagerMockSavepointParams{ctx, f}
+	mmSavepoint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSavepoint.expectations {
+		if minimock.Equal(e.params, mmSavepoint.defaultExpectation.params) {
+			mmSavepoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavepoint.defaultExpectation.params)
+		}
+	}
+
+	return mmSavepoint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.Savepoint
+func (mmSavepoint *mTxManagerMockSavepoint) ExpectCtxParam1(ctx context.Context) *mTxManagerMockSavepoint {
+	if mmSavepoint.mock.funcSavepoint != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by Set")
+	}
+
+	if mmSavepoint.defaultExpectation == nil {
+		mmSavepoint.defaultExpectation = &TxManagerMockSavepointExpectation{}
+	}
+
+	if mmSavepoint.defaultExpectation.params != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by Expect")
+	}
+
+	if mmSavepoint.defaultExpectation.paramPtrs == nil {
+		mmSavepoint.defaultExpectation.paramPtrs = &TxManagerMockSavepointParamPtrs{}
+	}
+	mmSavepoint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSavepoint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSavepoint
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.Savepoint
+func (mmSavepoint *mTxManagerMockSavepoint) ExpectFParam2(f mm_db.Handler) *mTxManagerMockSavepoint {
+	if mmSavepoint.mock.funcSavepoint != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by Set")
+	}
+
+	if mmSavepoint.defaultExpectation == nil {
+		mmSavepoint.defaultExpectation = &TxManagerMockSavepointExpectation{}
+	}
+
+	if mmSavepoint.defaultExpectation.params != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by Expect")
+	}
+
+	if mmSavepoint.defaultExpectation.paramPtrs == nil {
+		mmSavepoint.defaultExpectation.paramPtrs = &TxManagerMockSavepointParamPtrs{}
+	}
+	mmSavepoint.defaultExpectation.paramPtrs.f = &f
+	mmSavepoint.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmSavepoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.Savepoint
+func (mmSavepoint *mTxManagerMockSavepoint) Inspect(f func(ctx context.Context, f mm_db.Handler)) *mTxManagerMockSavepoint {
+	if mmSavepoint.mock.inspectFuncSavepoint != nil {
+		mmSavepoint.mock.t.Fatalf("Inspect function is already set for TxManagerMock.Savepoint")
+	}
+
+	mmSavepoint.mock.inspectFuncSavepoint = f
+
+	return mmSavepoint
+}
+
+// Return sets up results that will be returned by TxManager.Savepoint
+func (mmSavepoint *mTxManagerMockSavepoint) Return(err error) *TxManagerMock {
+	if mmSavepoint.mock.funcSavepoint != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by Set")
+	}
+
+	if mmSavepoint.defaultExpectation == nil {
+		mmSavepoint.defaultExpectation = &TxManagerMockSavepointExpectation{mock: mmSavepoint.mock}
+	}
+	mmSavepoint.defaultExpectation.results = &TxManagerMockSavepointResults{err}
+	mmSavepoint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSavepoint.mock
+}
+
+// Set uses given function f to mock the TxManager.Savepoint method
+func (mmSavepoint *mTxManagerMockSavepoint) Set(f func(ctx context.Context, f mm_db.Handler) (err error)) *TxManagerMock {
+	if mmSavepoint.defaultExpectation != nil {
+		mmSavepoint.mock.t.Fatalf("Default expectation is already set for the TxManager.Savepoint method")
+	}
+
+	if len(mmSavepoint.expectations) > 0 {
+		mmSavepoint.mock.t.Fatalf("Some expectations are already set for the TxManager.Savepoint method")
+	}
+
+	mmSavepoint.mock.funcSavepoint = f
+	mmSavepoint.mock.funcSavepointOrigin = minimock.CallerInfo(1)
+	return mmSavepoint.mock
+}
+
+// When sets expectation for the TxManager.Savepoint which will trigger the result defined by the following
+// Then helper
+func (mmSavepoint *mTxManagerMockSavepoint) When(ctx context.Context, f mm_db.Handler) *TxManagerMockSavepointExpectation {
+	if mmSavepoint.mock.funcSavepoint != nil {
+		mmSavepoint.mock.t.Fatalf("TxManagerMock.Savepoint mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockSavepointExpectation{
+		mock:               mmSavepoint.mock,
+		params:             &TxManagerMockSavepointParams{ctx, f},
+		expectationOrigins: TxManagerMockSavepointExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSavepoint.expectations = append(mmSavepoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.Savepoint return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockSavepointExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockSavepointResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.Savepoint should be invoked
+func (mmSavepoint *mTxManagerMockSavepoint) Times(n uint64) *mTxManagerMockSavepoint {
+	if n == 0 {
+		mmSavepoint.mock.t.Fatalf("Times of TxManagerMock.Savepoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSavepoint.expectedInvocations, n)
+	mmSavepoint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSavepoint
+}
+
+func (mmSavepoint *mTxManagerMockSavepoint) invocationsDone() bool {
+	if len(mmSavepoint.expectations) == 0 && mmSavepoint.defaultExpectation == nil && mmSavepoint.mock.funcSavepoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSavepoint.mock.afterSavepointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSavepoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Savepoint implements mm_db.TxManager
+func (mmSavepoint *TxManagerMock) Savepoint(ctx context.Context, f mm_db.Handler) (err error) {
+	mm_atomic.AddUint64(&mmSavepoint.beforeSavepointCounter, 1)
+	defer mm_atomic.AddUint64(&mmSavepoint.afterSavepointCounter, 1)
+
+	mmSavepoint.t.Helper()
+
+	if mmSavepoint.inspectFuncSavepoint != nil {
+		mmSavepoint.inspectFuncSavepoint(ctx, f)
+	}
+
+	mm_params := TxManagerMockSavepointParams{ctx, f}
+
+	// Record call args
+	mmSavepoint.SavepointMock.mutex.Lock()
+	mmSavepoint.SavepointMock.callArgs = append(mmSavepoint.SavepointMock.callArgs, &mm_params)
+	mmSavepoint.SavepointMock.mutex.Unlock()
+
+	for _, e := range mmSavepoint.SavepointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSavepoint.SavepointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSavepoint.SavepointMock.defaultExpectation.Counter, 1)
+		mm_want := mmSavepoint.SavepointMock.defaultExpectation.params
+		mm_want_ptrs := mmSavepoint.SavepointMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockSavepointParams{ctx, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSavepoint.t.Errorf("TxManagerMock.Savepoint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSavepoint.SavepointMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmSavepoint.t.Errorf("TxManagerMock.Savepoint got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSavepoint.SavepointMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSavepoint.t.Errorf("TxManagerMock.Savepoint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSavepoint.SavepointMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSavepoint.SavepointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSavepoint.t.Fatal("No results are set for the TxManagerMock.Savepoint")
+		}
+		return (*mm_results).err
+	}
+	if mmSavepoint.funcSavepoint != nil {
+		return mmSavepoint.funcSavepoint(ctx, f)
+	}
+	mmSavepoint.t.Fatalf("Unexpected call to TxManagerMock.Savepoint. %v %v", ctx, f)
+	return
+}
+
+// SavepointAfterCounter returns a count of finished TxManagerMock.Savepoint invocations
+func (mmSavepoint *TxManagerMock) SavepointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavepoint.afterSavepointCounter)
+}
+
+// SavepointBeforeCounter returns a count of TxManagerMock.Savepoint invocations
+func (mmSavepoint *TxManagerMock) SavepointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavepoint.beforeSavepointCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.Savepoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSavepoint *mTxManagerMockSavepoint) Calls() []*TxManagerMockSavepointParams {
+	mmSavepoint.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockSavepointParams, len(mmSavepoint.callArgs))
+	copy(argCopy, mmSavepoint.callArgs)
+
+	mmSavepoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSavepointDone returns true if the count of the Savepoint invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockSavepointDone() bool {
+	if m.SavepointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SavepointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SavepointMock.invocationsDone()
+}
+
+// MinimockSavepointInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockSavepointInspect() {
+	for _, e := range m.SavepointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.Savepoint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSavepointCounter := mm_atomic.LoadUint64(&m.afterSavepointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SavepointMock.defaultExpectation != nil && afterSavepointCounter < 1 {
+		if m.SavepointMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.Savepoint at\n%s", m.SavepointMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.Savepoint at\n%s with params: %#v", m.SavepointMock.defaultExpectation.expectationOrigins.origin, *m.SavepointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSavepoint != nil && afterSavepointCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.Savepoint at\n%s", m.funcSavepointOrigin)
+	}
+
+	if !m.SavepointMock.invocationsDone() && afterSavepointCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.Savepoint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SavepointMock.expectedInvocations), m.SavepointMock.expectedInvocationsOrigin, afterSavepointCounter)
+	}
+}
+
+type mTxManagerMockSerializable struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockSerializableExpectation
+	expectations       []*TxManagerMockSerializableExpectation
+
+	callArgs []*TxManagerMockSerializableParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockSerializableExpectation specifies expectation struct of the TxManager.Serializable
+type TxManagerMockSerializableExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockSerializableParams
+	paramPtrs          *TxManagerMockSerializableParamPtrs
+	expectationOrigins TxManagerMockSerializableExpectationOrigins
+	results            *TxManagerMockSerializableResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockSerializableParams contains parameters of the TxManager.Serializable
+type TxManagerMockSerializableParams struct {
+	ctx context.Context
+	f   mm_db.Handler
+}
+
+// TxManagerMockSerializableParamPtrs contains pointers to parameters of the TxManager.Serializable
+type TxManagerMockSerializableParamPtrs struct {
+	ctx *context.Context
+	f   *mm_db.Handler
+}
+
+// TxManagerMockSerializableResults contains results of the TxManager.Serializable
+type TxManagerMockSerializableResults struct {
+	err error
+}
+
+// TxManagerMockSerializableOrigins contains origins of expectations of the TxManager.Serializable
+type TxManagerMockSerializableExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originF   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSerializable *mTxManagerMockSerializable) Optional() *mTxManagerMockSerializable {
+	mmSerializable.optional = true
+	return mmSerializable
+}
+
+// Expect sets up expected params for TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) Expect(ctx context.Context, f mm_db.Handler) *mTxManagerMockSerializable {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{}
+	}
+
+	if mmSerializable.defaultExpectation.paramPtrs != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by ExpectParams functions")
+	}
+
+	mmSerializable.defaultExpectation.params = &TxManagerMockSerializableParams{ctx, f}
+	mmSerializable.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSerializable.expectations {
+		if minimock.Equal(e.params, mmSerializable.defaultExpectation.params) {
+			mmSerializable.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSerializable.defaultExpectation.params)
+		}
+	}
+
+	return mmSerializable
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) ExpectCtxParam1(ctx context.Context) *mTxManagerMockSerializable {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{}
+	}
+
+	if mmSerializable.defaultExpectation.params != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Expect")
+	}
+
+	if mmSerializable.defaultExpectation.paramPtrs == nil {
+		mmSerializable.defaultExpectation.paramPtrs = &TxManagerMockSerializableParamPtrs{}
+	}
+	mmSerializable.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSerializable.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSerializable
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) ExpectFParam2(f mm_db.Handler) *mTxManagerMockSerializable {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{}
+	}
+
+	if mmSerializable.defaultExpectation.params != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Expect")
+	}
+
+	if mmSerializable.defaultExpectation.paramPtrs == nil {
+		mmSerializable.defaultExpectation.paramPtrs = &TxManagerMockSerializableParamPtrs{}
+	}
+	mmSerializable.defaultExpectation.paramPtrs.f = &f
+	mmSerializable.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmSerializable
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) Inspect(f func(ctx context.Context, f mm_db.Handler)) *mTxManagerMockSerializable {
+	if mmSerializable.mock.inspectFuncSerializable != nil {
+		mmSerializable.mock.t.Fatalf("Inspect function is already set for TxManagerMock.Serializable")
+	}
+
+	mmSerializable.mock.inspectFuncSerializable = f
+
+	return mmSerializable
+}
+
+// Return sets up results that will be returned by TxManager.Serializable
+func (mmSerializable *mTxManagerMockSerializable) Return(err error) *TxManagerMock {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	if mmSerializable.defaultExpectation == nil {
+		mmSerializable.defaultExpectation = &TxManagerMockSerializableExpectation{mock: mmSerializable.mock}
+	}
+	mmSerializable.defaultExpectation.results = &TxManagerMockSerializableResults{err}
+	mmSerializable.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSerializable.mock
+}
+
+// Set uses given function f to mock the TxManager.Serializable method
+func (mmSerializable *mTxManagerMockSerializable) Set(f func(ctx context.Context, f mm_db.Handler) (err error)) *TxManagerMock {
+	if mmSerializable.defaultExpectation != nil {
+		mmSerializable.mock.t.Fatalf("Default expectation is already set for the TxManager.Serializable method")
+	}
+
+	if len(mmSerializable.expectations) > 0 {
+		mmSerializable.mock.t.Fatalf("Some expectations are already set for the TxManager.Serializable method")
+	}
+
+	mmSerializable.mock.funcSerializable = f
+	mmSerializable.mock.funcSerializableOrigin = minimock.CallerInfo(1)
+	return mmSerializable.mock
+}
+
+// When sets expectation for the TxManager.Serializable which will trigger the result defined by the following
+// Then helper
+func (mmSerializable *mTxManagerMockSerializable) When(ctx context.Context, f mm_db.Handler) *TxManagerMockSerializableExpectation {
+	if mmSerializable.mock.funcSerializable != nil {
+		mmSerializable.mock.t.Fatalf("TxManagerMock.Serializable mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockSerializableExpectation{
+		mock:               mmSerializable.mock,
+		params:             &TxManagerMockSerializableParams{ctx, f},
+		expectationOrigins: TxManagerMockSerializableExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSerializable.expectations = append(mmSerializable.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.Serializable return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockSerializableExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockSerializableResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.Serializable should be invoked
+func (mmSerializable *mTxManagerMockSerializable) Times(n uint64) *mTxManagerMockSerializable {
+	if n == 0 {
+		mmSerializable.mock.t.Fatalf("Times of TxManagerMock.Serializable mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSerializable.expectedInvocations, n)
+	mmSerializable.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSerializable
+}
+
+func (mmSerializable *mTxManagerMockSerializable) invocationsDone() bool {
+	if len(mmSerializable.expectations) == 0 && mmSerializable.defaultExpectation == nil && mmSerializable.mock.funcSerializable == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSerializable.mock.afterSerializableCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSerializable.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Serializable implements mm_db.TxManager
+func (mmSerializable *TxManagerMock) Serializable(ctx context.Context, f mm_db.Handler) (err error) {
+	mm_atomic.AddUint64(&mmSerializable.beforeSerializableCounter, 1)
+	defer mm_atomic.AddUint64(&mmSerializable.afterSerializableCounter, 1)
+
+	mmSerializable.t.Helper()
+
+	if mmSerializable.inspectFuncSerializable != nil {
+		mmSerializable.inspectFuncSerializable(ctx, f)
+	}
+
+	mm_params := TxManagerMockSerializableParams{ctx, f}
+
+	// Record call args
+	mmSerializable.SerializableMock.mutex.Lock()
+	mmSerializable.SerializableMock.callArgs = append(mmSerializable.SerializableMock.callArgs, &mm_params)
+	mmSerializable.SerializableMock.mutex.Unlock()
+
+	for _, e := range mmSerializable.SerializableMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSerializable.SerializableMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSerializable.SerializableMock.defaultExpectation.Counter, 1)
+		mm_want := mmSerializable.SerializableMock.defaultExpectation.params
+		mm_want_ptrs := mmSerializable.SerializableMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockSerializableParams{ctx, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSerializable.t.Errorf("TxManagerMock.Serializable got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSerializable.SerializableMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmSerializable.t.Errorf("TxManagerMock.Serializable got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSerializable.SerializableMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSerializable.t.Errorf("TxManagerMock.Serializable got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSerializable.SerializableMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSerializable.SerializableMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSerializable.t.Fatal("No results are set for the TxManagerMock.Serializable")
+		}
+		return (*mm_results).err
+	}
+	if mmSerializable.funcSerializable != nil {
+		return mmSerializable.funcSerializable(ctx, f)
+	}
+	mmSerializable.t.Fatalf("Unexpected call to TxManagerMock.Serializable. %v %v", ctx, f)
+	return
+}
+
+// SerializableAfterCounter returns a count of finished TxManagerMock.Serializable invocations
+func (mmSerializable *TxManagerMock) SerializableAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSerializable.afterSerializableCounter)
+}
+
+// SerializableBeforeCounter returns a count of TxManagerMock.Serializable invocations
+func (mmSerializable *TxManagerMock) SerializableBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSerializable.beforeSerializableCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.Serializable.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSerializable *mTxManagerMockSerializable) Calls() []*TxManagerMockSerializableParams {
+	mmSerializable.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockSerializableParams, len(mmSerializable.callArgs))
+	copy(argCopy, mmSerializable.callArgs)
+
+	mmSerializable.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSerializableDone returns true if the count of the Serializable invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockSerializableDone() bool {
+	if m.SerializableMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SerializableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SerializableMock.invocationsDone()
+}
+
+// MinimockSerializableInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockSerializableInspect() {
+	for _, e := range m.SerializableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSerializableCounter := mm_atomic.LoadUint64(&m.afterSerializableCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SerializableMock.defaultExpectation != nil && afterSerializableCounter < 1 {
+		if m.SerializableMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s", m.SerializableMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s with params: %#v", m.SerializableMock.defaultExpectation.expectationOrigins.origin, *m.SerializableMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSerializable != nil && afterSerializableCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.Serializable at\n%s", m.funcSerializableOrigin)
+	}
+
+	if !m.SerializableMock.invocationsDone() && afterSerializableCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.Serializable at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SerializableMock.expectedInvocations), m.SerializableMock.expectedInvocationsOrigin, afterSerializableCounter)
+	}
+}
+
+type mTxManagerMockTransaction struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockTransactionExpectation
+	expectations       []*TxManagerMockTransactionExpectation
+
+	callArgs []*TxManagerMockTransactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockTransactionExpectation specifies expectation struct of the TxManager.Transaction
+type TxManagerMockTransactionExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockTransactionParams
+	paramPtrs          *TxManagerMockTransactionParamPtrs
+	expectationOrigins TxManagerMockTransactionExpectationOrigins
+	results            *TxManagerMockTransactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockTransactionParams contains parameters of the TxManager.Transaction
+type TxManagerMockTransactionParams struct {
+	ctx  context.Context
+	opts pgx.TxOptions
+	f    mm_db.Handler
+}
+
+// TxManagerMockTransactionParamPtrs contains pointers to parameters of the TxManager.Transaction
+type TxManagerMockTransactionParamPtrs struct {
+	ctx  *context.Context
+	opts *pgx.TxOptions
+	f    *mm_db.Handler
+}
+
+// TxManagerMockTransactionResults contains results of the TxManager.Transaction
+type TxManagerMockTransactionResults struct {
+	err error
+}
+
+// TxManagerMockTransactionOrigins contains origins of expectations of the TxManager.Transaction
+type TxManagerMockTransactionExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originOpts string
+	originF    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransaction *mTxManagerMockTransaction) Optional() *mTxManagerMockTransaction {
+	mmTransaction.optional = true
+	return mmTransaction
+}
+
+// Expect sets up expected params for TxManager.Transaction
+func (mmTransaction *mTxManagerMockTransaction) Expect(ctx context.Context, opts pgx.TxOptions, f mm_db.Handler) *mTxManagerMockTransaction {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &TxManagerMockTransactionExpectation{}
+	}
+
+	if mmTransaction.defaultExpectation.paramPtrs != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by ExpectParams functions")
+	}
+
+	mmTransaction.defaultExpectation.params = &TxManagerMockTransactionParams{ctx, opts, f}
+	mmTransaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransaction.expectations {
+		if minimock.Equal(e.params, mmTransaction.defaultExpectation.params) {
+			mmTransaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransaction.defaultExpectation.params)
+		}
+	}
+
+	return mmTransaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.Transaction
+func (mmTransaction *mTxManagerMockTransaction) ExpectCtxParam1(ctx context.Context) *mTxManagerMockTransaction {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &TxManagerMockTransactionExpectation{}
+	}
+
+	if mmTransaction.defaultExpectation.params != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Expect")
+	}
+
+	if mmTransaction.defaultExpectation.paramPtrs == nil {
+		mmTransaction.defaultExpectation.paramPtrs = &TxManagerMockTransactionParamPtrs{}
+	}
+	mmTransaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransaction
+}
+
+// ExpectOptsParam2 sets up expected param opts for TxManager.Transaction
+func (mmTransaction *mTxManagerMockTransaction) ExpectOptsParam2(opts pgx.TxOptions) *mTxManagerMockTransaction {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &TxManagerMockTransactionExpectation{}
+	}
+
+	if mmTransaction.defaultExpectation.params != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Expect")
+	}
+
+	if mmTransaction.defaultExpectation.paramPtrs == nil {
+		mmTransaction.defaultExpectation.paramPtrs = &TxManagerMockTransactionParamPtrs{}
+	}
+	mmTransaction.defaultExpectation.paramPtrs.opts = &opts
+	mmTransaction.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmTransaction
+}
+
+// ExpectFParam3 sets up expected param f for TxManager.Transaction
+func (mmTransaction *mTxManagerMockTransaction) ExpectFParam3(f mm_db.Handler) *mTxManagerMockTransaction {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &TxManagerMockTransactionExpectation{}
+	}
+
+	if mmTransaction.defaultExpectation.params != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Expect")
+	}
+
+	if mmTransaction.defaultExpectation.paramPtrs == nil {
+		mmTransaction.defaultExpectation.paramPtrs = &TxManagerMockTransactionParamPtrs{}
+	}
+	mmTransaction.defaultExpectation.paramPtrs.f = &f
+	mmTransaction.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmTransaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.Transaction
+func (mmTransaction *mTxManagerMockTransaction) Inspect(f func(ctx context.Context, opts pgx.TxOptions, f mm_db.Handler)) *mTxManagerMockTransaction {
+	if mmTransaction.mock.inspectFuncTransaction != nil {
+		mmTransaction.mock.t.Fatalf("Inspect function is already set for TxManagerMock.Transaction")
+	}
+
+	mmTransaction.mock.inspectFuncTransaction = f
+
+	return mmTransaction
+}
+
+// Return sets up results that will be returned by TxManager.Transaction
+func (mmTransaction *mTxManagerMockTransaction) Return(err error) *TxManagerMock {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &TxManagerMockTransactionExpectation{mock: mmTransaction.mock}
+	}
+	mmTransaction.defaultExpectation.results = &TxManagerMockTransactionResults{err}
+	mmTransaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransaction.mock
+}
+
+// Set uses given function f to mock the TxManager.Transaction method
+func (mmTransaction *mTxManagerMockTransaction) Set(f func(ctx context.Context, opts pgx.TxOptions, f mm_db.Handler) (err error)) *TxManagerMock {
+	if mmTransaction.defaultExpectation != nil {
+		mmTransaction.mock.t.Fatalf("Default expectation is already set for the TxManager.Transaction method")
+	}
+
+	if len(mmTransaction.expectations) > 0 {
+		mmTransaction.mock.t.Fatalf("Some expectations are already set for the TxManager.Transaction method")
+	}
+
+	mmTransaction.mock.funcTransaction = f
+	mmTransaction.mock.funcTransactionOrigin = minimock.CallerInfo(1)
+	return mmTransaction.mock
+}
+
+// When sets expectation for the TxManager.Transaction which will trigger the result defined by the following
+// Then helper
+func (mmTransaction *mTxManagerMockTransaction) When(ctx context.Context, opts pgx.TxOptions, f mm_db.Handler) *TxManagerMockTransactionExpectation {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("TxManagerMock.Transaction mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockTransactionExpectation{
+		mock:               mmTransaction.mock,
+		params:             &TxManagerMockTransactionParams{ctx, opts, f},
+		expectationOrigins: TxManagerMockTransactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransaction.expectations = append(mmTransaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.Transaction return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockTransactionExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockTransactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.Transaction should be invoked
+func (mmTransaction *mTxManagerMockTransaction) Times(n uint64) *mTxManagerMockTransaction {
+	if n == 0 {
+		mmTransaction.mock.t.Fatalf("Times of TxManagerMock.Transaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransaction.expectedInvocations, n)
+	mmTransaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransaction
+}
+
+func (mmTransaction *mTxManagerMockTransaction) invocationsDone() bool {
+	if len(mmTransaction.expectations) == 0 && mmTransaction.defaultExpectation == nil && mmTransaction.mock.funcTransaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransaction.mock.afterTransactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Transaction implements mm_db.TxManager
+func (mmTransaction *TxManagerMock) Transaction(ctx context.Context, opts pgx.TxOptions, f mm_db.Handler) (err error) {
+	mm_atomic.AddUint64(&mmTransaction.beforeTransactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransaction.afterTransactionCounter, 1)
+
+	mmTransaction.t.Helper()
+
+	if mmTransaction.inspectFuncTransaction != nil {
+		mmTransaction.inspectFuncTransaction(ctx, opts, f)
+	}
+
+	mm_params := TxManagerMockTransactionParams{ctx, opts, f}
+
+	// Record call args
+	mmTransaction.TransactionMock.mutex.Lock()
+	mmTransaction.TransactionMock.callArgs = append(mmTransaction.TransactionMock.callArgs, &mm_params)
+	mmTransaction.TransactionMock.mutex.Unlock()
+
+	for _, e := range mmTransaction.TransactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTransaction.TransactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransaction.TransactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransaction.TransactionMock.defaultExpectation.params
+		mm_want_ptrs := mmTransaction.TransactionMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockTransactionParams{ctx, opts, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransaction.t.Errorf("TxManagerMock.Transaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransaction.TransactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmTransaction.t.Errorf("TxManagerMock.Transaction got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransaction.TransactionMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmTransaction.t.Errorf("TxManagerMock.Transaction got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransaction.TransactionMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransaction.t.Errorf("TxManagerMock.Transaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransaction.TransactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransaction.TransactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransaction.t.Fatal("No results are set for the TxManagerMock.Transaction")
+		}
+		return (*mm_results).err
+	}
+	if mmTransaction.funcTransaction != nil {
+		return mmTransaction.funcTransaction(ctx, opts, f)
+	}
+	mmTransaction.t.Fatalf("Unexpected call to TxManagerMock.Transaction. %v %v %v", ctx, opts, f)
+	return
+}
+
+// TransactionAfterCounter returns a count of finished TxManagerMock.Transaction invocations
+func (mmTransaction *TxManagerMock) TransactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransaction.afterTransactionCounter)
+}
+
+// TransactionBeforeCounter returns a count of TxManagerMock.Transaction invocations
+func (mmTransaction *TxManagerMock) TransactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransaction.beforeTransactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.Transaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransaction *mTxManagerMockTransaction) Calls() []*TxManagerMockTransactionParams {
+	mmTransaction.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockTransactionParams, len(mmTransaction.callArgs))
+	copy(argCopy, mmTransaction.callArgs)
+
+	mmTransaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransactionDone returns true if the count of the Transaction invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockTransactionDone() bool {
+	if m.TransactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransactionMock.invocationsDone()
+}
+
+// MinimockTransactionInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockTransactionInspect() {
+	for _, e := range m.TransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.Transaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransactionCounter := mm_atomic.LoadUint64(&m.afterTransactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransactionMock.defaultExpectation != nil && afterTransactionCounter < 1 {
+		if m.TransactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.Transaction at\n%s", m.TransactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.Transaction at\n%s with params: %#v", m.TransactionMock.defaultExpectation.expectationOrigins.origin, *m.TransactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransaction != nil && afterTransactionCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.Transaction at\n%s", m.funcTransactionOrigin)
+	}
+
+	if !m.TransactionMock.invocationsDone() && afterTransactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.Transaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransactionMock.expectedInvocations), m.TransactionMock.expectedInvocationsOrigin, afterTransactionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TxManagerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockReadCommittedInspect()
+
+			m.MinimockReadOnlyInspect()
+
+			m.MinimockReadOnlySerializableInspect()
+
+			m.MinimockRepeatableReadInspect()
+
+			m.MinimockSavepointInspect()
+
+			m.MinimockSerializableInspect()
+
+			m.MinimockTransactionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TxManagerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TxManagerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockReadCommittedDone() &&
+		m.MinimockReadOnlyDone() &&
+		m.MinimockReadOnlySerializableDone() &&
+		m.MinimockRepeatableReadDone() &&
+		m.MinimockSavepointDone() &&
+		m.MinimockSerializableDone() &&
+		m.MinimockTransactionDone()
+}
@@ -10,26 +10,64 @@ import (
 )
 
 type pgClient struct {
-	masterDBC db.DB
+	masterDBC  db.DB
+	replicaDBC db.DB
 }
 
-// New создаёт и инициализирует новый клиент базы данных, используя переданный DSN
-func New(ctx context.Context, dsn string) (db.Client, error) {
+// New создаёт и инициализирует новый клиент базы данных, используя переданный DSN основной БД и DSN реплик.
+// К репликам клиент подключается лениво, поэтому недоступная при запуске реплика не мешает старту.
+func New(ctx context.Context, dsn string, replicaDSNs []string, opts Options) (db.Client, error) {
 	dbc, err := pgxpool.Connect(ctx, dsn)
 	if err != nil {
 		return nil, errors.Errorf("failed to connect to db: %v", err)
 	}
 
+	masterDBC := NewDB(dbc, opts)
+
+	replicas := make([]db.DB, 0, len(replicaDSNs))
+	for i, replicaDSN := range replicaDSNs {
+		replicaDBC, err := connectLazy(ctx, replicaDSN)
+		if err != nil {
+			for _, replica := range replicas {
+				replica.Close()
+			}
+			dbc.Close()
+
+			return nil, errors.Errorf("failed to configure replica %d: %v", i, err)
+		}
+
+		replicas = append(replicas, NewDB(replicaDBC, opts))
+	}
+
 	return &pgClient{
-		masterDBC: &pg{dbc: dbc},
+		masterDBC:  masterDBC,
+		replicaDBC: NewReplicaDB(masterDBC, replicas, opts),
 	}, nil
 }
 
+func connectLazy(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	cfg.LazyConnect = true
+
+	return pgxpool.ConnectConfig(ctx, cfg)
+}
+
 func (c *pgClient) DB() db.DB {
 	return c.masterDBC
 }
 
+func (c *pgClient) Replica() db.DB {
+	return c.replicaDBC
+}
+
 func (c *pgClient) Close() error {
+	if c.replicaDBC != nil {
+		c.replicaDBC.Close()
+	}
+
 	if c.masterDBC != nil {
 		c.masterDBC.Close()
 	}
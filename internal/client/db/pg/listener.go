@@ -0,0 +1,107 @@
+package pg
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+)
+
+const (
+	listenMinBackoff = 100 * time.Millisecond
+	listenMaxBackoff = 5 * time.Second
+)
+
+func (p *pg) Notify(ctx context.Context, channel string, payload string) error {
+	q := db.Query{
+		Name:     "pg.Notify",
+		QueryRaw: "SELECT pg_notify($1, $2)",
+	}
+
+	// В уведомлении о новом сообщении передается его текст
+	_, err := p.ExecContext(ctx, q, channel, db.Sensitive{Value: payload})
+
+	return err
+}
+
+// Listen держит выделенное соединение с LISTEN на канал и передает полученные уведомления в handler.
+// При обрыве соединения переподключается с экспоненциальной задержкой, пока не будет отменен контекст.
+func (p *pg) Listen(ctx context.Context, channel string, handler func(payload string)) error {
+	backoff := Backoff{Min: listenMinBackoff, Max: listenMaxBackoff}
+
+	return Reconnect(ctx, channel, backoff, func(ctx context.Context, connected func()) error {
+		return p.listen(ctx, channel, handler, connected)
+	})
+}
+
+// Backoff границы экспоненциальной задержки между переподключениями
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Reconnect выполняет session, пока не будет отменен контекст, и перезапускает ее после каждой ошибки.
+// Задержка перед перезапуском удваивается от Min до Max и сбрасывается, когда сессия вызывает connected.
+func Reconnect(ctx context.Context, channel string, backoff Backoff, session func(ctx context.Context, connected func()) error) error {
+	delay := backoff.Min
+
+	for {
+		err := session(ctx, func() {
+			delay = backoff.Min
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		slog.WarnContext(ctx, "listener failed, reconnecting",
+			slog.String("channel", channel), slog.Duration("backoff", delay), logger.Err(err))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		delay = min(delay*2, backoff.Max)
+	}
+}
+
+// listen выполняет одну сессию LISTEN до первой ошибки
+func (p *pg) listen(ctx context.Context, channel string, handler func(payload string), onConnected func()) error {
+	poolConn, err := p.dbc.Acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to acquire connection")
+	}
+
+	// Соединение с активным LISTEN не должно вернуться в пул, поэтому забираем его себе и закрываем сами
+	conn := poolConn.Hijack()
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_ = conn.Close(closeCtx)
+	}()
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return errors.Wrap(err, "failed to listen")
+	}
+
+	onConnected()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to wait for notification")
+		}
+
+		handler(notification.Payload)
+	}
+}
@@ -2,16 +2,22 @@ package pg
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ipv02/chat-server/internal/client/db"
 	"github.com/ipv02/chat-server/internal/client/db/prettier"
+	"github.com/ipv02/chat-server/internal/metric"
+	"github.com/ipv02/chat-server/internal/tracing"
 )
 
 type key string
@@ -21,70 +27,122 @@ const (
 	TxKey key = "tx"
 )
 
+const redactedArg = "[redacted]"
+
+// Options настройки клиента БД
+type Options struct {
+	// LogQueries логировать запросы с уровнем DEBUG, аргументы db.Sensitive заменяются заглушкой
+	LogQueries bool
+	// ReplicaRetryInterval на сколько отказавшая реплика исключается из ротации, по умолчанию DefaultReplicaRetryInterval
+	ReplicaRetryInterval time.Duration
+}
+
 type pg struct {
-	dbc *pgxpool.Pool
+	dbc        *pgxpool.Pool
+	logQueries bool
 }
 
 // NewDB создаёт новый экземпляр соединения с базой данных, используя переданный пул соединений pgxpool
-func NewDB(dbc *pgxpool.Pool) db.DB {
+func NewDB(dbc *pgxpool.Pool, opts Options) db.DB {
 	return &pg{
-		dbc: dbc,
+		dbc:        dbc,
+		logQueries: opts.LogQueries,
 	}
 }
 
 func (p *pg) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
-	logQuery(ctx, q, args...)
+	args = p.prepareArgs(ctx, q, args)
+	ctx, span := startQuerySpan(ctx, q)
+	start := time.Now()
 
-	row, err := p.QueryContext(ctx, q, args...)
-	if err != nil {
-		return err
+	rows, err := p.query(ctx, q, args...)
+	if err == nil {
+		err = pgxscan.ScanOne(dest, rows)
 	}
 
-	return pgxscan.ScanOne(dest, row)
+	finishQuery(span, q, start, err)
+
+	return err
 }
 
 func (p *pg) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
-	logQuery(ctx, q, args...)
+	args = p.prepareArgs(ctx, q, args)
+	ctx, span := startQuerySpan(ctx, q)
+	start := time.Now()
 
-	rows, err := p.QueryContext(ctx, q, args...)
-	if err != nil {
-		return err
+	rows, err := p.query(ctx, q, args...)
+	if err == nil {
+		err = pgxscan.ScanAll(dest, rows)
 	}
 
-	return pgxscan.ScanAll(dest, rows)
+	finishQuery(span, q, start, err)
+
+	return err
 }
 
 func (p *pg) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
-	logQuery(ctx, q, args...)
+	args = p.prepareArgs(ctx, q, args)
+	ctx, span := startQuerySpan(ctx, q)
+	start := time.Now()
+
+	var (
+		tag pgconn.CommandTag
+		err error
+	)
 
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
-		return tx.Exec(ctx, q.QueryRaw, args...)
+		tag, err = tx.Exec(ctx, q.QueryRaw, args...)
+	} else {
+		tag, err = p.dbc.Exec(ctx, q.QueryRaw, args...)
 	}
 
-	return p.dbc.Exec(ctx, q.QueryRaw, args...)
+	finishQuery(span, q, start, err)
+
+	return tag, err
 }
 
+// QueryContext учитывает в метриках и спане время до получения первого ответа, ошибки чтения строк видит только вызывающий
 func (p *pg) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
-	logQuery(ctx, q, args...)
+	args = p.prepareArgs(ctx, q, args)
+	ctx, span := startQuerySpan(ctx, q)
+	start := time.Now()
+
+	rows, err := p.query(ctx, q, args...)
+	finishQuery(span, q, start, err)
+
+	return rows, err
+}
+
+func (p *pg) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	args = p.prepareArgs(ctx, q, args)
+	ctx, span := startQuerySpan(ctx, q)
+	start := time.Now()
+
+	var row pgx.Row
 
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
-		return tx.Query(ctx, q.QueryRaw, args...)
+		row = tx.QueryRow(ctx, q.QueryRaw, args...)
+	} else {
+		row = p.dbc.QueryRow(ctx, q.QueryRaw, args...)
 	}
 
-	return p.dbc.Query(ctx, q.QueryRaw, args...)
+	return &observedRow{
+		row:   row,
+		q:     q,
+		span:  span,
+		start: start,
+	}
 }
 
-func (p *pg) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
-	logQuery(ctx, q, args...)
-
+func (p *pg) query(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
 	tx, ok := ctx.Value(TxKey).(pgx.Tx)
 	if ok {
-		return tx.QueryRow(ctx, q.QueryRaw, args...)
+		return tx.Query(ctx, q.QueryRaw, args...)
 	}
 
-	return p.dbc.QueryRow(ctx, q.QueryRaw, args...)
+	return p.dbc.Query(ctx, q.QueryRaw, args...)
 }
 
 func (p *pg) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
@@ -95,6 +153,10 @@ func (p *pg) Ping(ctx context.Context) error {
 	return p.dbc.Ping(ctx)
 }
 
+func (p *pg) Stat() *pgxpool.Stat {
+	return p.dbc.Stat()
+}
+
 func (p *pg) Close() {
 	p.dbc.Close()
 }
@@ -104,11 +166,91 @@ func MakeContextTx(ctx context.Context, tx pgx.Tx) context.Context {
 	return context.WithValue(ctx, TxKey, tx)
 }
 
-func logQuery(ctx context.Context, q db.Query, args ...interface{}) {
-	prettyQuery := prettier.Pretty(q.QueryRaw, prettier.PlaceholderDollar, args...)
-	log.Println(
-		ctx,
-		fmt.Sprintf("sql: %s", q.Name),
-		fmt.Sprintf("query: %s", prettyQuery),
+// prepareArgs логирует запрос, если это включено, и снимает с аргументов пометку db.Sensitive перед передачей в драйвер
+func (p *pg) prepareArgs(ctx context.Context, q db.Query, args []interface{}) []interface{} {
+	if p.logQueries {
+		logQuery(ctx, q, args)
+	}
+
+	var unwrapped []interface{}
+	for i, arg := range args {
+		sensitive, ok := arg.(db.Sensitive)
+		if !ok {
+			continue
+		}
+
+		if unwrapped == nil {
+			unwrapped = make([]interface{}, len(args))
+			copy(unwrapped, args)
+		}
+		unwrapped[i] = sensitive.Value
+	}
+
+	if unwrapped == nil {
+		return args
+	}
+
+	return unwrapped
+}
+
+func logQuery(ctx context.Context, q db.Query, args []interface{}) {
+	if !slog.Default().Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	logged := make([]interface{}, len(args))
+	for i, arg := range args {
+		logged[i] = loggedArg(arg)
+	}
+
+	slog.DebugContext(ctx, "sql query",
+		slog.String("query_name", q.Name),
+		slog.String("query", prettier.Pretty(q.QueryRaw, prettier.PlaceholderDollar, logged...)),
+	)
+}
+
+// loggedArg заменяет чувствительные аргументы заглушкой, длинные значения обрезает prettier
+func loggedArg(arg interface{}) interface{} {
+	if _, ok := arg.(db.Sensitive); ok {
+		return redactedArg
+	}
+
+	return arg
+}
+
+// observedRow откладывает учет запроса в метриках и завершение спана до Scan,
+// так как QueryRow возвращает ошибку только при чтении строки
+type observedRow struct {
+	row   pgx.Row
+	q     db.Query
+	span  trace.Span
+	start time.Time
+}
+
+func (r *observedRow) Scan(dest ...interface{}) error {
+	err := r.row.Scan(dest...)
+	finishQuery(r.span, r.q, r.start, err)
+
+	return err
+}
+
+// startQuerySpan открывает спан запроса с именем db.Query.Name и текстом запроса без значений параметров
+func startQuerySpan(ctx context.Context, q db.Query) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, q.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBQueryText(strings.Join(strings.Fields(q.QueryRaw), " ")),
+		),
 	)
 }
+
+// finishQuery учитывает запрос в метриках и завершает его спан, отсутствие строк ошибкой не считается
+func finishQuery(span trace.Span, q db.Query, start time.Time, err error) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+
+	metric.ObserveQuery(q.Name, time.Since(start), err != nil)
+	tracing.End(span, err)
+}
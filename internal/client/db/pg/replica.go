@@ -0,0 +1,202 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/metric"
+)
+
+// DefaultReplicaRetryInterval время по умолчанию, на которое отказавшая реплика исключается из ротации
+const DefaultReplicaRetryInterval = 5 * time.Second
+
+// replica пул соединений с репликой и время, до которого она считается недоступной
+type replica struct {
+	db        db.DB
+	index     int
+	downUntil atomic.Int64
+}
+
+func (r *replica) available(now time.Time) bool {
+	return now.UnixNano() >= r.downUntil.Load()
+}
+
+// replicaDB направляет запросы на чтение в реплики по кругу, пропуская отказавшие,
+// остальные операции выполняет основная БД
+type replicaDB struct {
+	db.DB
+
+	replicas      []*replica
+	next          atomic.Uint64
+	retryInterval time.Duration
+}
+
+// NewReplicaDB создает БД для чтения из реплик. Реплика, запрос к которой завершился ошибкой соединения,
+// исключается из ротации на Options.ReplicaRetryInterval, а запрос повторяется в основной БД primary.
+// Close закрывает только реплики, основную БД закрывает ее владелец.
+func NewReplicaDB(primary db.DB, replicas []db.DB, opts Options) db.DB {
+	retryInterval := opts.ReplicaRetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DefaultReplicaRetryInterval
+	}
+
+	r := &replicaDB{
+		DB:            primary,
+		retryInterval: retryInterval,
+	}
+
+	for i, dbc := range replicas {
+		r.replicas = append(r.replicas, &replica{db: dbc, index: i})
+	}
+
+	return r
+}
+
+func (r *replicaDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	return r.read(ctx, q, func(dbc db.DB) error {
+		return dbc.ScanOneContext(ctx, dest, q, args...)
+	})
+}
+
+func (r *replicaDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	return r.read(ctx, q, func(dbc db.DB) error {
+		return dbc.ScanAllContext(ctx, dest, q, args...)
+	})
+}
+
+// QueryContext переходит на основную БД, только если реплика не выполнила запрос,
+// ошибки при чтении строк получает вызывающий
+func (r *replicaDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	var rows pgx.Rows
+	err := r.read(ctx, q, func(dbc db.DB) error {
+		var err error
+		rows, err = dbc.QueryContext(ctx, q, args...)
+
+		return err
+	})
+
+	return rows, err
+}
+
+// QueryRowContext откладывает выбор реплики до Scan, так как ошибку запроса QueryRow возвращает только при чтении строки
+func (r *replicaDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	return &replicaRow{
+		ctx:  ctx,
+		r:    r,
+		q:    q,
+		args: args,
+	}
+}
+
+func (r *replicaDB) Close() {
+	for _, rep := range r.replicas {
+		rep.db.Close()
+	}
+}
+
+// read выполняет запрос f в выбранной реплике, а при ее отказе - в основной БД
+func (r *replicaDB) read(ctx context.Context, q db.Query, f func(dbc db.DB) error) error {
+	rep := r.pick(ctx)
+	if rep == nil {
+		return f(r.DB)
+	}
+
+	err := f(rep.db)
+	if err == nil || !isReplicaFailure(ctx, err) {
+		return err
+	}
+
+	r.markDown(ctx, rep, q, err)
+
+	return f(r.DB)
+}
+
+// pick выбирает доступную реплику по кругу. Внутри транзакции и с db.WithPrimary реплика не выбирается:
+// транзакция открыта в основной БД, а вызывающему нужны только что записанные данные
+func (r *replicaDB) pick(ctx context.Context) *replica {
+	if len(r.replicas) == 0 || db.PrimaryRequired(ctx) {
+		return nil
+	}
+
+	if _, ok := ctx.Value(TxKey).(pgx.Tx); ok {
+		return nil
+	}
+
+	now := time.Now()
+	start := r.next.Add(1)
+	for i := range r.replicas {
+		rep := r.replicas[(start+uint64(i))%uint64(len(r.replicas))]
+		if rep.available(now) {
+			return rep
+		}
+	}
+
+	return nil
+}
+
+func (r *replicaDB) markDown(ctx context.Context, rep *replica, q db.Query, err error) {
+	rep.downUntil.Store(time.Now().Add(r.retryInterval).UnixNano())
+	metric.IncReplicaFallbacks()
+
+	slog.WarnContext(ctx, "replica failed, reading from primary",
+		slog.Int("replica", rep.index),
+		slog.String("query_name", q.Name),
+		slog.Duration("retry_in", r.retryInterval),
+		logger.Err(err),
+	)
+}
+
+// replicaRow выполняет QueryRow в реплике при Scan и повторяет его в основной БД при отказе реплики
+type replicaRow struct {
+	ctx  context.Context
+	r    *replicaDB
+	q    db.Query
+	args []interface{}
+}
+
+func (row *replicaRow) Scan(dest ...interface{}) error {
+	return row.r.read(row.ctx, row.q, func(dbc db.DB) error {
+		return dbc.QueryRowContext(row.ctx, row.q, row.args...).Scan(dest...)
+	})
+}
+
+// isReplicaFailure отличает отказ реплики от ошибки самого запроса: повторять в основной БД имеет смысл
+// только ошибки соединения и состояния сервера, ошибки SQL там повторятся так же
+func isReplicaFailure(ctx context.Context, err error) bool {
+	// Отмена или истечение срока запроса вызывающим не говорит о состоянии реплики
+	if ctx.Err() != nil || errors.Is(err, pgx.ErrNoRows) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		// 08 - ошибки соединения, 53 - нехватка ресурсов, например, соединений, 57 - сервер останавливается или запускается
+		case strings.HasPrefix(pgErr.Code, "08"), strings.HasPrefix(pgErr.Code, "53"), strings.HasPrefix(pgErr.Code, "57"):
+			return true
+		// Запрос на реплике отменен из-за конфликта с применением изменений основной БД
+		case pgErr.Code == "40001":
+			return true
+		default:
+			return false
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || pgconn.SafeToRetry(err)
+}
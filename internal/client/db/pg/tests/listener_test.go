@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/client/db/pg"
+)
+
+var errConnDropped = errors.New("connection reset by peer")
+
+func TestReconnect(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var (
+		attempts []time.Time
+		received []string
+	)
+
+	// Первые сессии не подключаются, затем две сессии подключаются, получают уведомление и обрываются
+	err := pg.Reconnect(ctx, "chat_messages", pg.Backoff{Min: 5 * time.Millisecond, Max: time.Hour},
+		func(ctx context.Context, connected func()) error {
+			attempts = append(attempts, time.Now())
+
+			switch n := len(attempts); {
+			case n <= 5:
+				return errConnDropped
+			case n <= 7:
+				connected()
+				received = append(received, "payload")
+
+				return errConnDropped
+			default:
+				cancel()
+				<-ctx.Done()
+
+				return ctx.Err()
+			}
+		})
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 8)
+	require.Equal(t, []string{"payload", "payload"}, received)
+
+	// Без подключения задержка растет: 5, 10, 20, 40 мс, перед шестой попыткой - 80 мс
+	require.GreaterOrEqual(t, attempts[5].Sub(attempts[4]), 80*time.Millisecond)
+	// После успешного подключения задержка сбрасывается до минимальной, без сброса она была бы 160 мс
+	require.Less(t, attempts[6].Sub(attempts[5]), 80*time.Millisecond)
+}
+
+func TestReconnectStopsOnCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- pg.Reconnect(ctx, "chat_messages", pg.Backoff{Min: time.Hour, Max: time.Hour},
+			func(context.Context, func()) error {
+				return errConnDropped
+			})
+	}()
+
+	// Отмена контекста прерывает ожидание перед переподключением
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Reconnect did not return after context cancellation")
+	}
+}
+
+func TestListenReconnectsToUnreachableDB(t *testing.T) {
+	logs := captureLogs(t, slog.LevelInfo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	err := newDB(t, pg.Options{}).Listen(ctx, "chat_messages", func(string) {
+		t.Fatal("unexpected notification")
+	})
+
+	// Недоступная БД не останавливает слушателя: он переподключается, пока не отменен контекст
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, strings.Count(logs.String(), "listener failed, reconnecting"), 2)
+}
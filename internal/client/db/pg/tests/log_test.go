@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/client/db/pg"
+	"github.com/ipv02/chat-server/internal/logger"
+)
+
+// unreachableDSN указывает на закрытый порт: запрос завершится ошибкой соединения, но успеет попасть в лог
+const unreachableDSN = "host=127.0.0.1 port=1 dbname=chat user=chat connect_timeout=1"
+
+func newDB(t *testing.T, opts pg.Options) db.DB {
+	t.Helper()
+
+	cfg, err := pgxpool.ParseConfig(unreachableDSN)
+	require.NoError(t, err)
+	cfg.LazyConnect = true
+
+	pool, err := pgxpool.ConnectConfig(context.Background(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pg.NewDB(pool, opts)
+}
+
+func captureLogs(t *testing.T, level slog.Level) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	log, err := logger.New(logger.Options{Level: level, Format: logger.FormatText, Output: &buf})
+	require.NoError(t, err)
+
+	prev := slog.Default()
+	slog.SetDefault(log)
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	return &buf
+}
+
+func exec(t *testing.T, dbc db.DB, args ...interface{}) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	q := db.Query{
+		Name:     "chat_repository.SendMessage",
+		QueryRaw: "INSERT INTO messages (chat_id, text, from_user) VALUES ($1, $2, $3)",
+	}
+
+	_, err := dbc.ExecContext(ctx, q, args...)
+	require.Error(t, err)
+}
+
+// Тесты меняют логгер по умолчанию, поэтому выполняются последовательно
+func TestQueryLogRedactsSensitiveArgs(t *testing.T) {
+	buf := captureLogs(t, slog.LevelDebug)
+
+	longName := strings.Repeat("a", 100)
+	exec(t, newDB(t, pg.Options{LogQueries: true}), int64(7), db.Sensitive{Value: "secret text"}, longName)
+
+	out := buf.String()
+	require.Contains(t, out, "chat_repository.SendMessage")
+	require.Contains(t, out, "[redacted]")
+	require.NotContains(t, out, "secret text")
+	require.Contains(t, out, strings.Repeat("a", 64)+"...")
+	require.NotContains(t, out, longName)
+}
+
+func TestQueryLogDisabled(t *testing.T) {
+	buf := captureLogs(t, slog.LevelDebug)
+
+	exec(t, newDB(t, pg.Options{}), int64(7), db.Sensitive{Value: "secret text"}, "user")
+
+	require.NotContains(t, buf.String(), "sql query")
+}
+
+func TestQueryLogRequiresDebugLevel(t *testing.T) {
+	buf := captureLogs(t, slog.LevelInfo)
+
+	exec(t, newDB(t, pg.Options{LogQueries: true}), int64(7), db.Sensitive{Value: "secret text"}, "user")
+
+	require.NotContains(t, buf.String(), "sql query")
+}
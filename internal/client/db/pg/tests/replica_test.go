@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/client/db/pg"
+)
+
+var (
+	listQuery = db.Query{Name: "chat_repository.ListMessages", QueryRaw: "SELECT id FROM messages"}
+
+	errConnRefused = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	errNoTable     = &pgconn.PgError{Code: "42P01", Message: "relation does not exist"}
+)
+
+type fakeTx struct {
+	pgx.Tx
+}
+
+type fakeRow func(dest ...interface{}) error
+
+func (f fakeRow) Scan(dest ...interface{}) error {
+	return f(dest...)
+}
+
+func newReplicaDB(primary db.DB, replicas ...db.DB) db.DB {
+	return pg.NewReplicaDB(primary, replicas, pg.Options{ReplicaRetryInterval: time.Hour})
+}
+
+func TestReplicaRouting(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		primary bool
+	}{
+		{
+			name: "read from replica case",
+			ctx:  context.Background(),
+		},
+		{
+			name:    "inside transaction case",
+			ctx:     pg.MakeContextTx(context.Background(), fakeTx{}),
+			primary: true,
+		},
+		{
+			name:    "primary required case",
+			ctx:     db.WithPrimary(context.Background()),
+			primary: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			primary := dbMocks.NewDBMock(mc)
+			replica := dbMocks.NewDBMock(mc)
+
+			if tt.primary {
+				primary.ScanAllContextMock.Return(nil)
+			} else {
+				replica.ScanAllContextMock.Return(nil)
+			}
+
+			var ids []int64
+			err := newReplicaDB(primary, replica).ScanAllContext(tt.ctx, &ids, listQuery)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReplicaRoundRobin(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	primary := dbMocks.NewDBMock(mc)
+	first := dbMocks.NewDBMock(mc).ScanAllContextMock.Return(nil)
+	second := dbMocks.NewDBMock(mc).ScanAllContextMock.Return(nil)
+
+	dbc := newReplicaDB(primary, first, second)
+	for i := 0; i < 4; i++ {
+		var ids []int64
+		require.NoError(t, dbc.ScanAllContext(context.Background(), &ids, listQuery))
+	}
+
+	require.Equal(t, uint64(2), first.ScanAllContextAfterCounter())
+	require.Equal(t, uint64(2), second.ScanAllContextAfterCounter())
+}
+
+func TestReplicaWithoutReplicas(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	primary := dbMocks.NewDBMock(mc).ScanOneContextMock.Return(nil)
+
+	var id int64
+	require.NoError(t, newReplicaDB(primary).ScanOneContext(context.Background(), &id, listQuery))
+}
+
+func TestReplicaFallback(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	primary := dbMocks.NewDBMock(mc).ScanAllContextMock.Return(nil)
+	failed := dbMocks.NewDBMock(mc).ScanAllContextMock.Return(errConnRefused)
+	healthy := dbMocks.NewDBMock(mc).ScanAllContextMock.Return(nil)
+
+	dbc := newReplicaDB(primary, failed, healthy)
+
+	// Первый запрос идет во вторую реплику, второй - в отказавшую и повторяется в основной БД,
+	// после чего отказавшая реплика больше не выбирается
+	for i := 0; i < 4; i++ {
+		var ids []int64
+		require.NoError(t, dbc.ScanAllContext(context.Background(), &ids, listQuery))
+	}
+
+	require.Equal(t, uint64(1), failed.ScanAllContextAfterCounter())
+	require.Equal(t, uint64(1), primary.ScanAllContextAfterCounter())
+	require.Equal(t, uint64(3), healthy.ScanAllContextAfterCounter())
+}
+
+func TestReplicaQueryError(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	primary := dbMocks.NewDBMock(mc)
+	replica := dbMocks.NewDBMock(mc).ScanAllContextMock.Return(errNoTable)
+
+	var ids []int64
+	err := newReplicaDB(primary, replica).ScanAllContext(context.Background(), &ids, listQuery)
+
+	// Ошибка SQL повторилась бы и в основной БД, поэтому возвращается как есть
+	require.ErrorIs(t, err, errNoTable)
+}
+
+func TestReplicaQueryRowFallback(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	primary := dbMocks.NewDBMock(mc).QueryRowContextMock.Return(fakeRow(func(dest ...interface{}) error {
+		*dest[0].(*int64) = 42
+		return nil
+	}))
+	replica := dbMocks.NewDBMock(mc).QueryRowContextMock.Return(fakeRow(func(...interface{}) error {
+		return errConnRefused
+	}))
+
+	var count int64
+	err := newReplicaDB(primary, replica).QueryRowContext(context.Background(), listQuery).Scan(&count)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(42), count)
+}
+
+func TestReplicaWritesGoToPrimary(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	primary := dbMocks.NewDBMock(mc).ExecContextMock.Return(pgconn.CommandTag("INSERT 0 1"), nil)
+	replica := dbMocks.NewDBMock(mc)
+
+	_, err := newReplicaDB(primary, replica).ExecContext(context.Background(), db.Query{Name: "insert"})
+	require.NoError(t, err)
+}
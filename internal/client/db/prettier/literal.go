@@ -0,0 +1,200 @@
+package prettier
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxValueLength сколько символов строки или байтов []byte попадает в литерал, остаток обрезается
+	MaxValueLength = 64
+	// MaxArrayLength сколько элементов среза попадает в литерал ARRAY[...]
+	MaxArrayLength = 10
+
+	truncatedSuffix = "..."
+
+	// maxDepth ограничивает разворачивание вложенных указателей, Valuer и срезов
+	maxDepth = 8
+)
+
+// Literal возвращает значение в виде литерала SQL: NULL, числа, TRUE/FALSE, строки в одинарных кавычках,
+// bytea и timestamptz с приведением типа и массивы ARRAY[...]. Длинные строки и массивы обрезаются,
+// отброшенная часть массива отмечается комментарием, поэтому литерал остается корректным SQL.
+func Literal(v any) string {
+	return literal(v, 0)
+}
+
+func literal(v any, depth int) string {
+	if depth > maxDepth {
+		return quote(fmt.Sprintf("%v", v))
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quote(v)
+	case []byte:
+		return bytea(v)
+	case bool:
+		return boolean(v)
+	case time.Time:
+		return quote(v.Format("2006-01-02 15:04:05.999999Z07:00")) + "::timestamptz"
+	case time.Duration:
+		return quote(strconv.FormatInt(v.Microseconds(), 10)+" microseconds") + "::interval"
+	case driver.Valuer:
+		return valuer(v, depth)
+	case fmt.Stringer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return "NULL"
+		}
+
+		return quote(v.String())
+	}
+
+	return reflectLiteral(reflect.ValueOf(v), depth)
+}
+
+// valuer раскрывает значения вроде sql.NullString, которые драйвер передает через driver.Valuer
+func valuer(v driver.Valuer, depth int) (s string) {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return "NULL"
+	}
+
+	defer func() {
+		// Value на значении с нулевым указателем внутри может паниковать
+		if r := recover(); r != nil {
+			s = quote(fmt.Sprintf("%v", v))
+		}
+	}()
+
+	value, err := v.Value()
+	if err != nil {
+		return quote(fmt.Sprintf("%v", v))
+	}
+
+	return literal(value, depth+1)
+}
+
+// reflectLiteral разбирает именованные типы, указатели и срезы по их виду
+func reflectLiteral(rv reflect.Value, depth int) string {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return "NULL"
+		}
+
+		return literal(rv.Elem().Interface(), depth+1)
+	case reflect.String:
+		return quote(rv.String())
+	case reflect.Bool:
+		return boolean(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return float(rv.Float(), rv.Type().Bits())
+	case reflect.Slice:
+		if rv.IsNil() {
+			return "NULL"
+		}
+
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return bytea(rv.Bytes())
+		}
+
+		return array(rv, depth)
+	case reflect.Array:
+		return array(rv, depth)
+	default:
+		return quote(fmt.Sprintf("%v", rv.Interface()))
+	}
+}
+
+func boolean(b bool) string {
+	if b {
+		return "TRUE"
+	}
+
+	return "FALSE"
+}
+
+func float(f float64, bits int) string {
+	switch {
+	case math.IsNaN(f):
+		return "'NaN'::float8"
+	case math.IsInf(f, 1):
+		return "'Infinity'::float8"
+	case math.IsInf(f, -1):
+		return "'-Infinity'::float8"
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, bits)
+}
+
+// array выводит ARRAY[...], пустой массив выводится как '{}', так как тип ARRAY[] не выводится из элементов
+func array(rv reflect.Value, depth int) string {
+	n := rv.Len()
+	if n == 0 {
+		return "'{}'"
+	}
+
+	shown := min(n, MaxArrayLength)
+	elems := make([]string, 0, shown)
+	for i := 0; i < shown; i++ {
+		elems = append(elems, literal(rv.Index(i).Interface(), depth+1))
+	}
+
+	var b strings.Builder
+	b.WriteString("ARRAY[")
+	b.WriteString(strings.Join(elems, ", "))
+	if n > shown {
+		fmt.Fprintf(&b, " /* %d more */", n-shown)
+	}
+	b.WriteString("]")
+
+	return b.String()
+}
+
+func bytea(v []byte) string {
+	if len(v) <= MaxValueLength {
+		return `'\x` + hex.EncodeToString(v) + `'::bytea`
+	}
+
+	return fmt.Sprintf(`'\x%s'::bytea /* %d bytes */`, hex.EncodeToString(v[:MaxValueLength]), len(v))
+}
+
+// quote заключает строку в одинарные кавычки, удваивая кавычки внутри. Некорректные последовательности UTF-8
+// и нулевые байты, которые PostgreSQL не принимает в тексте, заменяются, длинные строки обрезаются по границе символа
+func quote(s string) string {
+	s = strings.ToValidUTF8(s, string(utf8.RuneError))
+	s = strings.ReplaceAll(s, "\x00", string(utf8.RuneError))
+
+	truncated := false
+	if utf8.RuneCountInString(s) > MaxValueLength {
+		i := 0
+		for count := 0; count < MaxValueLength; count++ {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+		}
+
+		s = s[:i]
+		truncated = true
+	}
+
+	s = strings.ReplaceAll(s, "'", "''")
+
+	if truncated {
+		s += truncatedSuffix
+	}
+
+	return "'" + s + "'"
+}
@@ -1,34 +1,253 @@
 package prettier
 
 import (
-	"fmt"
 	"strconv"
 	"strings"
 )
 
-// PlaceholderDollar плейсхолдер для подстановки параметров в SQL-запросах
 const (
+	// PlaceholderDollar плейсхолдеры PostgreSQL вида $1, $2
 	PlaceholderDollar = "$"
+	// PlaceholderQuestion позиционные плейсхолдеры ?, пара ?? означает сам символ ?
+	PlaceholderQuestion = "?"
 )
 
-// Pretty форматирует SQL-запрос
+// Pretty подставляет значения параметров в SQL-запрос в виде литералов SQL и схлопывает пробелы,
+// чтобы запрос из лога можно было выполнить в psql. Плейсхолдеры внутри строк, идентификаторов
+// в кавычках и комментариев не заменяются, комментарии из результата удаляются.
+// Длинные значения обрезаются, плейсхолдеры без значения остаются как есть.
 func Pretty(query string, placeholder string, args ...any) string {
-	for i, param := range args {
-		var value string
-		switch v := param.(type) {
-		case string:
-			value = fmt.Sprintf("%q", v)
-		case []byte:
-			value = fmt.Sprintf("%q", string(v))
+	s := &scanner{
+		query:       query,
+		placeholder: placeholder,
+		args:        args,
+	}
+	s.scan()
+
+	return strings.TrimSpace(s.out.String())
+}
+
+type scanner struct {
+	query       string
+	placeholder string
+	args        []any
+
+	pos      int
+	next     int // номер следующего позиционного плейсхолдера ?
+	out      strings.Builder
+	pendingW bool // встретился пробел или комментарий, который выводится одним пробелом перед следующим токеном
+}
+
+func (s *scanner) scan() {
+	for s.pos < len(s.query) {
+		c := s.query[s.pos]
+
+		switch {
+		case isSpace(c):
+			s.pos++
+			s.pendingW = true
+		case c == '-' && s.peek(1) == '-':
+			s.skipLineComment()
+		case c == '/' && s.peek(1) == '*':
+			s.skipBlockComment()
+		case c == '\'':
+			s.copyQuoted('\'', s.isEscapeString())
+		case c == '"':
+			s.copyQuoted('"', false)
+		case c == '$':
+			s.scanDollar()
+		case c == '?' && s.placeholder == PlaceholderQuestion:
+			s.scanQuestion()
+		default:
+			s.emit(s.query[s.pos : s.pos+1])
+			s.pos++
+		}
+	}
+}
+
+func (s *scanner) peek(offset int) byte {
+	if s.pos+offset < len(s.query) {
+		return s.query[s.pos+offset]
+	}
+
+	return 0
+}
+
+// emit выводит токен, предваряя его одним пробелом, если перед ним были пробелы или комментарии
+func (s *scanner) emit(token string) {
+	if s.pendingW && s.out.Len() > 0 {
+		s.out.WriteByte(' ')
+	}
+	s.pendingW = false
+
+	s.out.WriteString(token)
+}
+
+func (s *scanner) skipLineComment() {
+	end := strings.IndexByte(s.query[s.pos:], '\n')
+	if end < 0 {
+		s.pos = len(s.query)
+	} else {
+		s.pos += end + 1
+	}
+
+	s.pendingW = true
+}
+
+// skipBlockComment пропускает комментарий /* */, в PostgreSQL они могут быть вложенными
+func (s *scanner) skipBlockComment() {
+	depth := 0
+
+	for s.pos < len(s.query) {
+		switch {
+		case s.query[s.pos] == '/' && s.peek(1) == '*':
+			depth++
+			s.pos += 2
+		case s.query[s.pos] == '*' && s.peek(1) == '/':
+			depth--
+			s.pos += 2
+			if depth == 0 {
+				s.pendingW = true
+				return
+			}
 		default:
-			value = fmt.Sprintf("%v", v)
+			s.pos++
 		}
+	}
 
-		query = strings.Replace(query, fmt.Sprintf("%s%s", placeholder, strconv.Itoa(i+1)), value, -1)
+	s.pendingW = true
+}
+
+// isEscapeString проверяет, что кавычка открывает строку E'...', в которой действуют экранирования обратным слэшем
+func (s *scanner) isEscapeString() bool {
+	if s.pos == 0 || (s.query[s.pos-1] != 'E' && s.query[s.pos-1] != 'e') {
+		return false
 	}
 
-	query = strings.ReplaceAll(query, "\t", "")
-	query = strings.ReplaceAll(query, "\n", " ")
+	return s.pos < 2 || !isIdentChar(s.query[s.pos-2])
+}
+
+// copyQuoted копирует строку или идентификатор в кавычках без изменений, удвоенная кавычка не завершает их.
+// Незакрытая строка копируется до конца запроса.
+func (s *scanner) copyQuoted(quote byte, backslashEscapes bool) {
+	start := s.pos
+	s.pos++
+
+	for s.pos < len(s.query) {
+		c := s.query[s.pos]
+
+		switch {
+		case backslashEscapes && c == '\\':
+			s.pos = min(s.pos+2, len(s.query))
+		case c == quote && s.peek(1) == quote:
+			s.pos += 2
+		case c == quote:
+			s.pos++
+			s.emit(s.query[start:s.pos])
+			return
+		default:
+			s.pos++
+		}
+	}
+
+	s.pos = len(s.query)
+	s.emit(s.query[start:s.pos])
+}
+
+// scanDollar разбирает плейсхолдер $n или строку в долларовых кавычках $tag$...$tag$
+func (s *scanner) scanDollar() {
+	// Знак доллара внутри идентификатора, например, a$1, не начинает ни плейсхолдер, ни строку
+	if s.pos > 0 && isIdentChar(s.query[s.pos-1]) {
+		s.emit("$")
+		s.pos++
+		return
+	}
+
+	if isDigit(s.peek(1)) {
+		if s.placeholder != PlaceholderDollar {
+			s.emit("$")
+			s.pos++
+			return
+		}
+
+		end := s.pos + 1
+		for end < len(s.query) && isDigit(s.query[end]) {
+			end++
+		}
+
+		s.emitArg(s.query[s.pos:end], s.query[s.pos+1:end])
+		s.pos = end
+		return
+	}
+
+	tag, ok := s.dollarTag()
+	if !ok {
+		s.emit("$")
+		s.pos++
+		return
+	}
+
+	start := s.pos
+	closing := strings.Index(s.query[s.pos+len(tag):], tag)
+	if closing < 0 {
+		s.pos = len(s.query)
+	} else {
+		s.pos += len(tag) + closing + len(tag)
+	}
+
+	s.emit(s.query[start:s.pos])
+}
+
+// dollarTag возвращает открывающий тег $tag$ или $$ в текущей позиции
+func (s *scanner) dollarTag() (string, bool) {
+	end := s.pos + 1
+	for end < len(s.query) && s.query[end] != '$' {
+		c := s.query[end]
+		if !isIdentChar(c) || (end == s.pos+1 && isDigit(c)) {
+			return "", false
+		}
+		end++
+	}
+
+	if end >= len(s.query) {
+		return "", false
+	}
+
+	return s.query[s.pos : end+1], true
+}
+
+func (s *scanner) scanQuestion() {
+	if s.peek(1) == '?' {
+		s.emit("?")
+		s.pos += 2
+		return
+	}
+
+	s.next++
+	s.emitArg("?", strconv.Itoa(s.next))
+	s.pos++
+}
+
+// emitArg подставляет значение параметра с номером number, raw выводится, если значения нет
+func (s *scanner) emitArg(raw string, number string) {
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 1 || n > len(s.args) {
+		s.emit(raw)
+		return
+	}
+
+	s.emit(Literal(s.args[n-1]))
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
 
-	return strings.TrimSpace(query)
+// isIdentChar символ идентификатора без кавычек, байты не из ASCII относятся к буквам в UTF-8
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
 }
@@ -0,0 +1,279 @@
+package tests
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/client/db/prettier"
+)
+
+type role string
+
+func TestPretty(t *testing.T) {
+	t.Parallel()
+
+	manyArgs := make([]any, 11)
+	for i := range manyArgs {
+		manyArgs[i] = i + 1
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		placeholder string
+		args        []any
+		want        string
+	}{
+		{
+			name:        "whitespace is collapsed",
+			query:       "SELECT id,\n\tname\n  FROM chat\n",
+			placeholder: prettier.PlaceholderDollar,
+			want:        "SELECT id, name FROM chat",
+		},
+		{
+			name:        "ten or more placeholders",
+			query:       "SELECT $1, $2, $10, $11",
+			placeholder: prettier.PlaceholderDollar,
+			args:        manyArgs,
+			want:        "SELECT 1, 2, 10, 11",
+		},
+		{
+			name:        "placeholder inside string literal",
+			query:       "SELECT '$1 costs $2', $1",
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{"x", "y"},
+			want:        "SELECT '$1 costs $2', 'x'",
+		},
+		{
+			name:        "doubled quote inside string literal",
+			query:       "SELECT 'it''s $1', $1",
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{1},
+			want:        "SELECT 'it''s $1', 1",
+		},
+		{
+			name:        "escape string literal",
+			query:       `SELECT E'it\'s $1', $1`,
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{1},
+			want:        `SELECT E'it\'s $1', 1`,
+		},
+		{
+			name:        "whitespace inside string literal is kept",
+			query:       "SELECT 'a\n\tb'",
+			placeholder: prettier.PlaceholderDollar,
+			want:        "SELECT 'a\n\tb'",
+		},
+		{
+			name:        "quoted identifier",
+			query:       `SELECT "col$1" FROM t WHERE id = $1`,
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{7},
+			want:        `SELECT "col$1" FROM t WHERE id = 7`,
+		},
+		{
+			name:        "comments are removed",
+			query:       "SELECT $1 -- $2 is ignored\nFROM t /* $2 /* nested $2 */ still comment */ WHERE a = $2",
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{1, 2},
+			want:        "SELECT 1 FROM t WHERE a = 2",
+		},
+		{
+			name:        "dollar quoted string",
+			query:       "SELECT $$ $1 $$, $tag$ it's $1 $tag$, $1",
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{1},
+			want:        "SELECT $$ $1 $$, $tag$ it's $1 $tag$, 1",
+		},
+		{
+			name:        "dollar sign inside identifier",
+			query:       "SELECT a$1 FROM t",
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{1},
+			want:        "SELECT a$1 FROM t",
+		},
+		{
+			name:        "placeholder without value",
+			query:       "SELECT $1, $3, $0",
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{1},
+			want:        "SELECT 1, $3, $0",
+		},
+		{
+			name:        "question placeholders",
+			query:       "SELECT * FROM t WHERE a = ? AND b = ? AND data ?? 'key' AND c = '?'",
+			placeholder: prettier.PlaceholderQuestion,
+			args:        []any{1, "x"},
+			want:        "SELECT * FROM t WHERE a = 1 AND b = 'x' AND data ? 'key' AND c = '?'",
+		},
+		{
+			name:        "dollar placeholders are not replaced in question mode",
+			query:       "SELECT $1, ?",
+			placeholder: prettier.PlaceholderQuestion,
+			args:        []any{1},
+			want:        "SELECT $1, 1",
+		},
+		{
+			name:        "value that looks like a placeholder",
+			query:       "SELECT $1, $2",
+			placeholder: prettier.PlaceholderDollar,
+			args:        []any{"$2", 2},
+			want:        "SELECT '$2', 2",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, prettier.Pretty(tt.query, tt.placeholder, tt.args...))
+		})
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nilInt *int
+		five   = 5
+		ts     = time.Date(2024, 3, 1, 12, 30, 45, 123456000, time.UTC)
+	)
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "nil", value: nil, want: "NULL"},
+		{name: "nil pointer", value: nilInt, want: "NULL"},
+		{name: "pointer", value: &five, want: "5"},
+		{name: "string", value: "O'Reilly", want: "'O''Reilly'"},
+		{name: "named string", value: role("admin"), want: "'admin'"},
+		{name: "bool", value: true, want: "TRUE"},
+		{name: "int64", value: int64(-42), want: "-42"},
+		{name: "uint", value: uint8(200), want: "200"},
+		{name: "float", value: 1.5, want: "1.5"},
+		{name: "NaN", value: math.NaN(), want: "'NaN'::float8"},
+		{name: "infinity", value: math.Inf(-1), want: "'-Infinity'::float8"},
+		{name: "time", value: ts, want: "'2024-03-01 12:30:45.123456Z'::timestamptz"},
+		{
+			name:  "time with zone",
+			value: ts.In(time.FixedZone("MSK", 3*60*60)),
+			want:  "'2024-03-01 15:30:45.123456+03:00'::timestamptz",
+		},
+		{name: "duration", value: 1500 * time.Millisecond, want: "'1500000 microseconds'::interval"},
+		{name: "bytes", value: []byte{0xde, 0xad}, want: `'\xdead'::bytea`},
+		{name: "nil bytes", value: []byte(nil), want: `'\x'::bytea`},
+		{name: "string slice", value: []string{"a", "b'c"}, want: "ARRAY['a', 'b''c']"},
+		{name: "int slice", value: []int64{1, 2}, want: "ARRAY[1, 2]"},
+		{name: "empty slice", value: []string{}, want: "'{}'"},
+		{name: "nil slice", value: []string(nil), want: "NULL"},
+		{name: "null valuer", value: sql.NullString{}, want: "NULL"},
+		{name: "valuer", value: sql.NullInt64{Int64: 3, Valid: true}, want: "3"},
+		{name: "stringer", value: time.March, want: "'March'"},
+		{name: "struct", value: struct{ A int }{A: 1}, want: "'{1}'"},
+		{name: "null byte and invalid utf-8", value: "a\x00b\xff", want: "'a�b�'"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, prettier.Literal(tt.value))
+		})
+	}
+}
+
+func TestLiteralTruncation(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("я", prettier.MaxValueLength+10)
+	require.Equal(t, "'"+strings.Repeat("я", prettier.MaxValueLength)+"...'", prettier.Literal(long))
+
+	exact := strings.Repeat("a", prettier.MaxValueLength)
+	require.Equal(t, "'"+exact+"'", prettier.Literal(exact))
+
+	bytes := make([]byte, prettier.MaxValueLength+1)
+	require.Equal(t,
+		`'\x`+strings.Repeat("00", prettier.MaxValueLength)+`'::bytea /* 65 bytes */`,
+		prettier.Literal(bytes))
+
+	ids := make([]int, prettier.MaxArrayLength+5)
+	require.Equal(t, "ARRAY[0, 0, 0, 0, 0, 0, 0, 0, 0, 0 /* 5 more */]", prettier.Literal(ids))
+}
+
+// unquote разбирает строковый литерал SQL, который выводит prettier
+func unquote(t *testing.T, literal string) string {
+	t.Helper()
+
+	require.True(t, strings.HasPrefix(literal, "'") && strings.HasSuffix(literal, "'") && len(literal) >= 2, literal)
+
+	body := literal[1 : len(literal)-1]
+	require.NotContains(t, strings.ReplaceAll(body, "''", ""), "'", literal)
+
+	return strings.ReplaceAll(body, "''", "'")
+}
+
+func FuzzPretty(f *testing.F) {
+	seeds := []string{
+		"SELECT $1, $10 FROM t WHERE a = '$2' -- $3\n AND b = $2",
+		"SELECT E'\\'$1', \"$1\", $$ $1 $$, $tag$ $1 $tag$ /* /* $1 */ */",
+		"SELECT a$1, $, $$, $a, '", "/*", "--", "E'\\", "$1$2", "a-/**/-b",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, query string) {
+		pretty := prettier.Pretty(query, prettier.PlaceholderDollar)
+
+		// Без значений плейсхолдеры остаются на месте, поэтому повторное форматирование ничего не меняет
+		require.Equal(t, pretty, prettier.Pretty(pretty, prettier.PlaceholderDollar), "query: %q", query)
+		require.Equal(t, strings.TrimSpace(pretty), pretty)
+
+		if utf8.ValidString(query) {
+			require.True(t, utf8.ValidString(pretty))
+		}
+
+		_ = prettier.Pretty(query, prettier.PlaceholderQuestion, 1, "x", nil)
+		_ = prettier.Pretty(query, prettier.PlaceholderDollar, 1, "x", nil, []byte(query), query)
+	})
+}
+
+func FuzzLiteralString(f *testing.F) {
+	for _, seed := range []string{"", "O'Reilly", "''", "-- $1 /*", "a\x00b", "\xff", strings.Repeat("ё", 70)} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		out := prettier.Pretty("SELECT $1 FROM t", prettier.PlaceholderDollar, value)
+		require.True(t, strings.HasPrefix(out, "SELECT ") && strings.HasSuffix(out, " FROM t"), out)
+
+		literal := strings.TrimSuffix(strings.TrimPrefix(out, "SELECT "), " FROM t")
+		got := unquote(t, literal)
+		require.True(t, utf8.ValidString(got))
+		require.NotContains(t, got, "\x00")
+
+		want := strings.ToValidUTF8(value, "�")
+		want = strings.ReplaceAll(want, "\x00", "�")
+		if utf8.RuneCountInString(want) <= prettier.MaxValueLength {
+			require.Equal(t, want, got)
+		} else {
+			require.True(t, strings.HasSuffix(got, "..."), got)
+			require.Equal(t, prettier.MaxValueLength+len("..."), utf8.RuneCountInString(got))
+		}
+
+		// Литерал читается сканером как одна строка: комментарии и плейсхолдеры внутри не разбираются
+		require.Equal(t, out, prettier.Pretty(out, prettier.PlaceholderDollar, "other"), fmt.Sprintf("%q", value))
+	})
+}
@@ -0,0 +1,17 @@
+package db
+
+import "context"
+
+type primaryKey struct{}
+
+// WithPrimary возвращает контекст, запросы с которым читают из основной БД, а не из реплики.
+// Нужен, когда запрос должен увидеть только что сделанную запись, например, историю после отправки сообщения.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// PrimaryRequired сообщает, что запросы с этим контекстом должны читать из основной БД
+func PrimaryRequired(ctx context.Context) bool {
+	required, _ := ctx.Value(primaryKey{}).(bool)
+	return required
+}
@@ -0,0 +1,423 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/client/db/pg"
+	"github.com/ipv02/chat-server/internal/client/db/transaction"
+)
+
+// fakeTx транзакция, которая только считает коммиты и откаты. Begin возвращает savepoint, для которого
+// Commit и Rollback означают RELEASE и ROLLBACK TO SAVEPOINT
+type fakeTx struct {
+	pgx.Tx
+
+	commitErr   error
+	rollbackErr error
+	commits     atomic.Int32
+	rollbacks   atomic.Int32
+
+	savepoint *fakeTx
+}
+
+func (tx *fakeTx) Begin(_ context.Context) (pgx.Tx, error) {
+	return tx.savepoint, nil
+}
+
+func (tx *fakeTx) Commit(_ context.Context) error {
+	tx.commits.Add(1)
+	return tx.commitErr
+}
+
+func (tx *fakeTx) Rollback(_ context.Context) error {
+	tx.rollbacks.Add(1)
+	return tx.rollbackErr
+}
+
+var (
+	errSerialization = &pgconn.PgError{Code: "40001", Message: "could not serialize access"}
+	errDeadlock      = &pgconn.PgError{Code: "40P01", Message: "deadlock detected"}
+	errUniqueViolate = &pgconn.PgError{Code: "23505", Message: "duplicate key value"}
+)
+
+func newManager(transactor db.Transactor, maxRetries int, budget float64) db.TxManager {
+	return transaction.NewTransactionManager(transactor, transaction.Options{
+		MaxRetries:  maxRetries,
+		MinBackoff:  time.Microsecond,
+		MaxBackoff:  time.Millisecond,
+		RetryBudget: budget,
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		maxRetries int
+		budget     float64
+		// errs ошибки обработчика по попыткам, после них обработчик завершается успешно
+		errs      []error
+		commitErr error
+		attempts  int
+		err       error
+	}{
+		{
+			name:       "success case",
+			maxRetries: 3,
+			attempts:   1,
+		},
+		{
+			name:       "retry serialization failure case",
+			maxRetries: 3,
+			errs:       []error{errSerialization, errDeadlock},
+			attempts:   3,
+		},
+		{
+			name:       "not retryable error case",
+			maxRetries: 3,
+			errs:       []error{errUniqueViolate},
+			attempts:   1,
+			err:        errUniqueViolate,
+		},
+		{
+			name:       "retries exhausted case",
+			maxRetries: 2,
+			errs:       []error{errSerialization, errSerialization, errSerialization, errSerialization},
+			attempts:   3,
+			err:        errSerialization,
+		},
+		{
+			name:       "retry budget exhausted case",
+			maxRetries: 3,
+			budget:     1,
+			errs:       []error{errSerialization, errSerialization, errSerialization},
+			attempts:   2,
+			err:        errSerialization,
+		},
+		{
+			name:       "commit serialization failure case",
+			maxRetries: 1,
+			commitErr:  errSerialization,
+			attempts:   2,
+			err:        errSerialization,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			tx := &fakeTx{commitErr: tt.commitErr}
+			transactor := dbMocks.NewTransactorMock(mc).BeginTxMock.Set(func(_ context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
+				require.Equal(t, pgx.Serializable, opts.IsoLevel)
+				return tx, nil
+			})
+
+			var attempts int
+			err := newManager(transactor, tt.maxRetries, tt.budget).Serializable(context.Background(), func(_ context.Context) error {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+
+				return nil
+			})
+
+			require.Equal(t, tt.attempts, attempts)
+			require.Equal(t, uint64(tt.attempts), transactor.BeginTxAfterCounter())
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, int32(1), tx.commits.Load())
+			require.Equal(t, int32(tt.attempts-1), tx.rollbacks.Load())
+		})
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	transactor := dbMocks.NewTransactorMock(mc).BeginTxMock.Return(&fakeTx{}, nil)
+
+	manager := transaction.NewTransactionManager(transactor, transaction.Options{
+		MaxRetries: 3,
+		MinBackoff: time.Hour,
+		MaxBackoff: time.Hour,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := manager.Serializable(ctx, func(_ context.Context) error {
+		cancel()
+		return errSerialization
+	})
+
+	require.ErrorIs(t, err, errSerialization)
+	require.Equal(t, uint64(1), transactor.BeginTxAfterCounter())
+}
+
+func TestNested(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		outer  func(m db.TxManager) func(ctx context.Context, f db.Handler) error
+		nested func(m db.TxManager) func(ctx context.Context, f db.Handler) error
+		err    error
+	}{
+		{
+			name:   "weaker nested isolation case",
+			outer:  func(m db.TxManager) func(context.Context, db.Handler) error { return m.Serializable },
+			nested: func(m db.TxManager) func(context.Context, db.Handler) error { return m.ReadCommitted },
+		},
+		{
+			name:   "read only nested in read write case",
+			outer:  func(m db.TxManager) func(context.Context, db.Handler) error { return m.RepeatableRead },
+			nested: func(m db.TxManager) func(context.Context, db.Handler) error { return m.ReadOnly },
+		},
+		{
+			name:   "stricter nested isolation case",
+			outer:  func(m db.TxManager) func(context.Context, db.Handler) error { return m.ReadCommitted },
+			nested: func(m db.TxManager) func(context.Context, db.Handler) error { return m.Serializable },
+			err:    transaction.ErrStricterNested,
+		},
+		{
+			name:   "read write nested in read only case",
+			outer:  func(m db.TxManager) func(context.Context, db.Handler) error { return m.ReadOnlySerializable },
+			nested: func(m db.TxManager) func(context.Context, db.Handler) error { return m.ReadCommitted },
+			err:    transaction.ErrStricterNested,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			tx := &fakeTx{}
+			transactor := dbMocks.NewTransactorMock(mc).BeginTxMock.Return(tx, nil)
+			manager := newManager(transactor, 3, 0)
+
+			var called bool
+			err := tt.outer(manager)(context.Background(), func(ctx context.Context) error {
+				return tt.nested(manager)(ctx, func(_ context.Context) error {
+					called = true
+					return nil
+				})
+			})
+
+			require.Equal(t, uint64(1), transactor.BeginTxAfterCounter())
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.False(t, called)
+				require.Equal(t, int32(1), tx.rollbacks.Load())
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, called)
+			require.Equal(t, int32(1), tx.commits.Load())
+		})
+	}
+}
+
+func TestNestedNotRetried(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	transactor := dbMocks.NewTransactorMock(mc).BeginTxMock.Return(&fakeTx{}, nil)
+	manager := newManager(transactor, 3, 0)
+
+	var outer, nested int
+	err := manager.Serializable(context.Background(), func(ctx context.Context) error {
+		outer++
+
+		return manager.Serializable(ctx, func(_ context.Context) error {
+			nested++
+			if nested == 1 {
+				return errSerialization
+			}
+
+			return nil
+		})
+	})
+
+	// Ошибку вложенного вызова повторяет внешняя транзакция целиком
+	require.NoError(t, err)
+	require.Equal(t, 2, outer)
+	require.Equal(t, 2, nested)
+	require.Equal(t, uint64(2), transactor.BeginTxAfterCounter())
+}
+
+func TestSavepoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		errSideWrite  = errors.New("search index unavailable")
+		errConnClosed = errors.New("conn closed")
+	)
+
+	tests := []struct {
+		name         string
+		savepointErr error
+		rollbackErr  error
+		// ignore внешний обработчик продолжает работу, проигнорировав ошибку savepoint
+		ignore            bool
+		attempts          int
+		err               error
+		commits           int32
+		releases          int32
+		rollbacksToSP     int32
+		savepointReturned error
+	}{
+		{
+			name:     "release on success case",
+			attempts: 1,
+			commits:  1,
+			releases: 1,
+		},
+		{
+			name:              "inner failure rolled back, outer commits case",
+			savepointErr:      errSideWrite,
+			ignore:            true,
+			attempts:          1,
+			commits:           1,
+			rollbacksToSP:     1,
+			savepointReturned: errSideWrite,
+		},
+		{
+			name:              "inner failure propagated case",
+			savepointErr:      errSideWrite,
+			attempts:          1,
+			rollbacksToSP:     1,
+			err:               errSideWrite,
+			savepointReturned: errSideWrite,
+		},
+		{
+			name:              "ignored serialization failure retries outer case",
+			savepointErr:      errSerialization,
+			ignore:            true,
+			attempts:          2,
+			commits:           1,
+			releases:          1,
+			rollbacksToSP:     1,
+			savepointReturned: errSerialization,
+		},
+		{
+			name:              "failed rollback to savepoint aborts outer case",
+			savepointErr:      errSideWrite,
+			rollbackErr:       errConnClosed,
+			ignore:            true,
+			attempts:          1,
+			rollbacksToSP:     1,
+			err:               errConnClosed,
+			savepointReturned: errSideWrite,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			sp := &fakeTx{rollbackErr: tt.rollbackErr}
+			tx := &fakeTx{savepoint: sp}
+			transactor := dbMocks.NewTransactorMock(mc).BeginTxMock.Return(tx, nil)
+			manager := newManager(transactor, 3, 0)
+
+			var attempts int
+			err := manager.Serializable(context.Background(), func(ctx context.Context) error {
+				attempts++
+
+				errSP := manager.Savepoint(ctx, func(ctx context.Context) error {
+					require.Same(t, sp, ctx.Value(pg.TxKey))
+
+					// Ошибка возникает только в первой попытке
+					if attempts == 1 {
+						return tt.savepointErr
+					}
+
+					return nil
+				})
+				if attempts == 1 && tt.savepointReturned != nil {
+					require.ErrorIs(t, errSP, tt.savepointReturned)
+				}
+
+				if errSP != nil && !tt.ignore {
+					return errSP
+				}
+
+				return nil
+			})
+
+			require.Equal(t, tt.attempts, attempts)
+			require.Equal(t, tt.commits, tx.commits.Load())
+			require.Equal(t, tt.releases, sp.commits.Load())
+			require.Equal(t, tt.rollbacksToSP, sp.rollbacks.Load())
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Equal(t, int32(1), tx.rollbacks.Load())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSavepointPanic(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	sp := &fakeTx{}
+	tx := &fakeTx{savepoint: sp}
+	transactor := dbMocks.NewTransactorMock(mc).BeginTxMock.Return(tx, nil)
+	manager := newManager(transactor, 3, 0)
+
+	err := manager.ReadCommitted(context.Background(), func(ctx context.Context) error {
+		return manager.Savepoint(ctx, func(_ context.Context) error {
+			panic("boom")
+		})
+	})
+
+	// Паника откатывает savepoint и, как и без него, всю транзакцию
+	require.ErrorContains(t, err, "panic recovered: boom")
+	require.Equal(t, int32(1), sp.rollbacks.Load())
+	require.Equal(t, int32(1), tx.rollbacks.Load())
+	require.Equal(t, int32(0), tx.commits.Load())
+}
+
+func TestSavepointWithoutTransaction(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	tx := &fakeTx{}
+	transactor := dbMocks.NewTransactorMock(mc).BeginTxMock.Expect(minimock.AnyContext, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+	err := newManager(transactor, 3, 0).Savepoint(context.Background(), func(ctx context.Context) error {
+		require.Same(t, tx, ctx.Value(pg.TxKey))
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int32(1), tx.commits.Load())
+}
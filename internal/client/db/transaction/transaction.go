@@ -2,41 +2,186 @@ package transaction
 
 import (
 	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
 
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ipv02/chat-server/internal/client/db"
 	"github.com/ipv02/chat-server/internal/client/db/pg"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/metric"
+	"github.com/ipv02/chat-server/internal/tracing"
 )
 
+const (
+	// DefaultMaxRetries сколько раз по умолчанию повторяется транзакция после ошибки сериализации
+	DefaultMaxRetries = 3
+	// DefaultMinBackoff пауза по умолчанию перед первым повтором
+	DefaultMinBackoff = 10 * time.Millisecond
+	// DefaultMaxBackoff максимальная пауза по умолчанию между повторами
+	DefaultMaxBackoff = 500 * time.Millisecond
+
+	// sqlstateSerializationFailure транзакция не может быть сериализована с параллельными транзакциями
+	sqlstateSerializationFailure = "40001"
+	// sqlstateDeadlockDetected транзакция выбрана жертвой при разрешении взаимоблокировки
+	sqlstateDeadlockDetected = "40P01"
+
+	// budgetRefill сколько повторов возвращает в бюджет каждая успешная транзакция
+	budgetRefill = 0.1
+)
+
+// ErrStricterNested вложенная транзакция требует более строгих гарантий, чем открытая внешняя
+var ErrStricterNested = errors.New("nested transaction requires stricter options than the outer transaction")
+
+// Options настройки повтора транзакций
+type Options struct {
+	// MaxRetries сколько раз повторяется транзакция после ошибки сериализации, 0 отключает повторы
+	MaxRetries int
+	// MinBackoff пауза перед первым повтором, далее она удваивается для каждого следующего
+	MinBackoff time.Duration
+	// MaxBackoff максимальная пауза между повторами
+	MaxBackoff time.Duration
+	// RetryBudget сколько повторов может быть сделано подряд без успешных транзакций, 0 снимает ограничение.
+	// Бюджет не дает повторам умножить нагрузку на БД при массовых конфликтах.
+	RetryBudget float64
+}
+
 type manager struct {
-	db db.Transactor
+	db   db.Transactor
+	opts Options
+
+	mu     sync.Mutex
+	budget float64
 }
 
 // NewTransactionManager создает новый менеджер транзакций, который удовлетворяет интерфейсу db.TxManager
-func NewTransactionManager(db db.Transactor) db.TxManager {
+func NewTransactionManager(db db.Transactor, opts Options) db.TxManager {
+	if opts.MaxRetries < 0 {
+		opts.MaxRetries = 0
+	}
+
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = DefaultMinBackoff
+	}
+
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = max(DefaultMaxBackoff, opts.MinBackoff)
+	}
+
 	return &manager{
-		db: db,
+		db:     db,
+		opts:   opts,
+		budget: opts.RetryBudget,
+	}
+}
+
+type txStateKey struct{}
+
+// txState состояние попытки внешней транзакции, доступное вложенным вызовам через контекст
+type txState struct {
+	opts pgx.TxOptions
+
+	mu sync.Mutex
+	// err ошибка внутри SAVEPOINT, после которой внешнюю транзакцию нельзя коммитить, даже если обработчик
+	// ее проигнорировал: ошибка сериализации требует повтора всей транзакции, а неудачный откат к SAVEPOINT
+	// оставляет транзакцию прерванной
+	err error
+}
+
+// fail запоминает первую такую ошибку, состояния нет, если транзакцию в контекст положил не менеджер
+func (s *txState) fail(err error) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err == nil {
+		s.err = err
 	}
 }
 
+func (s *txState) failure() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
 // transaction основная функция, которая выполняет указанный пользователем обработчик в транзакции
-func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Handler) (err error) {
+func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Handler) error {
 	// Если это вложенная транзакция, пропускаем инициацию новой транзакции и выполняем обработчик.
-	tx, ok := ctx.Value(pg.TxKey).(pgx.Tx)
-	if ok {
+	// Вложенный вызов не повторяется сам: при ошибке сериализации откатится и повторится внешняя транзакция.
+	if _, ok := ctx.Value(pg.TxKey).(pgx.Tx); ok {
+		var outer pgx.TxOptions
+		if state, ok := ctx.Value(txStateKey{}).(*txState); ok {
+			outer = state.opts
+		}
+
+		if !satisfies(outer, opts) {
+			return errors.Wrapf(ErrStricterNested, "outer %s, nested %s", describe(outer), describe(opts))
+		}
+
 		return fn(ctx)
 	}
 
+	for attempt := 0; ; attempt++ {
+		err := m.attempt(ctx, opts, attempt, fn)
+		if err == nil {
+			m.refill()
+			return nil
+		}
+
+		sqlstate, retryable := retryableState(err)
+		if !retryable || attempt >= m.opts.MaxRetries {
+			return err
+		}
+
+		if !m.takeRetry() {
+			slog.WarnContext(ctx, "transaction retry budget exhausted",
+				slog.String("sqlstate", sqlstate), logger.Err(err))
+			return err
+		}
+
+		metric.IncTxRetries(sqlstate)
+		slog.DebugContext(ctx, "retrying transaction",
+			slog.String("sqlstate", sqlstate), slog.Int("attempt", attempt+1))
+
+		if errWait := m.wait(ctx, attempt); errWait != nil {
+			return err
+		}
+	}
+}
+
+// attempt выполняет одну попытку транзакции: начало, обработчик и коммит или откат
+func (m *manager) attempt(ctx context.Context, opts pgx.TxOptions, attempt int, fn db.Handler) (err error) {
+	// Спан охватывает всю попытку вместе с коммитом или откатом, запросы внутри становятся его дочерними спанами.
+	ctx, span := tracing.Tracer().Start(ctx, "transaction", trace.WithAttributes(
+		attribute.String("db.transaction.isolation", string(opts.IsoLevel)),
+		attribute.String("db.transaction.access_mode", string(opts.AccessMode)),
+		attribute.Int("db.transaction.attempt", attempt),
+	))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	// Стартуем новую транзакцию.
-	tx, err = m.db.BeginTx(ctx, opts)
+	tx, err := m.db.BeginTx(ctx, opts)
 	if err != nil {
 		return errors.Wrap(err, "can't begin transaction")
 	}
 
-	// Кладем транзакцию в контекст.
-	ctx = pg.MakeContextTx(ctx, tx)
+	// Кладем транзакцию и состояние попытки в контекст.
+	state := &txState{opts: opts}
+	ctx = context.WithValue(pg.MakeContextTx(ctx, tx), txStateKey{}, state)
 
 	// Настраиваем функцию отсрочки для отката или коммита транзакции.
 	defer func() {
@@ -55,11 +200,9 @@ func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Han
 		}
 
 		// если ошибок не было, коммитим транзакцию
-		if nil == err {
-			err = tx.Commit(ctx)
-			if err != nil {
-				err = errors.Wrap(err, "tx commit failed")
-			}
+		err = tx.Commit(ctx)
+		if err != nil {
+			err = errors.Wrap(err, "tx commit failed")
 		}
 	}()
 
@@ -68,12 +211,206 @@ func (m *manager) transaction(ctx context.Context, opts pgx.TxOptions, fn db.Han
 	// или в противном случае транзакция коммитится.
 	if err = fn(ctx); err != nil {
 		err = errors.Wrap(err, "failed executing code inside transaction")
+	} else if errSavepoint := state.failure(); errSavepoint != nil {
+		err = errors.Wrap(errSavepoint, "savepoint failed inside transaction")
+	}
+
+	return err
+}
+
+// savepoint выполняет обработчик под SAVEPOINT открытой транзакции tx. При ошибке или панике изменения обработчика
+// откатываются через ROLLBACK TO SAVEPOINT, паника передается дальше, чтобы внешняя транзакция обработала ее как раньше.
+func (m *manager) savepoint(ctx context.Context, tx pgx.Tx, fn db.Handler) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "savepoint")
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	// Begin внутри транзакции в pgx создает SAVEPOINT, Commit и Rollback выполняют RELEASE и ROLLBACK TO SAVEPOINT
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can't create savepoint")
+	}
+
+	state, _ := ctx.Value(txStateKey{}).(*txState)
+	ctx = pg.MakeContextTx(ctx, sp)
+
+	completed := false
+	defer func() {
+		if completed && err == nil {
+			if errRelease := sp.Commit(ctx); errRelease != nil {
+				err = errors.Wrap(errRelease, "release savepoint failed")
+				state.fail(err)
+			}
+
+			return
+		}
+
+		if errRollback := sp.Rollback(ctx); errRollback != nil {
+			errRollback = errors.Wrap(errRollback, "rollback to savepoint failed")
+			state.fail(errRollback)
+
+			if err == nil {
+				err = errRollback
+			} else {
+				err = errors.Wrapf(err, "errRollback: %v", errRollback)
+			}
+		}
+
+		if _, retryable := retryableState(err); retryable {
+			state.fail(err)
+		}
+	}()
+
+	err = fn(ctx)
+	completed = true
+
+	if err != nil {
+		err = errors.Wrap(err, "failed executing code inside savepoint")
 	}
 
 	return err
 }
 
+// retryableState возвращает SQLSTATE ошибки, после которой транзакцию можно безопасно повторить
+func retryableState(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return "", false
+	}
+
+	switch pgErr.Code {
+	case sqlstateSerializationFailure, sqlstateDeadlockDetected:
+		return pgErr.Code, true
+	default:
+		return "", false
+	}
+}
+
+// takeRetry списывает один повтор из бюджета, если бюджет не ограничен, повтор разрешается всегда
+func (m *manager) takeRetry() bool {
+	if m.opts.RetryBudget <= 0 {
+		return true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.budget < 1 {
+		return false
+	}
+
+	m.budget--
+
+	return true
+}
+
+// refill пополняет бюджет повторов после успешной транзакции
+func (m *manager) refill() {
+	if m.opts.RetryBudget <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	m.budget = min(m.budget+budgetRefill, m.opts.RetryBudget)
+	m.mu.Unlock()
+}
+
+// wait делает паузу перед повтором: она удваивается с каждой попыткой и выбирается случайно
+// из второй половины интервала, чтобы конфликтующие транзакции не повторялись одновременно
+func (m *manager) wait(ctx context.Context, attempt int) error {
+	backoff := m.opts.MinBackoff << min(attempt, 30)
+	if backoff <= 0 || backoff > m.opts.MaxBackoff {
+		backoff = m.opts.MaxBackoff
+	}
+
+	backoff = backoff/2 + rand.N(backoff/2+1)
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// satisfies проверяет, что внешняя транзакция дает гарантии не слабее тех, что требует вложенная
+func satisfies(outer, nested pgx.TxOptions) bool {
+	if isolationRank(outer.IsoLevel) < isolationRank(nested.IsoLevel) {
+		return false
+	}
+
+	if outer.AccessMode == pgx.ReadOnly && nested.AccessMode != pgx.ReadOnly {
+		return false
+	}
+
+	return true
+}
+
+func isolationRank(level pgx.TxIsoLevel) int {
+	switch level {
+	case pgx.Serializable:
+		return 3
+	case pgx.RepeatableRead:
+		return 2
+	case pgx.ReadUncommitted:
+		return 0
+	default:
+		// уровень по умолчанию в PostgreSQL - read committed
+		return 1
+	}
+}
+
+func describe(opts pgx.TxOptions) string {
+	level := string(opts.IsoLevel)
+	if level == "" {
+		level = string(pgx.ReadCommitted)
+	}
+
+	if opts.AccessMode == pgx.ReadOnly {
+		return level + " " + string(pgx.ReadOnly)
+	}
+
+	return level
+}
+
 func (m *manager) ReadCommitted(ctx context.Context, f db.Handler) error {
 	txOpts := pgx.TxOptions{IsoLevel: pgx.ReadCommitted}
 	return m.transaction(ctx, txOpts, f)
 }
+
+func (m *manager) RepeatableRead(ctx context.Context, f db.Handler) error {
+	txOpts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead}
+	return m.transaction(ctx, txOpts, f)
+}
+
+func (m *manager) Serializable(ctx context.Context, f db.Handler) error {
+	txOpts := pgx.TxOptions{IsoLevel: pgx.Serializable}
+	return m.transaction(ctx, txOpts, f)
+}
+
+func (m *manager) ReadOnly(ctx context.Context, f db.Handler) error {
+	txOpts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	return m.transaction(ctx, txOpts, f)
+}
+
+func (m *manager) ReadOnlySerializable(ctx context.Context, f db.Handler) error {
+	txOpts := pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadOnly, DeferrableMode: pgx.Deferrable}
+	return m.transaction(ctx, txOpts, f)
+}
+
+func (m *manager) Transaction(ctx context.Context, opts pgx.TxOptions, f db.Handler) error {
+	return m.transaction(ctx, opts, f)
+}
+
+func (m *manager) Savepoint(ctx context.Context, f db.Handler) error {
+	tx, ok := ctx.Value(pg.TxKey).(pgx.Tx)
+	if !ok {
+		return m.ReadCommitted(ctx, f)
+	}
+
+	return m.savepoint(ctx, tx, f)
+}
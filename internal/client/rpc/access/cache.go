@@ -0,0 +1,70 @@
+package access
+
+import (
+	"sync"
+	"time"
+)
+
+// maxCacheEntries ограничение размера кэша, при достижении из него удаляются устаревшие записи
+const maxCacheEntries = 10000
+
+// cache хранит положительные ответы сервиса авторизации до истечения TTL
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		entries: make(map[string]time.Time),
+	}
+}
+
+func (c *cache) allowed(key string) bool {
+	if c.ttl <= 0 {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+
+	if !time.Now().Before(expiresAt) {
+		delete(c.entries, key)
+		return false
+	}
+
+	return true
+}
+
+func (c *cache) allow(key string) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	if len(c.entries) >= maxCacheEntries {
+		for k, expiresAt := range c.entries {
+			if !now.Before(expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+
+		// Все записи еще действительны, проще начать заново, чем выбирать, какие вытеснить
+		if len(c.entries) >= maxCacheEntries {
+			c.entries = make(map[string]time.Time)
+		}
+	}
+
+	c.entries[key] = now.Add(c.ttl)
+}
@@ -0,0 +1,143 @@
+package access
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/chat-server/internal/apperr"
+	"github.com/ipv02/chat-server/internal/client/rpc"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/requestid"
+	"github.com/ipv02/chat-server/pkg/access_v1"
+)
+
+var _ rpc.AccessClient = (*client)(nil)
+
+const authorizationHeader = "authorization"
+
+var (
+	// ErrAccessDenied сервис авторизации запретил вызов метода
+	ErrAccessDenied = apperr.PermissionDenied("access denied")
+	// ErrTokenRejected сервис авторизации не принял токен доступа
+	ErrTokenRejected = apperr.Unauthenticated("access service rejected the token")
+	// ErrUnavailable сервис авторизации не ответил, а метод запрещается при отказе
+	ErrUnavailable = apperr.Unavailable("access service is unavailable")
+)
+
+// Options настройки клиента сервиса авторизации
+type Options struct {
+	// Timeout ограничение времени одной проверки, 0 оставляет только дедлайн входящего запроса
+	Timeout time.Duration
+	// CacheTTL время, в течение которого разрешение переиспользуется без обращения к сервису, 0 отключает кэш
+	CacheTTL time.Duration
+	// FailOpenMethods префиксы методов, которые разрешаются, если сервис авторизации не ответил.
+	// Остальные методы в этом случае запрещаются
+	FailOpenMethods []string
+}
+
+type client struct {
+	accessClient    access_v1.AccessV1Client
+	timeout         time.Duration
+	failOpenMethods []string
+	cache           *cache
+}
+
+// NewClient создает клиент сервиса авторизации
+func NewClient(accessClient access_v1.AccessV1Client, opts Options) *client {
+	return &client{
+		accessClient:    accessClient,
+		timeout:         opts.Timeout,
+		failOpenMethods: opts.FailOpenMethods,
+		cache:           newCache(opts.CacheTTL),
+	}
+}
+
+// Check передает сервису авторизации токен входящего запроса и проверяет доступ к методу.
+// Разрешения кэшируются по паре токен и метод, отказы не кэшируются
+func (c *client) Check(ctx context.Context, endpoint string) error {
+	token := incomingAuthorization(ctx)
+	cacheKey := token + " " + endpoint
+
+	if c.cache.allowed(cacheKey) {
+		return nil
+	}
+
+	checkCtx := metadata.NewOutgoingContext(ctx, outgoingMetadata(ctx, token))
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(checkCtx, c.timeout)
+		defer cancel()
+	}
+
+	_, err := c.accessClient.Check(checkCtx, &access_v1.CheckRequest{EndpointAddress: endpoint})
+	if err == nil {
+		c.cache.allow(cacheKey)
+		return nil
+	}
+
+	// Входящий запрос отменен или истек его дедлайн, решение о доступе уже не нужно
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	switch status.Code(err) {
+	case codes.PermissionDenied:
+		return ErrAccessDenied
+	case codes.Unauthenticated:
+		return ErrTokenRejected
+	}
+
+	if c.isFailOpen(endpoint) {
+		slog.WarnContext(ctx, "access check failed, allowing by fail-open policy", slog.String("endpoint", endpoint), logger.Err(err))
+		return nil
+	}
+
+	slog.ErrorContext(ctx, "access check failed", slog.String("endpoint", endpoint), logger.Err(err))
+
+	return ErrUnavailable
+}
+
+func (c *client) isFailOpen(endpoint string) bool {
+	for _, prefix := range c.failOpenMethods {
+		if strings.HasPrefix(endpoint, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func incomingAuthorization(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// outgoingMetadata передает сервису авторизации токен и ID входящего запроса
+func outgoingMetadata(ctx context.Context, token string) metadata.MD {
+	md := metadata.MD{}
+
+	if token != "" {
+		md.Set(authorizationHeader, token)
+	}
+
+	if id := requestid.FromContext(ctx); id != "" {
+		md.Set(requestid.Header, id)
+	}
+
+	return md
+}
@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/client/rpc/access"
+	"github.com/ipv02/chat-server/pkg/access_v1"
+)
+
+const (
+	sendMessage = "/chat_v1.ChatV1/SendMessage"
+	listChats   = "/chat_v1.ChatV1/ListChats"
+)
+
+// fakeAccessServer сервис авторизации в памяти, отвечает заданной ошибкой и запоминает запросы
+type fakeAccessServer struct {
+	access_v1.UnimplementedAccessV1Server
+
+	mu     sync.Mutex
+	err    error
+	delay  time.Duration
+	calls  int
+	tokens []string
+}
+
+func (s *fakeAccessServer) Check(ctx context.Context, _ *access_v1.CheckRequest) (*emptypb.Empty, error) {
+	s.mu.Lock()
+	s.calls++
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.tokens = append(s.tokens, md.Get("authorization")...)
+	err, delay := s.err, s.delay
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *fakeAccessServer) callsCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls
+}
+
+func startFakeServer(t *testing.T, srv *fakeAccessServer) access_v1.AccessV1Client {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	access_v1.RegisterAccessV1Server(server, srv)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return access_v1.NewAccessV1Client(conn)
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token))
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		delay    time.Duration
+		endpoint string
+		want     error
+	}{
+		{
+			name:     "allowed case",
+			endpoint: sendMessage,
+		},
+		{
+			name:     "denied case",
+			err:      status.Error(codes.PermissionDenied, "denied"),
+			endpoint: sendMessage,
+			want:     access.ErrAccessDenied,
+		},
+		{
+			name:     "token rejected case",
+			err:      status.Error(codes.Unauthenticated, "bad token"),
+			endpoint: sendMessage,
+			want:     access.ErrTokenRejected,
+		},
+		{
+			name:     "unavailable fail closed case",
+			err:      status.Error(codes.Unavailable, "down"),
+			endpoint: sendMessage,
+			want:     access.ErrUnavailable,
+		},
+		{
+			name:     "unavailable fail open case",
+			err:      status.Error(codes.Unavailable, "down"),
+			endpoint: listChats,
+		},
+		{
+			name:     "timeout fail closed case",
+			delay:    time.Second,
+			endpoint: sendMessage,
+			want:     access.ErrUnavailable,
+		},
+		{
+			name:     "denied fail open method case",
+			err:      status.Error(codes.PermissionDenied, "denied"),
+			endpoint: listChats,
+			want:     access.ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &fakeAccessServer{err: tt.err, delay: tt.delay}
+			client := access.NewClient(startFakeServer(t, srv), access.Options{
+				Timeout:         50 * time.Millisecond,
+				CacheTTL:        time.Minute,
+				FailOpenMethods: []string{listChats},
+			})
+
+			err := client.Check(withToken("Bearer token"), tt.endpoint)
+			require.Equal(t, tt.want, err)
+		})
+	}
+}
+
+func TestCheckForwardsToken(t *testing.T) {
+	t.Parallel()
+
+	srv := &fakeAccessServer{}
+	client := access.NewClient(startFakeServer(t, srv), access.Options{Timeout: time.Second})
+
+	require.NoError(t, client.Check(withToken("Bearer token"), sendMessage))
+	require.Equal(t, []string{"Bearer token"}, srv.tokens)
+}
+
+func TestCheckCache(t *testing.T) {
+	t.Parallel()
+
+	srv := &fakeAccessServer{}
+	client := access.NewClient(startFakeServer(t, srv), access.Options{
+		Timeout:  time.Second,
+		CacheTTL: 100 * time.Millisecond,
+	})
+
+	require.NoError(t, client.Check(withToken("Bearer first"), sendMessage))
+	require.NoError(t, client.Check(withToken("Bearer first"), sendMessage))
+	require.Equal(t, 1, srv.callsCount(), "repeated check must be served from cache")
+
+	require.NoError(t, client.Check(withToken("Bearer second"), sendMessage))
+	require.NoError(t, client.Check(withToken("Bearer first"), listChats))
+	require.Equal(t, 3, srv.callsCount(), "cache is keyed by token and method")
+
+	require.Eventually(t, func() bool {
+		return client.Check(withToken("Bearer first"), sendMessage) == nil && srv.callsCount() > 3
+	}, time.Second, 20*time.Millisecond, "expired permission must be checked again")
+}
+
+func TestCheckDoesNotCacheDenial(t *testing.T) {
+	t.Parallel()
+
+	srv := &fakeAccessServer{err: status.Error(codes.PermissionDenied, "denied")}
+	client := access.NewClient(startFakeServer(t, srv), access.Options{
+		Timeout:  time.Second,
+		CacheTTL: time.Minute,
+	})
+
+	require.Equal(t, access.ErrAccessDenied, client.Check(withToken("Bearer token"), sendMessage))
+	require.Equal(t, access.ErrAccessDenied, client.Check(withToken("Bearer token"), sendMessage))
+	require.Equal(t, 2, srv.callsCount())
+}
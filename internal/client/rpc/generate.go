@@ -0,0 +1,4 @@
+package rpc
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i AccessClient -o ./mocks/ -s "_minimock.go"
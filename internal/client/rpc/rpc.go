@@ -0,0 +1,11 @@
+package rpc
+
+import (
+	"context"
+)
+
+// AccessClient клиент внешнего сервиса авторизации
+type AccessClient interface {
+	// Check проверяет, может ли пользователь из токена доступа входящего запроса вызвать метод endpoint
+	Check(ctx context.Context, endpoint string) error
+}
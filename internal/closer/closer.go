@@ -1,86 +1,264 @@
 package closer
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"os"
 	"os/signal"
+	"slices"
 	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ipv02/chat-server/internal/logger"
+)
+
+const (
+	// DefaultPhaseTimeout время по умолчанию, которое отводится одной фазе остановки
+	DefaultPhaseTimeout = 10 * time.Second
+
+	// DefaultTimeout жесткий срок по умолчанию на всю остановку
+	DefaultTimeout = 30 * time.Second
 )
 
-var globalCloser = New()
+// Phase фаза остановки приложения, фазы выполняются по возрастанию
+type Phase int
+
+const (
+	// PhaseStopAccepting перестать принимать новую работу: health в NOT_SERVING, закрытие слушателей
+	PhaseStopAccepting Phase = iota
+	// PhaseDrain дождаться завершения текущих запросов и потоков
+	PhaseDrain
+	// PhaseFlush остановить фоновые обработчики и сбросить буферы, например, спаны трассировки
+	PhaseFlush
+	// PhaseCloseDB закрыть соединения с БД, когда ими уже никто не пользуется
+	PhaseCloseDB
+)
+
+var phaseNames = map[Phase]string{
+	PhaseStopAccepting: "stop accepting",
+	PhaseDrain:         "drain",
+	PhaseFlush:         "flush",
+	PhaseCloseDB:       "close db",
+}
+
+// String возвращает название фазы для логов
+func (p Phase) String() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("phase %d", int(p))
+}
+
+// Func функция освобождения ресурса, ctx завершается по истечении времени фазы или жесткого срока
+type Func func(ctx context.Context) error
+
+// Options настройки остановки
+type Options struct {
+	// PhaseTimeout время на одну фазу, по его истечении остановка переходит к следующей фазе
+	PhaseTimeout time.Duration
+	// Timeout жесткий срок на всю остановку, после него оставшиеся фазы пропускаются
+	Timeout time.Duration
+}
+
+var globalCloser = New(Options{}, syscall.SIGINT, syscall.SIGTERM)
 
-// Add adds `func() error` callback to the globalCloser
-func Add(f ...func() error) {
-	globalCloser.Add(f...)
+// Configure задает настройки остановки глобального Closer
+func Configure(opts Options) {
+	globalCloser.Configure(opts)
 }
 
-// Wait ...
-func Wait() {
-	globalCloser.Wait()
+// Add регистрирует функцию в глобальном Closer
+func Add(phase Phase, name string, f Func) {
+	globalCloser.Add(phase, name, f)
 }
 
-// CloseAll ...
-func CloseAll() {
-	globalCloser.CloseAll()
+// Wait дожидается завершения остановки глобального Closer и возвращает ее ошибки
+func Wait() error {
+	return globalCloser.Wait()
 }
 
-// Closer ...
+// CloseAll запускает остановку глобального Closer
+func CloseAll() error {
+	return globalCloser.CloseAll()
+}
+
+type closer struct {
+	name string
+	f    Func
+}
+
+// Closer освобождает ресурсы приложения по фазам: внутри фазы функции выполняются параллельно,
+// следующая фаза начинается только после завершения предыдущей или истечения ее времени
 type Closer struct {
-	mu    sync.Mutex
-	once  sync.Once
-	done  chan struct{}
-	funcs []func() error
+	mu     sync.Mutex
+	once   sync.Once
+	done   chan struct{}
+	err    error
+	opts   Options
+	phases map[Phase][]closer
 }
 
-// New returns new Closer, if []os.Signal is specified Closer will automatically call CloseAll when one of signals is received from OS
-func New(sig ...os.Signal) *Closer {
-	c := &Closer{done: make(chan struct{})}
+// New создает Closer, если переданы сигналы, остановка начнется автоматически при получении любого из них
+func New(opts Options, sig ...os.Signal) *Closer {
+	c := &Closer{
+		done:   make(chan struct{}),
+		opts:   withDefaults(opts),
+		phases: make(map[Phase][]closer),
+	}
+
 	if len(sig) > 0 {
 		go func() {
 			ch := make(chan os.Signal, 1)
 			signal.Notify(ch, sig...)
-			<-ch
+			s := <-ch
 			signal.Stop(ch)
-			c.CloseAll()
+
+			slog.Info("received signal, shutting down", slog.String("signal", s.String()))
+			_ = c.CloseAll()
 		}()
 	}
+
 	return c
 }
 
-// Add func to closer
-func (c *Closer) Add(f ...func() error) {
+func withDefaults(opts Options) Options {
+	if opts.PhaseTimeout <= 0 {
+		opts.PhaseTimeout = DefaultPhaseTimeout
+	}
+
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	return opts
+}
+
+// Configure задает настройки остановки, нулевые значения заменяются значениями по умолчанию
+func (c *Closer) Configure(opts Options) {
+	c.mu.Lock()
+	c.opts = withDefaults(opts)
+	c.mu.Unlock()
+}
+
+// Add регистрирует функцию освобождения ресурса в фазе, name попадает в логи и ошибки
+func (c *Closer) Add(phase Phase, name string, f Func) {
 	c.mu.Lock()
-	c.funcs = append(c.funcs, f...)
+	c.phases[phase] = append(c.phases[phase], closer{name: name, f: f})
 	c.mu.Unlock()
 }
 
-// Wait blocks until all closer functions are done
-func (c *Closer) Wait() {
+// Wait блокируется до завершения остановки и возвращает ее ошибки
+func (c *Closer) Wait() error {
 	<-c.done
+
+	return c.err
 }
 
-// CloseAll calls all closer functions
-func (c *Closer) CloseAll() {
+// CloseAll выполняет фазы остановки по порядку и возвращает объединенные ошибки всех функций.
+// Повторный вызов дожидается первой остановки и возвращает ее результат.
+func (c *Closer) CloseAll() error {
 	c.once.Do(func() {
 		defer close(c.done)
 
 		c.mu.Lock()
-		funcs := c.funcs
-		c.funcs = nil
+		opts := c.opts
+		phases := c.phases
+		c.phases = make(map[Phase][]closer)
 		c.mu.Unlock()
 
-		// call all Closer funcs async
-		errs := make(chan error, len(funcs))
-		for _, f := range funcs {
-			go func(f func() error) {
-				errs <- f()
-			}(f)
+		c.err = closeAll(opts, phases)
+	})
+
+	return c.Wait()
+}
+
+func closeAll(opts Options, phases map[Phase][]closer) error {
+	order := make([]Phase, 0, len(phases))
+	for phase := range phases {
+		order = append(order, phase)
+	}
+	slices.Sort(order)
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancel()
+
+	var errs []error
+	for _, phase := range order {
+		if ctx.Err() != nil {
+			slog.Error("shutdown deadline exceeded, skipping phase", slog.String("phase", phase.String()))
+			errs = append(errs, fmt.Errorf("phase %q skipped: %w", phase, ctx.Err()))
+			continue
 		}
 
-		for i := 0; i < cap(errs); i++ {
-			if err := <-errs; err != nil {
-				log.Println("error returned from Closer")
+		errs = append(errs, runPhase(ctx, opts.PhaseTimeout, phase, phases[phase])...)
+	}
+
+	return errors.Join(errs...)
+}
+
+type result struct {
+	name string
+	err  error
+}
+
+// runPhase запускает функции фазы параллельно и ждет их не дольше timeout.
+// Функции, не успевшие завершиться, продолжают работу в фоне, но остановка переходит к следующей фазе.
+func runPhase(ctx context.Context, timeout time.Duration, phase Phase, closers []closer) []error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	slog.Info("shutdown phase started", slog.String("phase", phase.String()))
+
+	results := make(chan result, len(closers))
+	for _, cl := range closers {
+		go func(cl closer) {
+			results <- result{name: cl.name, err: call(ctx, cl.f)}
+		}(cl)
+	}
+
+	pending := make(map[string]int, len(closers))
+	for _, cl := range closers {
+		pending[cl.name]++
+	}
+
+	var errs []error
+	for range closers {
+		select {
+		case res := <-results:
+			pending[res.name]--
+			if res.err != nil {
+				slog.Error("closer failed",
+					slog.String("closer", res.name), slog.String("phase", phase.String()), logger.Err(res.err))
+				errs = append(errs, fmt.Errorf("%s: %w", res.name, res.err))
 			}
+		case <-ctx.Done():
+			for name, n := range pending {
+				if n > 0 {
+					slog.Error("closer did not finish in time", slog.String("closer", name), slog.String("phase", phase.String()))
+					errs = append(errs, fmt.Errorf("%s: %w", name, ctx.Err()))
+				}
+			}
+
+			return errs
 		}
-	})
+	}
+
+	slog.Info("shutdown phase finished", slog.String("phase", phase.String()))
+
+	return errs
+}
+
+// call выполняет функцию, превращая панику в ошибку, чтобы остальные фазы все равно выполнились
+func call(ctx context.Context, f Func) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic recovered: %v", r)
+		}
+	}()
+
+	return f(ctx)
 }
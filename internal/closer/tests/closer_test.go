@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/closer"
+)
+
+func TestCloseAllRunsPhasesInOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+
+	record := func(name string) closer.Func {
+		return func(context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	c := closer.New(closer.Options{PhaseTimeout: time.Second, Timeout: time.Second})
+	c.Add(closer.PhaseCloseDB, "db", record("db"))
+	c.Add(closer.PhaseFlush, "workers", record("workers"))
+	c.Add(closer.PhaseDrain, "grpc", record("grpc"))
+	c.Add(closer.PhaseStopAccepting, "health", record("health"))
+
+	require.NoError(t, c.CloseAll())
+	require.Equal(t, []string{"health", "grpc", "workers", "db"}, order)
+}
+
+func TestCloseAllRunsPhaseConcurrently(t *testing.T) {
+	t.Parallel()
+
+	// Каждая функция ждет другую, поэтому фаза завершится только при параллельном запуске
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	waitBoth := func(ctx context.Context) error {
+		wg.Done()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	c := closer.New(closer.Options{PhaseTimeout: time.Second, Timeout: time.Second})
+	c.Add(closer.PhaseDrain, "grpc server", waitBoth)
+	c.Add(closer.PhaseDrain, "http server", waitBoth)
+
+	require.NoError(t, c.CloseAll())
+}
+
+func TestCloseAllAggregatesErrors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		errConn = errors.New("connection reset")
+		errPool = errors.New("pool is busy")
+	)
+
+	c := closer.New(closer.Options{PhaseTimeout: time.Second, Timeout: time.Second})
+	c.Add(closer.PhaseFlush, "gateway connection", func(context.Context) error { return errConn })
+	c.Add(closer.PhaseFlush, "tracing", func(context.Context) error { return nil })
+	c.Add(closer.PhaseFlush, "metrics server", func(context.Context) error { panic("boom") })
+	c.Add(closer.PhaseCloseDB, "db client", func(context.Context) error { return errPool })
+
+	err := c.CloseAll()
+	require.ErrorIs(t, err, errConn)
+	require.ErrorIs(t, err, errPool)
+	require.ErrorContains(t, err, "gateway connection: connection reset")
+	require.ErrorContains(t, err, "db client: pool is busy")
+	require.ErrorContains(t, err, "metrics server: panic recovered: boom")
+	require.NotContains(t, err.Error(), "tracing")
+
+	// Повторный вызов и Wait возвращают результат первой остановки
+	require.Equal(t, err, c.CloseAll())
+	require.Equal(t, err, c.Wait())
+}
+
+func TestCloseAllPhaseTimeout(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	dbClosed := make(chan struct{})
+
+	c := closer.New(closer.Options{PhaseTimeout: 20 * time.Millisecond, Timeout: time.Second})
+	c.Add(closer.PhaseDrain, "grpc server", func(context.Context) error {
+		// Игнорирует ctx, как зависший обработчик
+		<-release
+		return nil
+	})
+	c.Add(closer.PhaseCloseDB, "db client", func(context.Context) error {
+		close(dbClosed)
+		return nil
+	})
+
+	err := c.CloseAll()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "grpc server")
+
+	select {
+	case <-dbClosed:
+	default:
+		t.Fatal("db phase did not run after drain timed out")
+	}
+}
+
+func TestCloseAllHardDeadline(t *testing.T) {
+	t.Parallel()
+
+	var dbClosed bool
+
+	c := closer.New(closer.Options{PhaseTimeout: time.Second, Timeout: 20 * time.Millisecond})
+	c.Add(closer.PhaseDrain, "grpc server", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	c.Add(closer.PhaseCloseDB, "db client", func(context.Context) error {
+		dbClosed = true
+		return nil
+	})
+
+	start := time.Now()
+	err := c.CloseAll()
+	require.Less(t, time.Since(start), time.Second)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, `phase "close db" skipped`)
+	require.False(t, dbClosed)
+}
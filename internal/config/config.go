@@ -1,7 +1,12 @@
 package config
 
 import (
+	"log/slog"
+	"time"
+
 	"github.com/joho/godotenv"
+
+	"github.com/ipv02/chat-server/internal/auth"
 )
 
 // Load загружает переменные окружения из указанного файла.
@@ -19,7 +24,89 @@ type GRPCConfig interface {
 	Address() string
 }
 
+// HTTPConfig представляет конфигурацию HTTP сервера REST API.
+type HTTPConfig interface {
+	Address() string
+}
+
+// MetricsConfig представляет конфигурацию HTTP сервера метрик Prometheus.
+type MetricsConfig interface {
+	Address() string
+}
+
+// LoggerConfig представляет настройки логирования.
+type LoggerConfig interface {
+	Level() slog.Level
+	Format() string
+	SQL() bool
+}
+
+// HealthConfig представляет настройки проверки готовности сервера.
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+	ShutdownDelay() time.Duration
+}
+
+// ShutdownConfig представляет ограничения времени остановки сервера.
+type ShutdownConfig interface {
+	PhaseTimeout() time.Duration
+	Timeout() time.Duration
+}
+
+// TransactionConfig представляет настройки повтора транзакций после ошибок сериализации.
+type TransactionConfig interface {
+	MaxRetries() int
+	MinBackoff() time.Duration
+	MaxBackoff() time.Duration
+	RetryBudget() float64
+}
+
+// TracingConfig представляет настройки трассировки OpenTelemetry.
+type TracingConfig interface {
+	ServiceName() string
+	Exporter() string
+	OTLPEndpoint() string
+	StdoutPath() string
+	SampleRatio() float64
+}
+
+// InterceptorConfig представляет настройки интерсепторов gRPC сервера.
+type InterceptorConfig interface {
+	RecoveryEnabled() bool
+	RequestIDEnabled() bool
+	AccessLogEnabled() bool
+	MetricsEnabled() bool
+	ValidationEnabled() bool
+}
+
+// AuthConfig представляет настройки проверки токенов доступа.
+type AuthConfig interface {
+	Keys() []auth.Key
+	Issuer() string
+	Audience() string
+	PublicMethods() []string
+}
+
+// AccessConfig представляет настройки клиента внешнего сервиса авторизации.
+type AccessConfig interface {
+	Enabled() bool
+	Address() string
+	Timeout() time.Duration
+	CacheTTL() time.Duration
+	FailOpenMethods() []string
+}
+
 // PGConfig представляет конфигурацию для подключения к базе данных PostgreSQL.
 type PGConfig interface {
 	DSN() string
+	ReplicaDSNs() []string
+	ReplicaRetryInterval() time.Duration
+}
+
+// ChatConfig представляет настройки бизнес-логики чатов.
+type ChatConfig interface {
+	EditWindow() time.Duration
+	IdempotencyTTL() time.Duration
+	IdempotencyCleanupInterval() time.Duration
 }
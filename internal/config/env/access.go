@@ -0,0 +1,117 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.AccessConfig = (*accessConfig)(nil)
+
+const (
+	accessEnabledEnvName         = "ACCESS_CHECK_ENABLED"
+	accessAddressEnvName         = "ACCESS_SERVICE_ADDRESS"
+	accessTimeoutEnvName         = "ACCESS_CHECK_TIMEOUT"
+	accessCacheTTLEnvName        = "ACCESS_CACHE_TTL"
+	accessFailOpenMethodsEnvName = "ACCESS_FAIL_OPEN_METHODS"
+
+	defaultAccessTimeout  = time.Second
+	defaultAccessCacheTTL = 30 * time.Second
+)
+
+type accessConfig struct {
+	enabled         bool
+	address         string
+	timeout         time.Duration
+	cacheTTL        time.Duration
+	failOpenMethods []string
+}
+
+// NewAccessConfig создает новую конфигурацию клиента сервиса авторизации.
+// Проверка выключена, пока не включена явно, адрес сервиса обязателен только для включенной проверки.
+func NewAccessConfig() (*accessConfig, error) {
+	cfg := &accessConfig{
+		timeout:  defaultAccessTimeout,
+		cacheTTL: defaultAccessCacheTTL,
+	}
+
+	if raw := os.Getenv(accessEnabledEnvName); len(raw) > 0 {
+		enabled, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", accessEnabledEnvName)
+		}
+
+		cfg.enabled = enabled
+	}
+
+	if !cfg.enabled {
+		return cfg, nil
+	}
+
+	cfg.address = os.Getenv(accessAddressEnvName)
+	if len(cfg.address) == 0 {
+		return nil, errors.New("access service address not found")
+	}
+
+	if raw := os.Getenv(accessTimeoutEnvName); len(raw) > 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid access check timeout")
+		}
+
+		if parsed <= 0 {
+			return nil, errors.New("access check timeout must be positive")
+		}
+
+		cfg.timeout = parsed
+	}
+
+	if raw := os.Getenv(accessCacheTTLEnvName); len(raw) > 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid access cache ttl")
+		}
+
+		if parsed < 0 {
+			return nil, errors.New("access cache ttl cannot be negative")
+		}
+
+		cfg.cacheTTL = parsed
+	}
+
+	if raw := os.Getenv(accessFailOpenMethodsEnvName); len(raw) > 0 {
+		cfg.failOpenMethods = strings.Split(raw, ",")
+	}
+
+	return cfg, nil
+}
+
+// Enabled включает проверку доступа к методам чата во внешнем сервисе
+func (cfg *accessConfig) Enabled() bool {
+	return cfg.enabled
+}
+
+// Address адрес сервиса авторизации
+func (cfg *accessConfig) Address() string {
+	return cfg.address
+}
+
+// Timeout ограничение времени одной проверки
+func (cfg *accessConfig) Timeout() time.Duration {
+	return cfg.timeout
+}
+
+// CacheTTL время жизни закэшированного разрешения, 0 отключает кэш
+func (cfg *accessConfig) CacheTTL() time.Duration {
+	return cfg.cacheTTL
+}
+
+// FailOpenMethods префиксы методов, разрешаемых при недоступности сервиса авторизации
+func (cfg *accessConfig) FailOpenMethods() []string {
+	return cfg.failOpenMethods
+}
@@ -0,0 +1,124 @@
+package env
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.AuthConfig = (*authConfig)(nil)
+
+const (
+	authKeysEnvName          = "AUTH_JWT_KEYS"
+	authIssuerEnvName        = "AUTH_JWT_ISSUER"
+	authAudienceEnvName      = "AUTH_JWT_AUDIENCE"
+	authPublicMethodsEnvName = "AUTH_PUBLIC_METHODS"
+)
+
+// defaultPublicMethods health check и reflection доступны без токена
+var defaultPublicMethods = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+type authConfig struct {
+	keys          []auth.Key
+	issuer        string
+	audience      string
+	publicMethods []string
+}
+
+// NewAuthConfig создает новую конфигурацию проверки токенов доступа.
+// Ключи задаются через запятую в виде kid:HS256:секрет-в-base64 или kid:RS256:путь-к-открытому-ключу-PEM.
+func NewAuthConfig() (*authConfig, error) {
+	raw := os.Getenv(authKeysEnvName)
+	if len(raw) == 0 {
+		return nil, errors.New("auth jwt keys not found")
+	}
+
+	var keys []auth.Key
+	for _, entry := range strings.Split(raw, ",") {
+		key, err := parseAuthKey(strings.TrimSpace(entry))
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	publicMethods := defaultPublicMethods
+	if raw := os.Getenv(authPublicMethodsEnvName); len(raw) > 0 {
+		publicMethods = strings.Split(raw, ",")
+	}
+
+	return &authConfig{
+		keys:          keys,
+		issuer:        os.Getenv(authIssuerEnvName),
+		audience:      os.Getenv(authAudienceEnvName),
+		publicMethods: publicMethods,
+	}, nil
+}
+
+func parseAuthKey(entry string) (auth.Key, error) {
+	parts := strings.SplitN(entry, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return auth.Key{}, fmt.Errorf("invalid auth key %q, expected kid:algorithm:value", entry)
+	}
+
+	key := auth.Key{
+		ID:        parts[0],
+		Algorithm: parts[1],
+	}
+
+	switch key.Algorithm {
+	case auth.AlgorithmHS256:
+		secret, err := base64.StdEncoding.DecodeString(parts[2])
+		if err != nil {
+			return auth.Key{}, errors.Wrapf(err, "invalid secret of auth key %q", key.ID)
+		}
+
+		key.Secret = secret
+	case auth.AlgorithmRS256:
+		pem, err := os.ReadFile(parts[2])
+		if err != nil {
+			return auth.Key{}, errors.Wrapf(err, "failed to read public key of auth key %q", key.ID)
+		}
+
+		key.PublicKey, err = jwt.ParseRSAPublicKeyFromPEM(pem)
+		if err != nil {
+			return auth.Key{}, errors.Wrapf(err, "invalid public key of auth key %q", key.ID)
+		}
+	default:
+		return auth.Key{}, fmt.Errorf("unsupported algorithm %q of auth key %q", key.Algorithm, key.ID)
+	}
+
+	return key, nil
+}
+
+// Keys ключи проверки подписи токенов
+func (cfg *authConfig) Keys() []auth.Key {
+	return cfg.keys
+}
+
+// Issuer ожидаемый издатель токенов, пустой не проверяется
+func (cfg *authConfig) Issuer() string {
+	return cfg.issuer
+}
+
+// Audience ожидаемая аудитория токенов, пустая не проверяется
+func (cfg *authConfig) Audience() string {
+	return cfg.audience
+}
+
+// PublicMethods префиксы методов, доступных без токена
+func (cfg *authConfig) PublicMethods() []string {
+	return cfg.publicMethods
+}
@@ -0,0 +1,97 @@
+package env
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.ChatConfig = (*chatConfig)(nil)
+
+const (
+	chatEditWindowEnvName         = "CHAT_EDIT_WINDOW"
+	chatIdempotencyTTLEnvName     = "CHAT_IDEMPOTENCY_TTL"
+	chatIdempotencyCleanupEnvName = "CHAT_IDEMPOTENCY_CLEANUP_INTERVAL"
+
+	defaultEditWindow         = 15 * time.Minute
+	defaultIdempotencyTTL     = 24 * time.Hour
+	defaultIdempotencyCleanup = time.Hour
+)
+
+type chatConfig struct {
+	editWindow         time.Duration
+	idempotencyTTL     time.Duration
+	idempotencyCleanup time.Duration
+}
+
+// NewChatConfig создает новую конфигурацию бизнес-логики чатов.
+func NewChatConfig() (*chatConfig, error) {
+	editWindow := defaultEditWindow
+
+	if raw := os.Getenv(chatEditWindowEnvName); len(raw) > 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid chat edit window")
+		}
+
+		if parsed < 0 {
+			return nil, errors.New("chat edit window cannot be negative")
+		}
+
+		editWindow = parsed
+	}
+
+	idempotencyTTL := defaultIdempotencyTTL
+
+	if raw := os.Getenv(chatIdempotencyTTLEnvName); len(raw) > 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid chat idempotency ttl")
+		}
+
+		if parsed <= 0 {
+			return nil, errors.New("chat idempotency ttl must be positive")
+		}
+
+		idempotencyTTL = parsed
+	}
+
+	idempotencyCleanup := defaultIdempotencyCleanup
+
+	if raw := os.Getenv(chatIdempotencyCleanupEnvName); len(raw) > 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid chat idempotency cleanup interval")
+		}
+
+		if parsed <= 0 {
+			return nil, errors.New("chat idempotency cleanup interval must be positive")
+		}
+
+		idempotencyCleanup = parsed
+	}
+
+	return &chatConfig{
+		editWindow:         editWindow,
+		idempotencyTTL:     idempotencyTTL,
+		idempotencyCleanup: idempotencyCleanup,
+	}, nil
+}
+
+// EditWindow время после отправки, в течение которого сообщение можно редактировать, 0 снимает ограничение
+func (cfg *chatConfig) EditWindow() time.Duration {
+	return cfg.editWindow
+}
+
+// IdempotencyTTL время, в течение которого повтор запроса с тем же ключом идемпотентности возвращает исходный результат
+func (cfg *chatConfig) IdempotencyTTL() time.Duration {
+	return cfg.idempotencyTTL
+}
+
+// IdempotencyCleanupInterval период удаления просроченных ключей идемпотентности
+func (cfg *chatConfig) IdempotencyCleanupInterval() time.Duration {
+	return cfg.idempotencyCleanup
+}
@@ -0,0 +1,81 @@
+package env
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.HealthConfig = (*healthConfig)(nil)
+
+const (
+	healthCheckIntervalEnvName = "HEALTH_CHECK_INTERVAL"
+	healthCheckTimeoutEnvName  = "HEALTH_CHECK_TIMEOUT"
+	healthShutdownDelayEnvName = "HEALTH_SHUTDOWN_DELAY"
+
+	defaultHealthCheckInterval = 5 * time.Second
+	defaultHealthCheckTimeout  = time.Second
+)
+
+type healthConfig struct {
+	checkInterval time.Duration
+	checkTimeout  time.Duration
+	shutdownDelay time.Duration
+}
+
+// NewHealthConfig создает новую конфигурацию проверки готовности сервера.
+func NewHealthConfig() (*healthConfig, error) {
+	cfg := &healthConfig{
+		checkInterval: defaultHealthCheckInterval,
+		checkTimeout:  defaultHealthCheckTimeout,
+	}
+
+	durations := []struct {
+		envName  string
+		value    *time.Duration
+		positive bool
+	}{
+		{healthCheckIntervalEnvName, &cfg.checkInterval, true},
+		{healthCheckTimeoutEnvName, &cfg.checkTimeout, true},
+		{healthShutdownDelayEnvName, &cfg.shutdownDelay, false},
+	}
+
+	for _, d := range durations {
+		raw := os.Getenv(d.envName)
+		if len(raw) == 0 {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", d.envName)
+		}
+
+		if parsed < 0 || (d.positive && parsed == 0) {
+			return nil, errors.Errorf("%s is out of range", d.envName)
+		}
+
+		*d.value = parsed
+	}
+
+	return cfg, nil
+}
+
+// CheckInterval интервал проверки доступности БД
+func (cfg *healthConfig) CheckInterval() time.Duration {
+	return cfg.checkInterval
+}
+
+// CheckTimeout ограничение времени одной проверки
+func (cfg *healthConfig) CheckTimeout() time.Duration {
+	return cfg.checkTimeout
+}
+
+// ShutdownDelay пауза между переходом в NOT_SERVING и остановкой сервера,
+// за которую балансировщик успевает исключить экземпляр
+func (cfg *healthConfig) ShutdownDelay() time.Duration {
+	return cfg.shutdownDelay
+}
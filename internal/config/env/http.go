@@ -0,0 +1,43 @@
+package env
+
+import (
+	"errors"
+	"net"
+	"os"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.HTTPConfig = (*httpConfig)(nil)
+
+const (
+	httpHostEnvName = "HTTP_HOST"
+	httpPortEnvName = "HTTP_PORT"
+)
+
+type httpConfig struct {
+	host string
+	port string
+}
+
+// NewHTTPConfig создает новую конфигурацию HTTP сервера REST API.
+func NewHTTPConfig() (*httpConfig, error) {
+	host := os.Getenv(httpHostEnvName)
+	if len(host) == 0 {
+		return nil, errors.New("http host not found")
+	}
+
+	port := os.Getenv(httpPortEnvName)
+	if len(port) == 0 {
+		return nil, errors.New("http port not found")
+	}
+
+	return &httpConfig{
+		host: host,
+		port: port,
+	}, nil
+}
+
+func (cfg *httpConfig) Address() string {
+	return net.JoinHostPort(cfg.host, cfg.port)
+}
@@ -0,0 +1,89 @@
+package env
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.InterceptorConfig = (*interceptorConfig)(nil)
+
+const (
+	grpcRecoveryEnvName   = "GRPC_RECOVERY_ENABLED"
+	grpcRequestIDEnvName  = "GRPC_REQUEST_ID_ENABLED"
+	grpcAccessLogEnvName  = "GRPC_ACCESS_LOG_ENABLED"
+	grpcMetricsEnvName    = "GRPC_METRICS_ENABLED"
+	grpcValidationEnvName = "GRPC_VALIDATION_ENABLED"
+)
+
+type interceptorConfig struct {
+	recovery   bool
+	requestID  bool
+	accessLog  bool
+	metrics    bool
+	validation bool
+}
+
+// NewInterceptorConfig создает новую конфигурацию интерсепторов gRPC сервера.
+// Вспомогательные интерсепторы выключены, пока не включены явно. Валидация включена по умолчанию:
+// обработчики не проверяют запросы сами, поэтому ее выключение пропускает невалидные запросы в сервис.
+func NewInterceptorConfig() (*interceptorConfig, error) {
+	cfg := &interceptorConfig{
+		validation: true,
+	}
+
+	flags := []struct {
+		envName string
+		value   *bool
+	}{
+		{grpcRecoveryEnvName, &cfg.recovery},
+		{grpcRequestIDEnvName, &cfg.requestID},
+		{grpcAccessLogEnvName, &cfg.accessLog},
+		{grpcMetricsEnvName, &cfg.metrics},
+		{grpcValidationEnvName, &cfg.validation},
+	}
+
+	for _, flag := range flags {
+		raw := os.Getenv(flag.envName)
+		if len(raw) == 0 {
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", flag.envName)
+		}
+
+		*flag.value = enabled
+	}
+
+	return cfg, nil
+}
+
+// RecoveryEnabled включает перехват паники в обработчиках
+func (cfg *interceptorConfig) RecoveryEnabled() bool {
+	return cfg.recovery
+}
+
+// RequestIDEnabled включает передачу ID запроса
+func (cfg *interceptorConfig) RequestIDEnabled() bool {
+	return cfg.requestID
+}
+
+// AccessLogEnabled включает логирование запросов
+func (cfg *interceptorConfig) AccessLogEnabled() bool {
+	return cfg.accessLog
+}
+
+// MetricsEnabled включает учет запросов в метриках
+func (cfg *interceptorConfig) MetricsEnabled() bool {
+	return cfg.metrics
+}
+
+// ValidationEnabled включает автоматическую валидацию запросов, по умолчанию включена
+func (cfg *interceptorConfig) ValidationEnabled() bool {
+	return cfg.validation
+}
@@ -0,0 +1,79 @@
+package env
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+	"github.com/ipv02/chat-server/internal/logger"
+)
+
+var _ config.LoggerConfig = (*loggerConfig)(nil)
+
+const (
+	logLevelEnvName  = "LOG_LEVEL"
+	logFormatEnvName = "LOG_FORMAT"
+	logSQLEnvName    = "LOG_SQL"
+)
+
+type loggerConfig struct {
+	level  slog.Level
+	format string
+	sql    bool
+}
+
+// NewLoggerConfig создает новую конфигурацию логирования.
+// По умолчанию уровень info, формат json, SQL-запросы не логируются.
+func NewLoggerConfig() (*loggerConfig, error) {
+	cfg := &loggerConfig{
+		level:  slog.LevelInfo,
+		format: logger.FormatJSON,
+	}
+
+	if raw := os.Getenv(logLevelEnvName); len(raw) > 0 {
+		level, err := logger.ParseLevel(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", logLevelEnvName)
+		}
+
+		cfg.level = level
+	}
+
+	if raw := os.Getenv(logFormatEnvName); len(raw) > 0 {
+		if raw != logger.FormatJSON && raw != logger.FormatText {
+			return nil, errors.Errorf("%s must be %q or %q", logFormatEnvName, logger.FormatJSON, logger.FormatText)
+		}
+
+		cfg.format = raw
+	}
+
+	if raw := os.Getenv(logSQLEnvName); len(raw) > 0 {
+		enabled, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", logSQLEnvName)
+		}
+
+		cfg.sql = enabled
+	}
+
+	return cfg, nil
+}
+
+// Level минимальный уровень записей
+func (cfg *loggerConfig) Level() slog.Level {
+	return cfg.level
+}
+
+// Format формат вывода: json или text
+func (cfg *loggerConfig) Format() string {
+	return cfg.format
+}
+
+// SQL логировать ли SQL-запросы. Запросы пишутся с уровнем DEBUG,
+// аргументы, помеченные как чувствительные, заменяются заглушкой
+func (cfg *loggerConfig) SQL() bool {
+	return cfg.sql
+}
@@ -0,0 +1,43 @@
+package env
+
+import (
+	"errors"
+	"net"
+	"os"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.MetricsConfig = (*metricsConfig)(nil)
+
+const (
+	metricsHostEnvName = "METRICS_HOST"
+	metricsPortEnvName = "METRICS_PORT"
+)
+
+type metricsConfig struct {
+	host string
+	port string
+}
+
+// NewMetricsConfig создает новую конфигурацию HTTP сервера метрик.
+func NewMetricsConfig() (*metricsConfig, error) {
+	host := os.Getenv(metricsHostEnvName)
+	if len(host) == 0 {
+		return nil, errors.New("metrics host not found")
+	}
+
+	port := os.Getenv(metricsPortEnvName)
+	if len(port) == 0 {
+		return nil, errors.New("metrics port not found")
+	}
+
+	return &metricsConfig{
+		host: host,
+		port: port,
+	}, nil
+}
+
+func (cfg *metricsConfig) Address() string {
+	return net.JoinHostPort(cfg.host, cfg.port)
+}
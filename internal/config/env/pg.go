@@ -1,8 +1,11 @@
 package env
 
 import (
-	"errors"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/ipv02/chat-server/internal/config"
 )
@@ -10,11 +13,20 @@ import (
 var _ config.PGConfig = (*pgConfig)(nil)
 
 const (
-	dsnEnvName = "PG_DSN"
+	dsnEnvName                  = "PG_DSN"
+	replicaDSNsEnvName          = "PG_REPLICA_DSNS"
+	replicaRetryIntervalEnvName = "PG_REPLICA_RETRY_INTERVAL"
+
+	// replicaDSNSeparator разделяет DSN реплик: запятая встречается внутри DSN со списком хостов
+	replicaDSNSeparator = ";"
+
+	defaultReplicaRetryInterval = 5 * time.Second
 )
 
 type pgConfig struct {
-	dsn string
+	dsn                  string
+	replicaDSNs          []string
+	replicaRetryInterval time.Duration
 }
 
 // NewPGConfig создает новую конфигурацию для подключения к PostgreSQL.
@@ -24,11 +36,44 @@ func NewPGConfig() (*pgConfig, error) {
 		return nil, errors.New("pg dsn not found")
 	}
 
-	return &pgConfig{
-		dsn: dsn,
-	}, nil
+	cfg := &pgConfig{
+		dsn:                  dsn,
+		replicaRetryInterval: defaultReplicaRetryInterval,
+	}
+
+	for _, replicaDSN := range strings.Split(os.Getenv(replicaDSNsEnvName), replicaDSNSeparator) {
+		replicaDSN = strings.TrimSpace(replicaDSN)
+		if len(replicaDSN) > 0 {
+			cfg.replicaDSNs = append(cfg.replicaDSNs, replicaDSN)
+		}
+	}
+
+	if raw := os.Getenv(replicaRetryIntervalEnvName); len(raw) > 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", replicaRetryIntervalEnvName)
+		}
+
+		if parsed <= 0 {
+			return nil, errors.Errorf("%s must be positive", replicaRetryIntervalEnvName)
+		}
+
+		cfg.replicaRetryInterval = parsed
+	}
+
+	return cfg, nil
 }
 
 func (cfg *pgConfig) DSN() string {
 	return cfg.dsn
 }
+
+// ReplicaDSNs DSN реплик для запросов на чтение, пустой список означает чтение из основной БД
+func (cfg *pgConfig) ReplicaDSNs() []string {
+	return cfg.replicaDSNs
+}
+
+// ReplicaRetryInterval на сколько отказавшая реплика исключается из ротации
+func (cfg *pgConfig) ReplicaRetryInterval() time.Duration {
+	return cfg.replicaRetryInterval
+}
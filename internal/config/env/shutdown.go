@@ -0,0 +1,75 @@
+package env
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.ShutdownConfig = (*shutdownConfig)(nil)
+
+const (
+	shutdownPhaseTimeoutEnvName = "SHUTDOWN_PHASE_TIMEOUT"
+	shutdownTimeoutEnvName      = "SHUTDOWN_TIMEOUT"
+
+	defaultShutdownPhaseTimeout = 10 * time.Second
+	defaultShutdownTimeout      = 30 * time.Second
+)
+
+type shutdownConfig struct {
+	phaseTimeout time.Duration
+	timeout      time.Duration
+}
+
+// NewShutdownConfig создает новую конфигурацию остановки сервера.
+func NewShutdownConfig() (*shutdownConfig, error) {
+	cfg := &shutdownConfig{
+		phaseTimeout: defaultShutdownPhaseTimeout,
+		timeout:      defaultShutdownTimeout,
+	}
+
+	durations := []struct {
+		envName string
+		value   *time.Duration
+	}{
+		{shutdownPhaseTimeoutEnvName, &cfg.phaseTimeout},
+		{shutdownTimeoutEnvName, &cfg.timeout},
+	}
+
+	for _, d := range durations {
+		raw := os.Getenv(d.envName)
+		if len(raw) == 0 {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", d.envName)
+		}
+
+		if parsed <= 0 {
+			return nil, errors.Errorf("%s must be positive", d.envName)
+		}
+
+		*d.value = parsed
+	}
+
+	if cfg.timeout < cfg.phaseTimeout {
+		return nil, errors.Errorf("%s must not be less than %s", shutdownTimeoutEnvName, shutdownPhaseTimeoutEnvName)
+	}
+
+	return cfg, nil
+}
+
+// PhaseTimeout время на одну фазу остановки
+func (cfg *shutdownConfig) PhaseTimeout() time.Duration {
+	return cfg.phaseTimeout
+}
+
+// Timeout жесткий срок на всю остановку
+func (cfg *shutdownConfig) Timeout() time.Duration {
+	return cfg.timeout
+}
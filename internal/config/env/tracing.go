@@ -0,0 +1,103 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+	"github.com/ipv02/chat-server/internal/tracing"
+)
+
+var _ config.TracingConfig = (*tracingConfig)(nil)
+
+const (
+	tracingServiceNameEnvName  = "TRACING_SERVICE_NAME"
+	tracingExporterEnvName     = "TRACING_EXPORTER"
+	tracingOTLPEndpointEnvName = "TRACING_OTLP_ENDPOINT"
+	tracingStdoutPathEnvName   = "TRACING_STDOUT_PATH"
+	tracingSampleRatioEnvName  = "TRACING_SAMPLE_RATIO"
+
+	defaultTracingServiceName = "chat-server"
+	defaultTracingSampleRatio = 1.0
+)
+
+type tracingConfig struct {
+	serviceName  string
+	exporter     string
+	otlpEndpoint string
+	stdoutPath   string
+	sampleRatio  float64
+}
+
+// NewTracingConfig создает новую конфигурацию трассировки.
+// Без TRACING_EXPORTER спаны не экспортируются.
+func NewTracingConfig() (*tracingConfig, error) {
+	cfg := &tracingConfig{
+		serviceName: defaultTracingServiceName,
+		exporter:    tracing.ExporterNone,
+		stdoutPath:  os.Getenv(tracingStdoutPathEnvName),
+		sampleRatio: defaultTracingSampleRatio,
+	}
+
+	if raw := os.Getenv(tracingServiceNameEnvName); len(raw) > 0 {
+		cfg.serviceName = raw
+	}
+
+	if raw := os.Getenv(tracingExporterEnvName); len(raw) > 0 {
+		cfg.exporter = raw
+	}
+
+	switch cfg.exporter {
+	case tracing.ExporterNone, tracing.ExporterStdout:
+	case tracing.ExporterOTLP:
+		cfg.otlpEndpoint = os.Getenv(tracingOTLPEndpointEnvName)
+		if len(cfg.otlpEndpoint) == 0 {
+			return nil, errors.New("tracing otlp endpoint not found")
+		}
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.exporter)
+	}
+
+	if raw := os.Getenv(tracingSampleRatioEnvName); len(raw) > 0 {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid tracing sample ratio")
+		}
+
+		if parsed < 0 || parsed > 1 {
+			return nil, errors.New("tracing sample ratio must be between 0 and 1")
+		}
+
+		cfg.sampleRatio = parsed
+	}
+
+	return cfg, nil
+}
+
+// ServiceName имя сервиса в спанах
+func (cfg *tracingConfig) ServiceName() string {
+	return cfg.serviceName
+}
+
+// Exporter экспортер спанов: none, otlp или stdout
+func (cfg *tracingConfig) Exporter() string {
+	return cfg.exporter
+}
+
+// OTLPEndpoint адрес коллектора OTLP/gRPC
+func (cfg *tracingConfig) OTLPEndpoint() string {
+	return cfg.otlpEndpoint
+}
+
+// StdoutPath файл для экспортера stdout, пустой путь означает stdout
+func (cfg *tracingConfig) StdoutPath() string {
+	return cfg.stdoutPath
+}
+
+// SampleRatio доля трассируемых запросов без входящего контекста трассировки
+func (cfg *tracingConfig) SampleRatio() float64 {
+	return cfg.sampleRatio
+}
@@ -0,0 +1,120 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ipv02/chat-server/internal/config"
+)
+
+var _ config.TransactionConfig = (*transactionConfig)(nil)
+
+const (
+	txMaxRetriesEnvName      = "TX_MAX_RETRIES"
+	txRetryMinBackoffEnvName = "TX_RETRY_MIN_BACKOFF"
+	txRetryMaxBackoffEnvName = "TX_RETRY_MAX_BACKOFF"
+	txRetryBudgetEnvName     = "TX_RETRY_BUDGET"
+
+	defaultTxMaxRetries      = 3
+	defaultTxRetryMinBackoff = 10 * time.Millisecond
+	defaultTxRetryMaxBackoff = 500 * time.Millisecond
+	defaultTxRetryBudget     = 100
+)
+
+type transactionConfig struct {
+	maxRetries  int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	retryBudget float64
+}
+
+// NewTransactionConfig создает новую конфигурацию повтора транзакций.
+func NewTransactionConfig() (*transactionConfig, error) {
+	cfg := &transactionConfig{
+		maxRetries:  defaultTxMaxRetries,
+		minBackoff:  defaultTxRetryMinBackoff,
+		maxBackoff:  defaultTxRetryMaxBackoff,
+		retryBudget: defaultTxRetryBudget,
+	}
+
+	if raw := os.Getenv(txMaxRetriesEnvName); len(raw) > 0 {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", txMaxRetriesEnvName)
+		}
+
+		if parsed < 0 {
+			return nil, errors.Errorf("%s must not be negative", txMaxRetriesEnvName)
+		}
+
+		cfg.maxRetries = parsed
+	}
+
+	durations := []struct {
+		envName string
+		value   *time.Duration
+	}{
+		{txRetryMinBackoffEnvName, &cfg.minBackoff},
+		{txRetryMaxBackoffEnvName, &cfg.maxBackoff},
+	}
+
+	for _, d := range durations {
+		raw := os.Getenv(d.envName)
+		if len(raw) == 0 {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", d.envName)
+		}
+
+		if parsed <= 0 {
+			return nil, errors.Errorf("%s must be positive", d.envName)
+		}
+
+		*d.value = parsed
+	}
+
+	if cfg.maxBackoff < cfg.minBackoff {
+		return nil, errors.Errorf("%s must not be less than %s", txRetryMaxBackoffEnvName, txRetryMinBackoffEnvName)
+	}
+
+	if raw := os.Getenv(txRetryBudgetEnvName); len(raw) > 0 {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", txRetryBudgetEnvName)
+		}
+
+		if parsed < 0 {
+			return nil, errors.Errorf("%s must not be negative", txRetryBudgetEnvName)
+		}
+
+		cfg.retryBudget = parsed
+	}
+
+	return cfg, nil
+}
+
+// MaxRetries сколько раз повторяется транзакция после ошибки сериализации
+func (cfg *transactionConfig) MaxRetries() int {
+	return cfg.maxRetries
+}
+
+// MinBackoff пауза перед первым повтором
+func (cfg *transactionConfig) MinBackoff() time.Duration {
+	return cfg.minBackoff
+}
+
+// MaxBackoff максимальная пауза между повторами
+func (cfg *transactionConfig) MaxBackoff() time.Duration {
+	return cfg.maxBackoff
+}
+
+// RetryBudget сколько повторов можно сделать подряд без успешных транзакций, 0 - без ограничения
+func (cfg *transactionConfig) RetryBudget() float64 {
+	return cfg.retryBudget
+}
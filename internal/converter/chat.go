@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ToChatFromService конвертер модели бизнес-логики в протомодель
+func ToChatFromService(chat *model.Chat) *chat_v1.Chat {
+	if chat == nil {
+		return nil
+	}
+
+	res := &chat_v1.Chat{
+		Id:          chat.ID,
+		Name:        chat.Name,
+		CreatedAt:   timestamppb.New(chat.CreatedAt),
+		UpdatedAt:   timestamppb.New(chat.UpdatedAt),
+		LastMessage: ToMessageFromService(chat.LastMessage),
+		UnreadCount: chat.UnreadCount,
+	}
+
+	if chat.Members != nil {
+		res.Members = ToMembersFromService(chat.Members)
+	}
+
+	if chat.LastMessageAt != nil {
+		res.LastMessageAt = timestamppb.New(*chat.LastMessageAt)
+	}
+
+	return res
+}
+
+// ToChatListFilterFromReq конвертер протомодели в модель бизнес-логики
+func ToChatListFilterFromReq(req *chat_v1.ListChatsRequest) (*model.ChatListFilter, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	cursor, err := DecodeChatCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ChatListFilter{
+		UserID: req.UserId,
+		Limit:  uint64(req.PageSize),
+		Cursor: cursor,
+	}, nil
+}
+
+// ToListChatsResponseFromService конвертер модели бизнес-логики в протомодель
+func ToListChatsResponseFromService(list *model.ChatList) *chat_v1.ListChatsResponse {
+	chats := make([]*chat_v1.Chat, 0, len(list.Chats))
+	for _, chat := range list.Chats {
+		chats = append(chats, ToChatFromService(chat))
+	}
+
+	return &chat_v1.ListChatsResponse{
+		Chats:      chats,
+		NextCursor: EncodeChatCursor(list.NextCursor),
+	}
+}
+
+// ToChatReadFromReq конвертер протомодели в модель бизнес-логики
+func ToChatReadFromReq(req *chat_v1.MarkReadRequest) *model.ChatRead {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatRead{
+		ChatID:    req.ChatId,
+		UserID:    req.UserId,
+		MessageID: req.MessageId,
+	}
+}
+
+// ToGetReadReceiptsResponseFromService конвертер модели бизнес-логики в протомодель
+func ToGetReadReceiptsResponseFromService(receipts []*model.ReadReceipt) *chat_v1.GetReadReceiptsResponse {
+	res := make([]*chat_v1.ReadReceipt, 0, len(receipts))
+	for _, receipt := range receipts {
+		res = append(res, &chat_v1.ReadReceipt{
+			UserId: receipt.UserID,
+			ReadAt: timestamppb.New(receipt.ReadAt),
+		})
+	}
+
+	return &chat_v1.GetReadReceiptsResponse{
+		Receipts: res,
+	}
+}
@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/ipv02/chat-server/internal/model"
 	"github.com/ipv02/chat-server/pkg/chat_v1"
 )
@@ -12,8 +14,22 @@ func ToChatCreateFromReq(chat *chat_v1.CreateChatRequest) *model.ChatCreate {
 	}
 
 	return &model.ChatCreate{
-		UsersID:  chat.UsersId,
-		ChatName: chat.ChatName,
+		UsersID:        chat.UsersId,
+		ChatName:       chat.ChatName,
+		CreatorID:      chat.CreatorId,
+		IdempotencyKey: chat.IdempotencyKey,
+	}
+}
+
+// ToChatDeleteFromReq конвертер протомодели в модель бизнес-логики
+func ToChatDeleteFromReq(req *chat_v1.DeleteChatRequest) *model.ChatDelete {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatDelete{
+		ID:     req.Id,
+		UserID: req.UserId,
 	}
 }
 
@@ -24,8 +40,247 @@ func ToChatSendMessage(chat *chat_v1.SendMessageRequest) *model.ChatSendMessage
 	}
 
 	return &model.ChatSendMessage{
-		From:      chat.From,
-		Text:      chat.Text,
-		Timestamp: chat.Timestamp,
+		ChatID:           chat.ChatId,
+		From:             chat.From,
+		Text:             chat.Text,
+		Timestamp:        chat.Timestamp,
+		ReplyToMessageID: chat.ReplyToMessageId,
+		IdempotencyKey:   chat.IdempotencyKey,
+	}
+}
+
+// ToChatConnectFromReq конвертер протомодели в модель бизнес-логики
+func ToChatConnectFromReq(req *chat_v1.ConnectChatRequest) *model.ChatConnect {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatConnect{
+		ChatID: req.ChatId,
+		UserID: req.UserId,
+	}
+}
+
+// ToMessageListFilterFromReq конвертер протомодели в модель бизнес-логики
+func ToMessageListFilterFromReq(req *chat_v1.ListMessagesRequest) (*model.MessageListFilter, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	cursor, err := DecodeMessageCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &model.MessageListFilter{
+		ChatID: req.ChatId,
+		Limit:  uint64(req.PageSize),
+		Cursor: cursor,
+	}
+
+	if req.Before != nil {
+		before := req.Before.AsTime()
+		filter.Before = &before
+	}
+
+	if req.After != nil {
+		after := req.After.AsTime()
+		filter.After = &after
+	}
+
+	return filter, nil
+}
+
+// ToMessageFromService конвертер модели бизнес-логики в протомодель
+func ToMessageFromService(message *model.Message) *chat_v1.Message {
+	if message == nil {
+		return nil
+	}
+
+	res := &chat_v1.Message{
+		Id:               message.ID,
+		ChatId:           message.ChatID,
+		From:             message.From,
+		Text:             message.Text,
+		CreatedAt:        timestamppb.New(message.CreatedAt),
+		ReplyToMessageId: message.ReplyToMessageID,
+		ReplyCount:       message.ReplyCount,
+	}
+
+	if message.EditedAt != nil {
+		res.EditedAt = timestamppb.New(*message.EditedAt)
+	}
+
+	if message.DeletedAt != nil {
+		res.DeletedAt = timestamppb.New(*message.DeletedAt)
+	}
+
+	if message.LastReplyAt != nil {
+		res.LastReplyAt = timestamppb.New(*message.LastReplyAt)
+	}
+
+	if message.PinnedAt != nil {
+		res.PinnedAt = timestamppb.New(*message.PinnedAt)
+	}
+
+	return res
+}
+
+// ToListMessagesResponseFromService конвертер модели бизнес-логики в протомодель
+func ToListMessagesResponseFromService(list *model.MessageList) *chat_v1.ListMessagesResponse {
+	messages := make([]*chat_v1.Message, 0, len(list.Messages))
+	for _, message := range list.Messages {
+		messages = append(messages, ToMessageFromService(message))
+	}
+
+	return &chat_v1.ListMessagesResponse{
+		Messages:   messages,
+		NextCursor: EncodeMessageCursor(list.NextCursor),
+	}
+}
+
+// ToChatMembersFromAddReq конвертер протомодели в модель бизнес-логики
+func ToChatMembersFromAddReq(req *chat_v1.AddMembersRequest) *model.ChatMembers {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatMembers{
+		ChatID:  req.ChatId,
+		UsersID: req.UsersId,
+		UserID:  req.UserId,
+	}
+}
+
+// ToChatMembersFromRemoveReq конвертер протомодели в модель бизнес-логики
+func ToChatMembersFromRemoveReq(req *chat_v1.RemoveMembersRequest) *model.ChatMembers {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatMembers{
+		ChatID:  req.ChatId,
+		UsersID: req.UsersId,
+		UserID:  req.UserId,
+	}
+}
+
+// ToChatLeaveFromReq конвертер протомодели в модель бизнес-логики
+func ToChatLeaveFromReq(req *chat_v1.LeaveChatRequest) *model.ChatLeave {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatLeave{
+		ChatID: req.ChatId,
+		UserID: req.UserId,
+	}
+}
+
+// ToMemberListFilterFromReq конвертер протомодели в модель бизнес-логики
+func ToMemberListFilterFromReq(req *chat_v1.ListMembersRequest) (*model.MemberListFilter, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	cursor, err := DecodeMemberCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.MemberListFilter{
+		ChatID: req.ChatId,
+		Limit:  uint64(req.PageSize),
+		Cursor: cursor,
+	}, nil
+}
+
+// ToListMembersResponseFromService конвертер модели бизнес-логики в протомодель
+func ToListMembersResponseFromService(list *model.MemberList) *chat_v1.ListMembersResponse {
+	return &chat_v1.ListMembersResponse{
+		Members:    ToMembersFromService(list.Members),
+		NextCursor: EncodeMemberCursor(list.NextCursor),
+	}
+}
+
+// ToMembersFromService конвертер участников чата из модели бизнес-логики в протомодель
+func ToMembersFromService(members []*model.Member) []*chat_v1.Member {
+	res := make([]*chat_v1.Member, 0, len(members))
+	for _, member := range members {
+		res = append(res, &chat_v1.Member{
+			UserId: member.UserID,
+			Role:   ToRoleFromService(member.Role),
+		})
+	}
+
+	return res
+}
+
+// ToMessageEditFromReq конвертер протомодели в модель бизнес-логики
+func ToMessageEditFromReq(req *chat_v1.EditMessageRequest) *model.MessageEdit {
+	if req == nil {
+		return nil
+	}
+
+	return &model.MessageEdit{
+		MessageID: req.MessageId,
+		UserID:    req.UserId,
+		Text:      req.Text,
+	}
+}
+
+// ToMessageDeleteFromReq конвертер протомодели в модель бизнес-логики
+func ToMessageDeleteFromReq(req *chat_v1.DeleteMessageRequest) *model.MessageDelete {
+	if req == nil {
+		return nil
+	}
+
+	return &model.MessageDelete{
+		MessageID: req.MessageId,
+		UserID:    req.UserId,
+	}
+}
+
+// ToMessagePinFromReq конвертер протомодели в модель бизнес-логики
+func ToMessagePinFromReq(req *chat_v1.PinMessageRequest) *model.MessagePin {
+	if req == nil {
+		return nil
+	}
+
+	return &model.MessagePin{
+		MessageID: req.MessageId,
+		Pinned:    req.Pinned,
+	}
+}
+
+// ToThreadFilterFromReq конвертер протомодели в модель бизнес-логики
+func ToThreadFilterFromReq(req *chat_v1.ListThreadRequest) (*model.ThreadFilter, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	cursor, err := DecodeMessageCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ThreadFilter{
+		RootID: req.MessageId,
+		Limit:  uint64(req.PageSize),
+		Cursor: cursor,
+	}, nil
+}
+
+// ToListThreadResponseFromService конвертер модели бизнес-логики в протомодель
+func ToListThreadResponseFromService(thread *model.Thread) *chat_v1.ListThreadResponse {
+	replies := make([]*chat_v1.Message, 0, len(thread.Replies))
+	for _, reply := range thread.Replies {
+		replies = append(replies, ToMessageFromService(reply))
+	}
+
+	return &chat_v1.ListThreadResponse{
+		Root:       ToMessageFromService(thread.Root),
+		Replies:    replies,
+		NextCursor: EncodeMessageCursor(thread.NextCursor),
 	}
 }
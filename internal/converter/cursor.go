@@ -0,0 +1,132 @@
+package converter
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ipv02/chat-server/internal/apperr"
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// ErrInvalidCursor курсор не был выдан сервером или поврежден
+var ErrInvalidCursor = apperr.InvalidInput("invalid cursor", apperr.FieldViolation{
+	Field:       "cursor",
+	Description: "cursor was not issued by the server or is corrupted",
+})
+
+// EncodeMessageCursor кодирует позицию в истории сообщений в непрозрачную строку
+func EncodeMessageCursor(cursor *model.MessageCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	return encodeTimeCursor(cursor.CreatedAt, cursor.ID)
+}
+
+// DecodeMessageCursor разбирает курсор, полученный от клиента
+func DecodeMessageCursor(cursor string) (*model.MessageCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	createdAt, id, err := decodeTimeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.MessageCursor{
+		CreatedAt: createdAt,
+		ID:        id,
+	}, nil
+}
+
+// EncodeChatCursor кодирует позицию в списке чатов в непрозрачную строку
+func EncodeChatCursor(cursor *model.ChatCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	return encodeTimeCursor(cursor.LastActivityAt, cursor.ID)
+}
+
+// DecodeChatCursor разбирает курсор, полученный от клиента
+func DecodeChatCursor(cursor string) (*model.ChatCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	lastActivityAt, id, err := decodeTimeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ChatCursor{
+		LastActivityAt: lastActivityAt,
+		ID:             id,
+	}, nil
+}
+
+// encodeTimeCursor кодирует пару (время, ID) в строку вида base64url("unixnano:id")
+func encodeTimeCursor(t time.Time, id int64) string {
+	raw := strconv.FormatInt(t.UnixNano(), 10) + ":" + strconv.FormatInt(id, 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeTimeCursor разбирает строку, закодированную encodeTimeCursor
+func decodeTimeCursor(cursor string) (time.Time, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+
+	unixNano, id, found := strings.Cut(string(raw), ":")
+	if !found {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+
+	nano, err := strconv.ParseInt(unixNano, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+
+	parsedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+
+	return time.Unix(0, nano).UTC(), parsedID, nil
+}
+
+// EncodeMemberCursor кодирует позицию в списке участников в непрозрачную строку
+func EncodeMemberCursor(cursor *model.MemberCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor.UserID))
+}
+
+// DecodeMemberCursor разбирает курсор, полученный от клиента. ID пользователя в курсоре
+// сравнивается с числовой колонкой, поэтому нечисловой курсор отклоняется до запроса в БД
+func DecodeMemberCursor(cursor string) (*model.MemberCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	userID, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &model.MemberCursor{
+		UserID: strconv.FormatInt(userID, 10),
+	}, nil
+}
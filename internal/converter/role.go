@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ToRoleFromReq конвертер роли из протомодели в модель бизнес-логики
+func ToRoleFromReq(role chat_v1.Role) model.Role {
+	switch role {
+	case chat_v1.Role_ROLE_OWNER:
+		return model.RoleOwner
+	case chat_v1.Role_ROLE_ADMIN:
+		return model.RoleAdmin
+	case chat_v1.Role_ROLE_MEMBER:
+		return model.RoleMember
+	case chat_v1.Role_ROLE_READ_ONLY:
+		return model.RoleReadOnly
+	default:
+		return ""
+	}
+}
+
+// ToRoleFromService конвертер роли из модели бизнес-логики в протомодель
+func ToRoleFromService(role model.Role) chat_v1.Role {
+	switch role {
+	case model.RoleOwner:
+		return chat_v1.Role_ROLE_OWNER
+	case model.RoleAdmin:
+		return chat_v1.Role_ROLE_ADMIN
+	case model.RoleMember:
+		return chat_v1.Role_ROLE_MEMBER
+	case model.RoleReadOnly:
+		return chat_v1.Role_ROLE_READ_ONLY
+	default:
+		return chat_v1.Role_ROLE_UNSPECIFIED
+	}
+}
+
+// ToChatMemberRoleFromReq конвертер протомодели в модель бизнес-логики
+func ToChatMemberRoleFromReq(req *chat_v1.SetMemberRoleRequest) *model.ChatMemberRole {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatMemberRole{
+		ChatID:   req.ChatId,
+		UserID:   req.UserId,
+		MemberID: req.MemberId,
+		Role:     ToRoleFromReq(req.Role),
+	}
+}
+
+// ToChatOwnershipTransferFromReq конвертер протомодели в модель бизнес-логики
+func ToChatOwnershipTransferFromReq(req *chat_v1.TransferOwnershipRequest) *model.ChatOwnershipTransfer {
+	if req == nil {
+		return nil
+	}
+
+	return &model.ChatOwnershipTransfer{
+		ChatID:     req.ChatId,
+		UserID:     req.UserId,
+		NewOwnerID: req.NewOwnerId,
+	}
+}
@@ -0,0 +1,33 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpStatusOverrides коды, для которых стандартное отображение gateway не подходит API чатов.
+// FailedPrecondition сервис возвращает при конфликте с текущим состоянием чата или сообщения
+var httpStatusOverrides = map[codes.Code]int{
+	codes.FailedPrecondition: http.StatusConflict,
+}
+
+// errorHandler отвечает JSON телом google.rpc.Status с деталями ошибки, как описано в документе OpenAPI,
+// и HTTP статусом, соответствующим коду gRPC
+func errorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	if httpStatus, ok := httpStatusOverrides[status.Code(err)]; ok {
+		err = &runtime.HTTPStatusError{HTTPStatus: httpStatus, Err: err}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
@@ -0,0 +1,81 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"github.com/ipv02/chat-server/internal/interceptor"
+	"github.com/ipv02/chat-server/internal/requestid"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// forwardedHeaders заголовки HTTP запроса, которые передаются в метаданные gRPC без префикса.
+// Authorization gateway передает сам
+var forwardedHeaders = map[string]string{
+	textproto.CanonicalMIMEHeaderKey("idempotency-key"):             "idempotency-key",
+	textproto.CanonicalMIMEHeaderKey(requestid.Header):              requestid.Header,
+	textproto.CanonicalMIMEHeaderKey(interceptor.ReadPrimaryHeader): interceptor.ReadPrimaryHeader,
+	"Traceparent": "traceparent",
+	"Tracestate":  "tracestate",
+}
+
+// New создает обработчик REST API, который проксирует запросы в gRPC сервер через conn,
+// и отдает документы OpenAPI по /openapi/v2.json и /openapi/v3.json
+func New(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(errorHandler),
+	)
+
+	err := chat_v1.RegisterChatV1Handler(ctx, gwMux, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	openAPIv3, err := openAPIv3(chat_v1.OpenAPIv2)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/openapi/v2.json", jsonDocument(chat_v1.OpenAPIv2))
+	mux.Handle("/openapi/v3.json", jsonDocument(openAPIv3))
+	mux.Handle("/", gwMux)
+
+	return mux, nil
+}
+
+func incomingHeaderMatcher(key string) (string, bool) {
+	if name, ok := forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return name, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher возвращает ID запроса в привычном заголовке, остальные метаданные ответа получают префикс Grpc-Metadata-
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == requestid.Header {
+		return textproto.CanonicalMIMEHeaderKey(requestid.Header), true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+func jsonDocument(body []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+}
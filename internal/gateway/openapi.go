@@ -0,0 +1,25 @@
+package gateway
+
+import (
+	"encoding/json"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/pkg/errors"
+)
+
+// openAPIv3 конвертирует сгенерированный документ OpenAPI v2 в OpenAPI v3
+func openAPIv3(v2 []byte) ([]byte, error) {
+	var doc openapi2.T
+	err := json.Unmarshal(v2, &doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse openapi v2 document")
+	}
+
+	docV3, err := openapi2conv.ToV3(&doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert openapi document to v3")
+	}
+
+	return json.Marshal(docV3)
+}
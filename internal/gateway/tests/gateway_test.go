@@ -0,0 +1,214 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ipv02/chat-server/internal/gateway"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// fakeChatServer запоминает последний запрос и метаданные, отвечает заданной ошибкой
+type fakeChatServer struct {
+	chat_v1.UnimplementedChatV1Server
+
+	mu      sync.Mutex
+	err     error
+	md      metadata.MD
+	message *chat_v1.SendMessageRequest
+}
+
+func (s *fakeChatServer) SendMessage(ctx context.Context, req *chat_v1.SendMessageRequest) (*emptypb.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	s.message = req
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *fakeChatServer) GetChat(_ context.Context, req *chat_v1.GetChatRequest) (*chat_v1.GetChatResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &chat_v1.GetChatResponse{Chat: &chat_v1.Chat{Id: req.Id, Name: "general"}}, nil
+}
+
+func newGateway(t *testing.T, srv *fakeChatServer) http.Handler {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	chat_v1.RegisterChatV1Server(server, srv)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	handler, err := gateway.New(context.Background(), conn)
+	require.NoError(t, err)
+
+	return handler
+}
+
+func TestSendMessageForwardsMetadata(t *testing.T) {
+	t.Parallel()
+
+	srv := &fakeChatServer{}
+	handler := newGateway(t, srv)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/chats/7/messages",
+		strings.NewReader(`{"text":"hello","timestamp":"2024-11-01T10:00:00Z"}`))
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Idempotency-Key", "key-1")
+	req.Header.Set("X-Request-Id", "req-1")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, int64(7), srv.message.ChatId)
+	require.Equal(t, "hello", srv.message.Text)
+	require.Equal(t, []string{"Bearer token"}, srv.md.Get("authorization"))
+	require.Equal(t, []string{"key-1"}, srv.md.Get("idempotency-key"))
+	require.Equal(t, []string{"req-1"}, srv.md.Get("x-request-id"))
+}
+
+func TestErrorMapping(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		httpStatus int
+	}{
+		{
+			name:       "not found case",
+			err:        status.Error(codes.NotFound, "chat not found"),
+			httpStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unauthenticated case",
+			err:        status.Error(codes.Unauthenticated, "authentication required"),
+			httpStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "permission denied case",
+			err:        status.Error(codes.PermissionDenied, "access denied"),
+			httpStatus: http.StatusForbidden,
+		},
+		{
+			name:       "conflict with chat state case",
+			err:        status.Error(codes.FailedPrecondition, "message edit window has expired"),
+			httpStatus: http.StatusConflict,
+		},
+		{
+			name:       "invalid argument case",
+			err:        status.Error(codes.InvalidArgument, "validation error"),
+			httpStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := newGateway(t, &fakeChatServer{err: tt.err})
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/chats/3", nil))
+
+			require.Equal(t, tt.httpStatus, rec.Code)
+			require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var body struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, int(status.Code(tt.err)), body.Code)
+			require.Equal(t, status.Convert(tt.err).Message(), body.Message)
+		})
+	}
+}
+
+func TestGetChat(t *testing.T) {
+	t.Parallel()
+
+	handler := newGateway(t, &fakeChatServer{})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/chats/3", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"chat":{
+		"id":"3",
+		"name":"general",
+		"members":[],
+		"createdAt":null,
+		"updatedAt":null,
+		"lastMessageAt":null,
+		"lastMessage":null,
+		"unreadCount":"0"
+	}}`, rec.Body.String())
+}
+
+func TestOpenAPIDocuments(t *testing.T) {
+	t.Parallel()
+
+	handler := newGateway(t, &fakeChatServer{})
+
+	tests := []struct {
+		path    string
+		version string
+		field   string
+	}{
+		{path: "/openapi/v2.json", field: "swagger", version: "2.0"},
+		{path: "/openapi/v3.json", field: "openapi", version: "3.0.3"},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		require.Equal(t, http.StatusOK, rec.Code, tt.path)
+
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc), tt.path)
+		require.Equal(t, tt.version, doc[tt.field], tt.path)
+		require.Contains(t, doc["paths"], "/v1/chats/{chatId}/messages", tt.path)
+	}
+}
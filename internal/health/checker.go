@@ -0,0 +1,145 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+)
+
+// Checker периодически проверяет доступность БД и по результату переключает статус
+// стандартного gRPC health сервиса и готовность для /readyz
+type Checker struct {
+	pinger   db.Pinger
+	server   *health.Server
+	interval time.Duration
+	timeout  time.Duration
+	// services имена gRPC сервисов, статус которых зависит от БД, пустое имя - общий статус сервера
+	services []string
+
+	mu       sync.Mutex
+	ready    bool
+	shutdown bool
+}
+
+// NewChecker создает проверку, до первого успешного пинга все сервисы в статусе NOT_SERVING
+func NewChecker(pinger db.Pinger, interval, timeout time.Duration, services ...string) *Checker {
+	c := &Checker{
+		pinger:   pinger,
+		server:   health.NewServer(),
+		interval: interval,
+		timeout:  timeout,
+		services: append([]string{""}, services...),
+	}
+
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return c
+}
+
+// Server возвращает реализацию grpc.health.v1 для регистрации в gRPC сервере
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+// Run проверяет БД с заданным интервалом, пока не будет отменен контекст
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check однократно проверяет БД и обновляет статус
+func (c *Checker) Check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	err := c.pinger.Ping(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.shutdown {
+		return
+	}
+
+	ready := err == nil
+	if ready != c.ready {
+		if ready {
+			slog.InfoContext(ctx, "health: database is reachable, serving")
+		} else {
+			slog.WarnContext(ctx, "health: database is unreachable, not serving", logger.Err(err))
+		}
+	}
+
+	c.ready = ready
+
+	if ready {
+		c.setStatus(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// Shutdown переводит все сервисы в NOT_SERVING до конца работы процесса,
+// чтобы балансировщик перестал присылать новые запросы до остановки сервера
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shutdown = true
+	c.ready = false
+	c.server.Shutdown()
+}
+
+// Ready сообщает, готов ли сервер принимать запросы
+func (c *Checker) Ready() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ready
+}
+
+// LivenessHandler отвечает 200, пока процесс способен обрабатывать HTTP запросы
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+// ReadinessHandler отвечает 200, если БД доступна и сервер не останавливается, иначе 503
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !c.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("not ready"))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ready"))
+	})
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
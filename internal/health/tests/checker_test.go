@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/health"
+)
+
+const chatService = "chat_v1.ChatV1"
+
+func servingStatus(t *testing.T, checker *health.Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	res, err := checker.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+
+	return res.Status
+}
+
+func readyzCode(checker *health.Checker) int {
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	return rec.Code
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		pingErr = errors.New("connection refused")
+	)
+
+	tests := []struct {
+		name       string
+		pingErr    error
+		status     healthpb.HealthCheckResponse_ServingStatus
+		readyzCode int
+	}{
+		{
+			name:       "database reachable case",
+			status:     healthpb.HealthCheckResponse_SERVING,
+			readyzCode: http.StatusOK,
+		},
+		{
+			name:       "database unreachable case",
+			pingErr:    pingErr,
+			status:     healthpb.HealthCheckResponse_NOT_SERVING,
+			readyzCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pinger := dbMocks.NewPingerMock(mc)
+			pinger.PingMock.Return(tt.pingErr)
+
+			checker := health.NewChecker(pinger, time.Second, time.Second, chatService)
+			checker.Check(ctx)
+
+			require.Equal(t, tt.status, servingStatus(t, checker, ""))
+			require.Equal(t, tt.status, servingStatus(t, checker, chatService))
+			require.Equal(t, tt.readyzCode, readyzCode(checker))
+		})
+	}
+}
+
+func TestCheckerNotServingBeforeFirstCheck(t *testing.T) {
+	t.Parallel()
+
+	checker := health.NewChecker(dbMocks.NewPingerMock(t), time.Second, time.Second, chatService)
+
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, chatService))
+	require.Equal(t, http.StatusServiceUnavailable, readyzCode(checker))
+}
+
+func TestCheckerRecovers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pinger := dbMocks.NewPingerMock(t)
+	pinger.PingMock.Return(errors.New("connection refused"))
+
+	checker := health.NewChecker(pinger, time.Second, time.Second, chatService)
+	checker.Check(ctx)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, chatService))
+
+	pinger.PingMock.Return(nil)
+	checker.Check(ctx)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, checker, chatService))
+}
+
+func TestCheckerShutdown(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pinger := dbMocks.NewPingerMock(t)
+	pinger.PingMock.Return(nil)
+
+	checker := health.NewChecker(pinger, time.Second, time.Second, chatService)
+	checker.Check(ctx)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, checker, ""))
+
+	checker.Shutdown()
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, ""))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, chatService))
+	require.Equal(t, http.StatusServiceUnavailable, readyzCode(checker))
+
+	// Успешная проверка после начала остановки не возвращает сервер в SERVING
+	checker.Check(ctx)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, ""))
+	require.Equal(t, http.StatusServiceUnavailable, readyzCode(checker))
+}
+
+func TestLivenessHandler(t *testing.T) {
+	t.Parallel()
+
+	checker := health.NewChecker(dbMocks.NewPingerMock(t), time.Second, time.Second)
+
+	rec := httptest.NewRecorder()
+	checker.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
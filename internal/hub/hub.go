@@ -0,0 +1,187 @@
+package hub
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+const (
+	// DefaultBufferSize размер буфера подписки по умолчанию
+	DefaultBufferSize = 100
+
+	// recentMessagesSize сколько последних ID сообщений хаб помнит для отсева дублей
+	recentMessagesSize = 4096
+)
+
+// ErrSlowSubscriber подписчик не успевал вычитывать сообщения и был отключен
+var ErrSlowSubscriber = errors.New("subscriber buffer overflow")
+
+// ErrClosed хаб закрыт при остановке сервера
+var ErrClosed = errors.New("hub is closed")
+
+// Hub рассылает новые сообщения подписчикам чатов внутри процесса
+type Hub struct {
+	mu          sync.Mutex
+	bufferSize  int
+	subscribers map[int64]map[*Subscription]struct{}
+	closed      bool
+
+	// Одно и то же сообщение приходит и от локального отправителя, и через LISTEN/NOTIFY
+	recent      map[int64]struct{}
+	recentOrder []int64
+	recentNext  int
+}
+
+// Subscription подписка на новые сообщения одного чата
+type Subscription struct {
+	hub    *Hub
+	chatID int64
+	ch     chan *model.Message
+	err    error
+}
+
+// New создает хаб, в котором у каждой подписки буфер на bufferSize сообщений
+func New(bufferSize int) *Hub {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	return &Hub{
+		bufferSize:  bufferSize,
+		subscribers: make(map[int64]map[*Subscription]struct{}),
+		recent:      make(map[int64]struct{}, recentMessagesSize),
+		recentOrder: make([]int64, 0, recentMessagesSize),
+	}
+}
+
+// Subscribe подписывает на новые сообщения чата.
+// После закрытия хаба возвращает уже отключенную подписку с ошибкой ErrClosed.
+func (h *Hub) Subscribe(chatID int64) *Subscription {
+	sub := &Subscription{
+		hub:    h,
+		chatID: chatID,
+		ch:     make(chan *model.Message, h.bufferSize),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		sub.err = ErrClosed
+		close(sub.ch)
+
+		return sub
+	}
+
+	subs, ok := h.subscribers[chatID]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		h.subscribers[chatID] = subs
+	}
+	subs[sub] = struct{}{}
+
+	return sub
+}
+
+// Publish рассылает сообщение подписчикам его чата, не блокируя отправителя.
+// Подписчики с переполненным буфером отключаются с ошибкой ErrSlowSubscriber.
+// Повторная публикация сообщения с тем же ID игнорируется.
+func (h *Hub) Publish(msg *model.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.markSeen(msg.ID) {
+		return
+	}
+
+	for sub := range h.subscribers[msg.ChatID] {
+		select {
+		case sub.ch <- msg:
+		default:
+			h.remove(sub, ErrSlowSubscriber)
+		}
+	}
+}
+
+// Close отключает все подписки с ошибкой ErrClosed, чтобы открытые потоки завершились при остановке сервера
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			h.remove(sub, ErrClosed)
+		}
+	}
+}
+
+// SubscribersCount возвращает количество подписчиков чата
+func (h *Hub) SubscribersCount(chatID int64) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.subscribers[chatID])
+}
+
+// markSeen запоминает ID сообщения и возвращает false, если оно уже публиковалось, вызывается под h.mu
+func (h *Hub) markSeen(id int64) bool {
+	if _, ok := h.recent[id]; ok {
+		return false
+	}
+
+	if len(h.recentOrder) < recentMessagesSize {
+		h.recentOrder = append(h.recentOrder, id)
+	} else {
+		delete(h.recent, h.recentOrder[h.recentNext])
+		h.recentOrder[h.recentNext] = id
+		h.recentNext = (h.recentNext + 1) % recentMessagesSize
+	}
+	h.recent[id] = struct{}{}
+
+	return true
+}
+
+// remove удаляет подписку и закрывает ее канал, вызывается под h.mu
+func (h *Hub) remove(sub *Subscription, err error) {
+	subs, ok := h.subscribers[sub.chatID]
+	if !ok {
+		return
+	}
+
+	if _, ok = subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.chatID)
+	}
+
+	sub.err = err
+	close(sub.ch)
+}
+
+// Messages канал новых сообщений, закрывается при отключении подписки
+func (s *Subscription) Messages() <-chan *model.Message {
+	return s.ch
+}
+
+// Err причина отключения подписки, nil если подписка закрыта через Close
+func (s *Subscription) Err() error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	return s.err
+}
+
+// Close отписывает от сообщений чата, повторный вызов ничего не делает
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.remove(s, nil)
+}
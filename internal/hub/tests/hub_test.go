@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/hub"
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// dedupWindow сколько последних ID сообщений хаб помнит для отсева дублей
+const dedupWindow = 4096
+
+func message(id, chatID int64) *model.Message {
+	return &model.Message{ID: id, ChatID: chatID, Text: "hello"}
+}
+
+// drain возвращает ID сообщений, уже лежащих в буфере подписки
+func drain(sub *hub.Subscription) []int64 {
+	var ids []int64
+	for {
+		select {
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				return ids
+			}
+			ids = append(ids, msg.ID)
+		default:
+			return ids
+		}
+	}
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(10)
+	first := h.Subscribe(1)
+	second := h.Subscribe(1)
+	other := h.Subscribe(2)
+
+	h.Publish(message(1, 1))
+	h.Publish(message(2, 2))
+
+	require.Equal(t, []int64{1}, drain(first))
+	require.Equal(t, []int64{1}, drain(second))
+	require.Equal(t, []int64{2}, drain(other))
+	require.Equal(t, 2, h.SubscribersCount(1))
+}
+
+func TestSubscriptionClose(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(10)
+	sub := h.Subscribe(1)
+
+	sub.Close()
+	sub.Close()
+
+	h.Publish(message(1, 1))
+
+	_, ok := <-sub.Messages()
+	require.False(t, ok)
+	require.NoError(t, sub.Err())
+	require.Zero(t, h.SubscribersCount(1))
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(2)
+	slow := h.Subscribe(1)
+	fast := h.Subscribe(1)
+
+	for id := int64(1); id <= 3; id++ {
+		h.Publish(message(id, 1))
+		if id < 3 {
+			require.Equal(t, []int64{id}, drain(fast))
+		}
+	}
+
+	// Подписчик с переполненным буфером отключается, остальные продолжают получать сообщения
+	require.Equal(t, []int64{1, 2}, drain(slow))
+	require.ErrorIs(t, slow.Err(), hub.ErrSlowSubscriber)
+	require.Equal(t, []int64{3}, drain(fast))
+	require.NoError(t, fast.Err())
+	require.Equal(t, 1, h.SubscribersCount(1))
+}
+
+func TestClose(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(10)
+	sub := h.Subscribe(1)
+
+	h.Close()
+
+	_, ok := <-sub.Messages()
+	require.False(t, ok)
+	require.ErrorIs(t, sub.Err(), hub.ErrClosed)
+
+	// Подписка после закрытия хаба сразу отключена
+	late := h.Subscribe(1)
+	_, ok = <-late.Messages()
+	require.False(t, ok)
+	require.ErrorIs(t, late.Err(), hub.ErrClosed)
+	require.Zero(t, h.SubscribersCount(1))
+}
+
+func TestPublishDeduplicates(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(10)
+	sub := h.Subscribe(1)
+
+	// Сообщение публикует локальный отправитель, а затем оно же приходит через LISTEN/NOTIFY
+	h.Publish(message(1, 1))
+	h.Publish(message(1, 1))
+	h.Publish(message(2, 1))
+
+	require.Equal(t, []int64{1, 2}, drain(sub))
+}
+
+func TestPublishDedupWindowEviction(t *testing.T) {
+	t.Parallel()
+
+	h := hub.New(10)
+
+	// Заполняем окно без подписчиков, первое сообщение вытесняется последним
+	for id := int64(1); id <= dedupWindow+1; id++ {
+		h.Publish(message(id, 1))
+	}
+
+	sub := h.Subscribe(1)
+
+	h.Publish(message(2, 1))
+	h.Publish(message(dedupWindow+1, 1))
+	require.Empty(t, drain(sub))
+
+	h.Publish(message(1, 1))
+	require.Equal(t, []int64{1}, drain(sub))
+}
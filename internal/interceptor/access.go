@@ -0,0 +1,58 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/ipv02/chat-server/internal/client/rpc"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// chatMethodPrefix доступ проверяется только для методов чата, служебные сервисы не затрагиваются
+var chatMethodPrefix = "/" + chat_v1.ChatV1_ServiceDesc.ServiceName + "/"
+
+// AccessInterceptor проверяет во внешнем сервисе авторизации доступ к методам чата
+type AccessInterceptor struct {
+	accessClient rpc.AccessClient
+}
+
+// NewAccessInterceptor создает интерсептор проверки доступа
+func NewAccessInterceptor(accessClient rpc.AccessClient) *AccessInterceptor {
+	return &AccessInterceptor{accessClient: accessClient}
+}
+
+// Unary проверяет доступ к унарным методам
+func (i *AccessInterceptor) Unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, chatMethodPrefix) {
+		err := i.accessClient.Check(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// Stream проверяет доступ к потоковым методам
+func (i *AccessInterceptor) Stream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if strings.HasPrefix(info.FullMethod, chatMethodPrefix) {
+		err := i.accessClient.Check(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+	}
+
+	return handler(srv, ss)
+}
@@ -0,0 +1,56 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// AccessLogUnaryInterceptor логирует метод, код ответа и время обработки запроса
+func AccessLogUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	res, err := handler(ctx, req)
+	logAccess(ctx, start, err)
+
+	return res, err
+}
+
+// AccessLogStreamInterceptor логирует метод, код завершения и длительность потока
+func AccessLogStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+
+	err := handler(srv, ss)
+	logAccess(ss.Context(), start, err)
+
+	return err
+}
+
+// logAccess пишет запись о запросе, метод и ID запроса добавляет обработчик логов из контекста
+func logAccess(ctx context.Context, start time.Time, err error) {
+	st := status.Convert(err)
+
+	attrs := []slog.Attr{
+		slog.String("code", st.Code().String()),
+		slog.Duration("latency", time.Since(start)),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", st.Message()))
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "grpc request", attrs...)
+}
@@ -0,0 +1,106 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ipv02/chat-server/internal/apperr"
+	"github.com/ipv02/chat-server/internal/auth"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+// AuthInterceptor проверяет токен доступа из метаданных и передает ID пользователя в контекст обработчика
+type AuthInterceptor struct {
+	verifier *auth.Verifier
+	// publicMethods префиксы методов, доступных без токена, например "/grpc.health.v1.Health/"
+	publicMethods []string
+}
+
+// NewAuthInterceptor создает интерсептор аутентификации
+func NewAuthInterceptor(verifier *auth.Verifier, publicMethods []string) *AuthInterceptor {
+	return &AuthInterceptor{
+		verifier:      verifier,
+		publicMethods: publicMethods,
+	}
+}
+
+// Unary аутентифицирует унарные запросы
+func (i *AuthInterceptor) Unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if i.isPublic(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	ctx, err := i.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// Stream аутентифицирует потоковые запросы
+func (i *AuthInterceptor) Stream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if i.isPublic(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	ctx, err := i.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &wrappedStream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+}
+
+func (i *AuthInterceptor) isPublic(method string) bool {
+	for _, prefix := range i.publicMethods {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, apperr.Unauthenticated("authorization metadata is required")
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return nil, apperr.Unauthenticated("authorization metadata is required")
+	}
+
+	if len(values[0]) <= len(bearerPrefix) || !strings.EqualFold(values[0][:len(bearerPrefix)], bearerPrefix) {
+		return nil, apperr.Unauthenticated("bearer token is required")
+	}
+
+	userID, err := i.verifier.Verify(values[0][len(bearerPrefix):])
+	if err != nil {
+		return nil, apperr.Wrap(err, apperr.KindUnauthenticated, "invalid token")
+	}
+
+	return auth.NewContext(ctx, userID), nil
+}
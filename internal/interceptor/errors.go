@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/ipv02/chat-server/internal/apperr"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+// ErrorsUnaryInterceptor отображает ошибки обработчиков в статусы gRPC, не раскрывая клиенту внутренние ошибки
+func ErrorsUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	res, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return res, nil
+}
+
+// ErrorsStreamInterceptor отображает ошибки потоковых обработчиков в статусы gRPC
+func ErrorsStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	err := handler(srv, ss)
+	if err != nil {
+		return toStatusError(ss.Context(), err)
+	}
+
+	return nil
+}
+
+// toStatusError отображает ошибку в статус. Подробности внутренних ошибок клиент не получает,
+// поэтому они логируются здесь.
+func toStatusError(ctx context.Context, err error) error {
+	var validationErr *chat_v1.ValidationError
+	if errors.As(err, &validationErr) {
+		err = apperr.InvalidInput("invalid request", apperr.FieldViolation{
+			Field:       validationErr.Field,
+			Description: validationErr.Description,
+		})
+	}
+
+	st := apperr.ToStatus(err)
+	if st.Code() == codes.Internal {
+		slog.ErrorContext(ctx, "internal error", logger.Err(err))
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,41 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/chat-server/internal/metric"
+)
+
+// MetricsUnaryInterceptor учитывает в метриках метод, код ответа и время обработки запроса
+func MetricsUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	res, err := handler(ctx, req)
+	metric.ObserveRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+	return res, err
+}
+
+// MetricsStreamInterceptor учитывает в метриках метод, код завершения и длительность потока
+func MetricsStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+
+	err := handler(srv, ss)
+	metric.ObserveRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+	return err
+}
@@ -0,0 +1,62 @@
+package interceptor
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+)
+
+// ReadPrimaryHeader метаданные, значение true в которых заставляет запрос читать из основной БД, а не из реплики.
+// Клиент передает их, чтобы увидеть свою запись сразу, например, историю чата после отправки сообщения
+const ReadPrimaryHeader = "x-read-primary"
+
+// ReadPrimaryUnaryInterceptor помечает контекст обработчика db.WithPrimary, если клиент запросил чтение из основной БД
+func ReadPrimaryUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if readPrimary(ctx) {
+		ctx = db.WithPrimary(ctx)
+	}
+
+	return handler(ctx, req)
+}
+
+// ReadPrimaryStreamInterceptor помечает контекст потокового обработчика db.WithPrimary
+func ReadPrimaryStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if !readPrimary(ss.Context()) {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &wrappedStream{
+		ServerStream: ss,
+		ctx:          db.WithPrimary(ss.Context()),
+	})
+}
+
+func readPrimary(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	values := md.Get(ReadPrimaryHeader)
+	if len(values) == 0 {
+		return false
+	}
+
+	enabled, err := strconv.ParseBool(values[0])
+
+	return err == nil && enabled
+}
@@ -0,0 +1,49 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryUnaryInterceptor перехватывает панику в обработчике и возвращает codes.Internal вместо падения сервера
+func RecoveryUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (res interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(ctx, r)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// RecoveryStreamInterceptor перехватывает панику в потоковом обработчике
+func RecoveryStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(ss.Context(), r)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+func recoveredError(ctx context.Context, r interface{}) error {
+	slog.ErrorContext(ctx, "panic recovered", slog.Any("panic", r), slog.String("stack", string(debug.Stack())))
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,51 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ipv02/chat-server/internal/requestid"
+)
+
+// RequestIDUnaryInterceptor берет ID запроса из входящих метаданных или генерирует новый,
+// кладет его в контекст обработчика и возвращает клиенту в заголовке ответа
+func RequestIDUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	id := incomingRequestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.Header, id))
+
+	return handler(requestid.NewContext(ctx, id), req)
+}
+
+// RequestIDStreamInterceptor передает ID запроса в контекст потокового обработчика
+func RequestIDStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	id := incomingRequestID(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(requestid.Header, id))
+
+	return handler(srv, &wrappedStream{
+		ServerStream: ss,
+		ctx:          requestid.NewContext(ss.Context(), id),
+	})
+}
+
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get(requestid.Header); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	return requestid.New()
+}
@@ -0,0 +1,18 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// wrappedStream поток с подмененным контекстом, чтобы передать значения из интерсептора в обработчик
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает контекст потока
+func (s *wrappedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ipv02/chat-server/internal/apperr"
+	"github.com/ipv02/chat-server/internal/client/rpc"
+	rpcMocks "github.com/ipv02/chat-server/internal/client/rpc/mocks"
+	"github.com/ipv02/chat-server/internal/interceptor"
+)
+
+func TestAccessUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+	type accessClientMockFunc func(mc *minimock.Controller) rpc.AccessClient
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		deniedErr = apperr.PermissionDenied("access denied")
+	)
+
+	tests := []struct {
+		name             string
+		method           string
+		called           bool
+		err              error
+		accessClientMock accessClientMockFunc
+	}{
+		{
+			name:   "allowed case",
+			method: unaryInfo.FullMethod,
+			called: true,
+			accessClientMock: func(mc *minimock.Controller) rpc.AccessClient {
+				mock := rpcMocks.NewAccessClientMock(mc)
+				mock.CheckMock.Expect(ctx, unaryInfo.FullMethod).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "denied case",
+			method: unaryInfo.FullMethod,
+			err:    deniedErr,
+			accessClientMock: func(mc *minimock.Controller) rpc.AccessClient {
+				mock := rpcMocks.NewAccessClientMock(mc)
+				mock.CheckMock.Expect(ctx, unaryInfo.FullMethod).Return(deniedErr)
+				return mock
+			},
+		},
+		{
+			name:   "not a chat method case",
+			method: "/grpc.health.v1.Health/Check",
+			called: true,
+			accessClientMock: func(mc *minimock.Controller) rpc.AccessClient {
+				return rpcMocks.NewAccessClientMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accessInterceptor := interceptor.NewAccessInterceptor(tt.accessClientMock(mc))
+
+			var called bool
+			_, err := accessInterceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(_ context.Context, _ interface{}) (interface{}, error) {
+					called = true
+					return nil, nil
+				})
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.called, called)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ipv02/chat-server/internal/apperr"
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/interceptor"
+)
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "42"
+		secret = []byte("secret")
+	)
+
+	verifier, err := auth.NewVerifier([]auth.Key{
+		{ID: "hs", Algorithm: auth.AlgorithmHS256, Secret: secret},
+	}, "", "")
+	require.NoError(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   userID,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).SignedString(secret)
+	require.NoError(t, err)
+
+	authInterceptor := interceptor.NewAuthInterceptor(verifier, []string{"/grpc.health.v1.Health/"})
+
+	withAuthorization := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", value))
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   string
+		err    bool
+	}{
+		{
+			name:   "valid token case",
+			ctx:    withAuthorization("Bearer " + token),
+			method: unaryInfo.FullMethod,
+			want:   userID,
+		},
+		{
+			name:   "public method without token case",
+			ctx:    context.Background(),
+			method: "/grpc.health.v1.Health/Check",
+		},
+		{
+			name:   "missing token case",
+			ctx:    context.Background(),
+			method: unaryInfo.FullMethod,
+			err:    true,
+		},
+		{
+			name:   "not a bearer token case",
+			ctx:    withAuthorization("Basic " + token),
+			method: unaryInfo.FullMethod,
+			err:    true,
+		},
+		{
+			name:   "invalid token case",
+			ctx:    withAuthorization("Bearer " + token + "x"),
+			method: unaryInfo.FullMethod,
+			err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				called bool
+				got    string
+			)
+			_, errAuth := authInterceptor.Unary(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					called = true
+					got, _ = auth.UserIDFromContext(ctx)
+					return nil, nil
+				})
+
+			if tt.err {
+				var appErr *apperr.Error
+				require.ErrorAs(t, errAuth, &appErr)
+				require.Equal(t, apperr.KindUnauthenticated, appErr.Kind)
+				require.False(t, called)
+				return
+			}
+
+			require.NoError(t, errAuth)
+			require.True(t, called)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/interceptor"
+	"github.com/ipv02/chat-server/internal/requestid"
+	"github.com/ipv02/chat-server/pkg/chat_v1"
+)
+
+var unaryInfo = &grpc.UnaryServerInfo{FullMethod: "/chat_v1.ChatV1/GetChat"}
+
+func TestRecoveryUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	res, err := interceptor.RecoveryUnaryInterceptor(context.Background(), nil, unaryInfo,
+		func(_ context.Context, _ interface{}) (interface{}, error) {
+			panic("boom")
+		})
+
+	require.Nil(t, res)
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestRequestIDUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "incoming request id case",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestid.Header, "req-1")),
+			want: "req-1",
+		},
+		{
+			name: "generated request id case",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			_, err := interceptor.RequestIDUnaryInterceptor(tt.ctx, nil, unaryInfo,
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					got = requestid.FromContext(ctx)
+					return nil, nil
+				})
+
+			require.NoError(t, err)
+			require.NotEmpty(t, got)
+			if tt.want != "" {
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReadPrimaryUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want bool
+	}{
+		{
+			name: "read primary requested case",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptor.ReadPrimaryHeader, "true")),
+			want: true,
+		},
+		{
+			name: "invalid value case",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptor.ReadPrimaryHeader, "yes")),
+		},
+		{
+			name: "no metadata case",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got bool
+			_, err := interceptor.ReadPrimaryUnaryInterceptor(tt.ctx, nil, unaryInfo,
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					got = db.PrimaryRequired(ctx)
+					return nil, nil
+				})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		req    interface{}
+		called bool
+		code   codes.Code
+	}{
+		{
+			name:   "valid request case",
+			req:    &chat_v1.GetChatRequest{Id: 1},
+			called: true,
+			code:   codes.OK,
+		},
+		{
+			name:   "invalid request case",
+			req:    &chat_v1.GetChatRequest{},
+			called: false,
+			code:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			// Ошибка валидации отображается в статус интерсептором ошибок, стоящим выше по цепочке
+			_, err := interceptor.ErrorsUnaryInterceptor(context.Background(), tt.req, unaryInfo,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return interceptor.ValidateUnaryInterceptor(ctx, req, unaryInfo, handler)
+				})
+
+			require.Equal(t, tt.called, called)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
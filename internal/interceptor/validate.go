@@ -0,0 +1,53 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/ipv02/chat-server/pkg"
+)
+
+// ValidateUnaryInterceptor валидирует запросы, реализующие pkg.Validator, до вызова обработчика
+func ValidateUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if v, ok := req.(pkg.Validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// ValidateStreamInterceptor валидирует каждое сообщение, полученное из потока
+func ValidateStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+// validatingStream поток, валидирующий входящие сообщения
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg получает сообщение из потока и валидирует его
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if v, ok := m.(pkg.Validator); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
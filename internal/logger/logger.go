@@ -0,0 +1,133 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/requestid"
+)
+
+const (
+	// FormatJSON вывод в JSON, по одному объекту на строку
+	FormatJSON = "json"
+	// FormatText вывод в формате key=value
+	FormatText = "text"
+)
+
+// Options настройки логгера
+type Options struct {
+	// Level минимальный уровень записей
+	Level slog.Level
+	// Format json или text
+	Format string
+	// Output куда писать логи, по умолчанию os.Stderr
+	Output io.Writer
+}
+
+// New создает логгер, который дополняет записи атрибутами запроса из контекста
+func New(opts Options) (*slog.Logger, error) {
+	out := opts.Output
+	if out == nil {
+		out = os.Stderr
+	}
+
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+
+	var handler slog.Handler
+	switch opts.Format {
+	case FormatJSON, "":
+		handler = slog.NewJSONHandler(out, handlerOpts)
+	case FormatText:
+		handler = slog.NewTextHandler(out, handlerOpts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", opts.Format)
+	}
+
+	return slog.New(&contextHandler{Handler: handler}), nil
+}
+
+// Init создает логгер и делает его логгером по умолчанию,
+// записи стандартного пакета log тоже проходят через него с уровнем INFO
+func Init(opts Options) error {
+	logger, err := New(opts)
+	if err != nil {
+		return err
+	}
+
+	slog.SetDefault(logger)
+
+	return nil
+}
+
+// ParseLevel разбирает уровень логирования: debug, info, warn или error
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+
+	err := level.UnmarshalText([]byte(strings.TrimSpace(s)))
+	if err != nil {
+		return 0, err
+	}
+
+	return level, nil
+}
+
+// Fatal пишет запись с уровнем ERROR и завершает процесс
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// Err атрибут с ошибкой под единым ключом
+func Err(err error) slog.Attr {
+	return slog.Any("error", err)
+}
+
+// contextHandler добавляет к записи ID запроса, gRPC метод, пользователя и трассу из контекста,
+// поэтому их не нужно передавать в каждом вызове
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if id := requestid.FromContext(ctx); id != "" {
+			r.AddAttrs(slog.String("request_id", id))
+		}
+
+		if method, ok := grpc.Method(ctx); ok {
+			r.AddAttrs(slog.String("method", method))
+		}
+
+		if userID, ok := auth.UserIDFromContext(ctx); ok {
+			r.AddAttrs(slog.String("user_id", userID))
+		}
+
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+		}
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// StdLogger возвращает логгер стандартного пакета log для библиотек, которые принимают только его
+func StdLogger(level slog.Level) *log.Logger {
+	return slog.NewLogLogger(slog.Default().Handler(), level)
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/requestid"
+)
+
+func decode(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	t.Helper()
+
+	record := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	return record
+}
+
+func TestContextAttributes(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	log, err := logger.New(logger.Options{Level: slog.LevelInfo, Format: logger.FormatJSON, Output: &buf})
+	require.NoError(t, err)
+
+	ctx := requestid.NewContext(context.Background(), "req-1")
+	ctx = auth.NewContext(ctx, "user-1")
+
+	log.With(slog.String("component", "test")).InfoContext(ctx, "sent message",
+		slog.Int64("chat_id", 42), logger.Err(errors.New("boom")))
+
+	record := decode(t, &buf)
+	require.Equal(t, "INFO", record["level"])
+	require.Equal(t, "sent message", record["msg"])
+	require.Equal(t, "req-1", record["request_id"])
+	require.Equal(t, "user-1", record["user_id"])
+	require.Equal(t, "test", record["component"])
+	require.Equal(t, float64(42), record["chat_id"])
+	require.Equal(t, "boom", record["error"])
+	require.NotContains(t, record, "method")
+}
+
+func TestWithoutContextAttributes(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	log, err := logger.New(logger.Options{Format: logger.FormatJSON, Output: &buf})
+	require.NoError(t, err)
+
+	log.Info("started")
+
+	record := decode(t, &buf)
+	require.NotContains(t, record, "request_id")
+	require.NotContains(t, record, "user_id")
+}
+
+func TestLevel(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	log, err := logger.New(logger.Options{Level: slog.LevelWarn, Format: logger.FormatText, Output: &buf})
+	require.NoError(t, err)
+
+	log.Info("skipped")
+	require.Zero(t, buf.Len())
+
+	log.Warn("written", slog.String("key", "value"))
+	require.Contains(t, buf.String(), "level=WARN")
+	require.Contains(t, buf.String(), "key=value")
+}
+
+func TestUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := logger.New(logger.Options{Format: "xml"})
+	require.Error(t, err)
+}
+
+func TestParseLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw   string
+		level slog.Level
+		err   bool
+	}{
+		{raw: "debug", level: slog.LevelDebug},
+		{raw: "INFO", level: slog.LevelInfo},
+		{raw: " warn ", level: slog.LevelWarn},
+		{raw: "error", level: slog.LevelError},
+		{raw: "verbose", err: true},
+	}
+
+	for _, tt := range tests {
+		level, err := logger.ParseLevel(tt.raw)
+		if tt.err {
+			require.Error(t, err, tt.raw)
+			continue
+		}
+
+		require.NoError(t, err, tt.raw)
+		require.Equal(t, tt.level, level, tt.raw)
+	}
+}
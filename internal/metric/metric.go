@@ -0,0 +1,148 @@
+package metric
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "chat_server"
+
+// registry отдельный реестр вместо глобального, чтобы в /metrics попадали только метрики сервиса и рантайма
+var registry = newRegistry()
+
+var (
+	grpcRequests = promauto.With(registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Количество обработанных gRPC запросов по методам и кодам ответа.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = promauto.With(registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Время обработки gRPC запросов по методам и кодам ответа.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	dbQueryDuration = promauto.With(registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Время выполнения запросов к БД по именам запросов.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"query"})
+
+	dbQueryErrors = promauto.With(registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_errors_total",
+		Help:      "Количество запросов к БД, завершившихся ошибкой, по именам запросов.",
+	}, []string{"query"})
+
+	dbTxRetries = promauto.With(registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "transaction_retries_total",
+		Help:      "Количество повторов транзакций по кодам SQLSTATE ошибок, из-за которых они откатились.",
+	}, []string{"sqlstate"})
+
+	dbReplicaFallbacks = promauto.With(registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "replica_fallbacks_total",
+		Help:      "Количество запросов на чтение, повторенных в основной БД из-за отказа реплики.",
+	})
+
+	messagesSent = promauto.With(registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chat",
+		Name:      "messages_sent_total",
+		Help:      "Количество отправленных сообщений.",
+	})
+
+	chatsCreated = promauto.With(registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chat",
+		Name:      "chats_created_total",
+		Help:      "Количество созданных чатов.",
+	})
+
+	activeStreams = promauto.With(registry).NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "chat",
+		Name:      "active_streams",
+		Help:      "Количество открытых подписок на сообщения чатов.",
+	})
+)
+
+func newRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return reg
+}
+
+// Handler возвращает обработчик HTTP, отдающий метрики в формате Prometheus
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Register добавляет в реестр коллектор, например статистику пула соединений
+func Register(collector prometheus.Collector) error {
+	return registry.Register(collector)
+}
+
+// ObserveRequest учитывает обработанный gRPC запрос
+func ObserveRequest(method string, code string, duration time.Duration) {
+	grpcRequests.WithLabelValues(method, code).Inc()
+	grpcRequestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+// ObserveQuery учитывает выполненный запрос к БД, query - имя запроса из db.Query
+func ObserveQuery(query string, duration time.Duration, failed bool) {
+	dbQueryDuration.WithLabelValues(query).Observe(duration.Seconds())
+
+	if failed {
+		dbQueryErrors.WithLabelValues(query).Inc()
+	}
+}
+
+// IncTxRetries учитывает повтор транзакции после ошибки с кодом sqlstate
+func IncTxRetries(sqlstate string) {
+	dbTxRetries.WithLabelValues(sqlstate).Inc()
+}
+
+// IncReplicaFallbacks учитывает запрос, повторенный в основной БД после отказа реплики
+func IncReplicaFallbacks() {
+	dbReplicaFallbacks.Inc()
+}
+
+// IncMessagesSent учитывает отправленное сообщение
+func IncMessagesSent() {
+	messagesSent.Inc()
+}
+
+// IncChatsCreated учитывает созданный чат
+func IncChatsCreated() {
+	chatsCreated.Inc()
+}
+
+// StreamOpened учитывает открытую подписку на сообщения чата
+func StreamOpened() {
+	activeStreams.Inc()
+}
+
+// StreamClosed учитывает закрытую подписку на сообщения чата
+func StreamClosed() {
+	activeStreams.Dec()
+}
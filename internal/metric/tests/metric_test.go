@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/metric"
+)
+
+func scrape(t *testing.T) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	metric.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func TestObserveQuery(t *testing.T) {
+	metric.ObserveQuery("chat_repository.MetricsTest", 10*time.Millisecond, false)
+	metric.ObserveQuery("chat_repository.MetricsTest", 20*time.Millisecond, true)
+
+	body := scrape(t)
+	require.Contains(t, body, `chat_server_db_query_duration_seconds_count{query="chat_repository.MetricsTest"} 2`)
+	require.Contains(t, body, `chat_server_db_query_errors_total{query="chat_repository.MetricsTest"} 1`)
+}
+
+func TestObserveRequest(t *testing.T) {
+	metric.ObserveRequest("/chat_v1.ChatV1/MetricsTest", "NotFound", time.Millisecond)
+
+	body := scrape(t)
+	require.Contains(t, body, `chat_server_grpc_requests_total{code="NotFound",method="/chat_v1.ChatV1/MetricsTest"} 1`)
+	require.Contains(t, body, `chat_server_grpc_request_duration_seconds_count{code="NotFound",method="/chat_v1.ChatV1/MetricsTest"} 1`)
+}
+
+func TestBusinessCounters(t *testing.T) {
+	metric.IncMessagesSent()
+	metric.IncChatsCreated()
+	metric.StreamOpened()
+	metric.StreamOpened()
+	metric.StreamClosed()
+
+	body := scrape(t)
+	require.Contains(t, body, "chat_server_chat_messages_sent_total 1")
+	require.Contains(t, body, "chat_server_chat_chats_created_total 1")
+	require.Contains(t, body, "chat_server_chat_active_streams 1")
+}
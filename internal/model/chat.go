@@ -1,16 +1,116 @@
 package model
 
-import "google.golang.org/protobuf/types/known/timestamppb"
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 // ChatCreate модель для конвертации из протомодели в модель бизнес-логики
 type ChatCreate struct {
-	UsersID  []string
-	ChatName string
+	UsersID   []string
+	ChatName  string
+	CreatorID string
+	// IdempotencyKey ключ идемпотентности клиента, пустой если запрос не нужно дедуплицировать
+	IdempotencyKey string
+}
+
+// ChatDelete модель для удаления чата
+type ChatDelete struct {
+	ID     int64
+	UserID string
 }
 
 // ChatSendMessage модель для конвертации из протомодели в модель бизнес-логики
 type ChatSendMessage struct {
+	ChatID    int64
 	From      string
 	Text      string
 	Timestamp *timestamppb.Timestamp
+	// ReplyToMessageID ID сообщения, на которое отвечает новое, 0 если это не ответ
+	ReplyToMessageID int64
+	// IdempotencyKey ключ идемпотентности клиента, пустой если запрос не нужно дедуплицировать
+	IdempotencyKey string
+}
+
+// ChatConnect модель для подключения к потоку сообщений чата
+type ChatConnect struct {
+	ChatID int64
+	UserID string
+}
+
+// ChatMembers модель для изменения состава участников чата
+type ChatMembers struct {
+	ChatID  int64
+	UsersID []string
+	UserID  string
+}
+
+// ChatLeave модель для выхода пользователя из чата
+type ChatLeave struct {
+	ChatID int64
+	UserID string
+}
+
+// ChatMemberRole модель для изменения роли участника чата
+type ChatMemberRole struct {
+	ChatID   int64
+	UserID   string
+	MemberID string
+	Role     Role
+}
+
+// ChatOwnershipTransfer модель для передачи владения чатом
+type ChatOwnershipTransfer struct {
+	ChatID     int64
+	UserID     string
+	NewOwnerID string
+}
+
+// Chat модель чата с последней активностью
+type Chat struct {
+	ID            int64
+	Name          string
+	Members       []*Member
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	LastMessageAt *time.Time
+	LastMessage   *Message
+	// UnreadCount заполняется только в списке чатов пользователя
+	UnreadCount int64
+}
+
+// LastActivityAt время последней активности чата, по нему сортируется список чатов
+func (c *Chat) LastActivityAt() time.Time {
+	if c.LastMessageAt != nil {
+		return *c.LastMessageAt
+	}
+
+	return c.CreatedAt
+}
+
+// ChatCursor позиция в списке чатов, после которой начинается следующая страница
+type ChatCursor struct {
+	LastActivityAt time.Time
+	ID             int64
+}
+
+// ChatListFilter параметры выборки чатов пользователя
+type ChatListFilter struct {
+	UserID string
+	Limit  uint64
+	Cursor *ChatCursor
+}
+
+// ChatList страница списка чатов пользователя
+type ChatList struct {
+	Chats      []*Chat
+	NextCursor *ChatCursor
+}
+
+// ChatRead модель для отметки о прочтении сообщений чата
+type ChatRead struct {
+	ChatID    int64
+	UserID    string
+	MessageID int64
 }
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+const (
+	// IdempotencyScopeCreateChat область ключей идемпотентности создания чата
+	IdempotencyScopeCreateChat = "CreateChat"
+	// IdempotencyScopeSendMessage область ключей идемпотентности отправки сообщения
+	IdempotencyScopeSendMessage = "SendMessage"
+)
+
+// IdempotencyKey ключ идемпотентности запроса пользователя в рамках метода
+type IdempotencyKey struct {
+	Scope  string
+	UserID string
+	Key    string
+	// TTL время, в течение которого повтор запроса возвращает исходный результат
+	TTL time.Duration
+}
@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// Role роль участника в чате
+type Role string
+
+// Роли участников чата
+const (
+	RoleOwner    Role = "owner"
+	RoleAdmin    Role = "admin"
+	RoleMember   Role = "member"
+	RoleReadOnly Role = "read_only"
+)
+
+// Member участник чата
+type Member struct {
+	UserID string
+	Role   Role
+}
+
+// MemberCursor позиция в списке участников, после которой начинается следующая страница
+type MemberCursor struct {
+	UserID string
+}
+
+// MemberListFilter параметры выборки участников чата
+type MemberListFilter struct {
+	ChatID int64
+	// Limit равный нулю снимает ограничение на количество участников
+	Limit  uint64
+	Cursor *MemberCursor
+}
+
+// MemberList страница списка участников чата
+type MemberList struct {
+	Members    []*Member
+	NextCursor *MemberCursor
+}
+
+// ReadReceipt отметка о прочтении сообщения участником чата
+type ReadReceipt struct {
+	UserID string
+	ReadAt time.Time
+}
@@ -0,0 +1,78 @@
+package model
+
+import "time"
+
+// Message модель сообщения чата
+type Message struct {
+	ID        int64
+	ChatID    int64
+	From      string
+	Text      string
+	CreatedAt time.Time
+	EditedAt  *time.Time
+	// DeletedAt заполнен у удаленного сообщения, его текст при этом пустой
+	DeletedAt *time.Time
+	// ReplyToMessageID ID корневого сообщения треда, 0 у сообщений вне треда
+	ReplyToMessageID int64
+	// ReplyCount и LastReplyAt ведутся у корневого сообщения треда
+	ReplyCount  int64
+	LastReplyAt *time.Time
+	// PinnedAt заполнен у закрепленного сообщения
+	PinnedAt *time.Time
+}
+
+// MessageEdit модель для редактирования сообщения
+type MessageEdit struct {
+	MessageID int64
+	UserID    string
+	Text      string
+}
+
+// MessageDelete модель для удаления сообщения
+type MessageDelete struct {
+	MessageID int64
+	UserID    string
+}
+
+// MessagePin модель для закрепления и открепления сообщения
+type MessagePin struct {
+	MessageID int64
+	UserID    string
+	// Pinned false открепляет сообщение
+	Pinned bool
+}
+
+// MessageCursor позиция в истории сообщений, после которой начинается следующая страница
+type MessageCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// MessageListFilter параметры выборки истории сообщений чата
+type MessageListFilter struct {
+	ChatID int64
+	Limit  uint64
+	Cursor *MessageCursor
+	Before *time.Time
+	After  *time.Time
+}
+
+// ThreadFilter параметры выборки ответов в треде
+type ThreadFilter struct {
+	RootID int64
+	Limit  uint64
+	Cursor *MessageCursor
+}
+
+// Thread страница ответов в треде вместе с корневым сообщением
+type Thread struct {
+	Root       *Message
+	Replies    []*Message
+	NextCursor *MessageCursor
+}
+
+// MessageList страница истории сообщений
+type MessageList struct {
+	Messages   []*Message
+	NextCursor *MessageCursor
+}
@@ -0,0 +1,135 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository/chat/converter"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+// chatLastActivityExpr время последней активности чата, совпадает с выражением индекса chat_last_activity_idx
+const chatLastActivityExpr = "coalesce(c." + tableChatLastMessageAtColumn + ", c." + tableChatCreatedAtColumn + ")"
+
+// selectChats строит выборку чатов вместе с последним сообщением каждого из них
+func selectChats() sq.SelectBuilder {
+	return sq.Select(
+		"c."+tableChatIDColumn,
+		"c."+tableChatNameColumn,
+		"c."+tableChatCreatedAtColumn,
+		"c."+tableChatUpdatedAtColumn,
+		"c."+tableChatLastMessageAtColumn,
+		"lm."+tableMessagesIDColumn+" AS last_message_id",
+		"lm."+tableMessagesUserIDColumn+" AS last_message_user_id",
+		"lm."+tableMessagesMessageColumn+" AS last_message_text",
+		"lm."+tableMessagesCreatedAtColumn+" AS last_message_created_at",
+	).
+		From(tableChatName + " c").
+		LeftJoin("LATERAL (" +
+			"SELECT " + tableMessagesIDColumn + ", " + tableMessagesUserIDColumn + ", " +
+			tableMessagesMessageColumn + ", " + tableMessagesCreatedAtColumn +
+			" FROM " + tableMessagesName +
+			" WHERE " + tableMessagesChatIDColumn + " = c." + tableChatIDColumn +
+			" ORDER BY " + tableMessagesCreatedAtColumn + " DESC, " + tableMessagesIDColumn + " DESC" +
+			" LIMIT 1) lm ON true").
+		PlaceholderFormat(sq.Dollar)
+}
+
+// GetChat возвращает чат с последним сообщением
+func (r *repo) GetChat(ctx context.Context, id int64) (*model.Chat, error) {
+	builderGetChat := selectChats().
+		Where(sq.Eq{"c." + tableChatIDColumn: id})
+
+	query, args, err := builderGetChat.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build get chat query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetChat",
+		QueryRaw: query,
+	}
+
+	var chat modelRepo.Chat
+	err = r.db.DB().ScanOneContext(ctx, &chat, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute get chat query", logger.Err(err))
+		return nil, err
+	}
+
+	return converter.ToChatFromRepo(&chat), nil
+}
+
+// ListUserChats возвращает чаты пользователя от недавно активных к давно неактивным.
+// Список читается из реплики, вне транзакции он может немного отставать от основной БД
+func (r *repo) ListUserChats(ctx context.Context, filter *model.ChatListFilter) ([]*model.Chat, error) {
+	builderListChats := selectChats().
+		Column("cu."+tableChatUsersUnreadCountColumn).
+		Join(tableChatUsersName+" cu ON cu."+tableChatUsersChatIDColumn+" = c."+tableChatIDColumn).
+		Where(sq.Eq{"cu." + tableChatUsersUserIDColumn: filter.UserID}).
+		OrderBy(chatLastActivityExpr+" DESC", "c."+tableChatIDColumn+" DESC").
+		Limit(filter.Limit)
+
+	if filter.Cursor != nil {
+		builderListChats = builderListChats.Where(
+			sq.Expr("("+chatLastActivityExpr+", c."+tableChatIDColumn+") < (?, ?)",
+				filter.Cursor.LastActivityAt, filter.Cursor.ID),
+		)
+	}
+
+	query, args, err := builderListChats.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build list user chats query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.ListUserChats",
+		QueryRaw: query,
+	}
+
+	var chats []*modelRepo.Chat
+	err = r.db.Replica().ScanAllContext(ctx, &chats, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute list user chats query", logger.Err(err))
+		return nil, err
+	}
+
+	return converter.ToChatsFromRepo(chats), nil
+}
+
+// touchChatLastMessage сдвигает время последнего сообщения чата, сообщения с более ранней
+// отметкой времени его не откатывают
+func (r *repo) touchChatLastMessage(ctx context.Context, chatID int64, sentAt time.Time) error {
+	builderTouchChat := sq.Update(tableChatName).
+		Set(tableChatLastMessageAtColumn, sq.Expr("GREATEST("+tableChatLastMessageAtColumn+", ?::timestamp)", sentAt)).
+		Set(tableChatUpdatedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{tableChatIDColumn: chatID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderTouchChat.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build touch chat query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.TouchChatLastMessage",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute touch chat query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
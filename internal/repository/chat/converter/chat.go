@@ -0,0 +1,55 @@
+package converter
+
+import (
+	"strconv"
+
+	"github.com/ipv02/chat-server/internal/model"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+// ToChatFromRepo конвертер модели репо слоя в модель бизнес-логики
+func ToChatFromRepo(chat *modelRepo.Chat) *model.Chat {
+	if chat == nil {
+		return nil
+	}
+
+	res := &model.Chat{
+		ID:            chat.ID,
+		Name:          chat.Name,
+		CreatedAt:     chat.CreatedAt,
+		UpdatedAt:     chat.UpdatedAt,
+		LastMessageAt: chat.LastMessageAt,
+		UnreadCount:   chat.UnreadCount,
+	}
+
+	if chat.LastMessageID != nil {
+		res.LastMessage = &model.Message{
+			ID:     *chat.LastMessageID,
+			ChatID: chat.ID,
+		}
+
+		if chat.LastMessageUserID != nil {
+			res.LastMessage.From = strconv.FormatInt(*chat.LastMessageUserID, 10)
+		}
+
+		if chat.LastMessageText != nil {
+			res.LastMessage.Text = *chat.LastMessageText
+		}
+
+		if chat.LastMessageCreatedAt != nil {
+			res.LastMessage.CreatedAt = *chat.LastMessageCreatedAt
+		}
+	}
+
+	return res
+}
+
+// ToChatsFromRepo конвертер списка чатов репо слоя в модели бизнес-логики
+func ToChatsFromRepo(chats []*modelRepo.Chat) []*model.Chat {
+	res := make([]*model.Chat, 0, len(chats))
+	for _, chat := range chats {
+		res = append(res, ToChatFromRepo(chat))
+	}
+
+	return res
+}
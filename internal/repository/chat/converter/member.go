@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"strconv"
+
+	"github.com/ipv02/chat-server/internal/model"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+// ToMembersFromRepo конвертер списка участников репо слоя в модели бизнес-логики
+func ToMembersFromRepo(members []*modelRepo.Member) []*model.Member {
+	res := make([]*model.Member, 0, len(members))
+	for _, member := range members {
+		res = append(res, &model.Member{
+			UserID: strconv.FormatInt(member.UserID, 10),
+			Role:   model.Role(member.Role),
+		})
+	}
+
+	return res
+}
+
+// ToReadReceiptsFromRepo конвертер отметок о прочтении репо слоя в модели бизнес-логики
+func ToReadReceiptsFromRepo(receipts []*modelRepo.ReadReceipt) []*model.ReadReceipt {
+	res := make([]*model.ReadReceipt, 0, len(receipts))
+	for _, receipt := range receipts {
+		res = append(res, &model.ReadReceipt{
+			UserID: strconv.FormatInt(receipt.UserID, 10),
+			ReadAt: receipt.ReadAt,
+		})
+	}
+
+	return res
+}
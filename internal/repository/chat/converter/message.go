@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"strconv"
+
+	"github.com/ipv02/chat-server/internal/model"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+// ToMessageFromRepo конвертер модели репо слоя в модель бизнес-логики
+func ToMessageFromRepo(message *modelRepo.Message) *model.Message {
+	if message == nil {
+		return nil
+	}
+
+	res := &model.Message{
+		ID:          message.ID,
+		ChatID:      message.ChatID,
+		From:        strconv.FormatInt(message.UserID, 10),
+		Text:        message.Message,
+		CreatedAt:   message.CreatedAt,
+		EditedAt:    message.EditedAt,
+		DeletedAt:   message.DeletedAt,
+		ReplyCount:  message.ReplyCount,
+		LastReplyAt: message.LastReplyAt,
+		PinnedAt:    message.PinnedAt,
+	}
+
+	if message.ReplyToMessageID != nil {
+		res.ReplyToMessageID = *message.ReplyToMessageID
+	}
+
+	return res
+}
+
+// ToMessagesFromRepo конвертер списка сообщений репо слоя в модели бизнес-логики
+func ToMessagesFromRepo(messages []*modelRepo.Message) []*model.Message {
+	res := make([]*model.Message, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, ToMessageFromRepo(message))
+	}
+
+	return res
+}
+
+// ToMessageNotificationFromService конвертер модели бизнес-логики в уведомление о новом сообщении
+func ToMessageNotificationFromService(message *model.Message) *modelRepo.MessageNotification {
+	return &modelRepo.MessageNotification{
+		ID:               message.ID,
+		ChatID:           message.ChatID,
+		From:             message.From,
+		Text:             message.Text,
+		CreatedAt:        message.CreatedAt,
+		ReplyToMessageID: message.ReplyToMessageID,
+	}
+}
+
+// ToMessageFromNotification конвертер уведомления о новом сообщении в модель бизнес-логики
+func ToMessageFromNotification(notification *modelRepo.MessageNotification) *model.Message {
+	return &model.Message{
+		ID:               notification.ID,
+		ChatID:           notification.ChatID,
+		From:             notification.From,
+		Text:             notification.Text,
+		CreatedAt:        notification.CreatedAt,
+		ReplyToMessageID: notification.ReplyToMessageID,
+	}
+}
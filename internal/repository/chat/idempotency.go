@@ -0,0 +1,187 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+const (
+	tableIdempotencyKeysName            = "idempotency_keys"
+	tableIdempotencyKeysScopeColumn     = "scope"
+	tableIdempotencyKeysUserIDColumn    = "user_id"
+	tableIdempotencyKeysKeyColumn       = "key"
+	tableIdempotencyKeysResultIDColumn  = "result_id"
+	tableIdempotencyKeysCreatedAtColumn = "created_at"
+	tableIdempotencyKeysExpiresAtColumn = "expires_at"
+)
+
+// ReserveIdempotencyKey занимает ключ идемпотентности в текущей транзакции, просроченный ключ занимается заново.
+// Возвращает false, если ключ уже занят: при конкурентной вставке запрос дожидается завершения
+// транзакции, занявшей ключ, поэтому после этого ее результат уже можно прочитать.
+func (r *repo) ReserveIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) (bool, error) {
+	builderReserve := sq.Insert(tableIdempotencyKeysName).
+		Columns(
+			tableIdempotencyKeysScopeColumn,
+			tableIdempotencyKeysUserIDColumn,
+			tableIdempotencyKeysKeyColumn,
+			tableIdempotencyKeysExpiresAtColumn,
+		).
+		Values(key.Scope, key.UserID, key.Key, sq.Expr("now() + make_interval(secs => ?)", key.TTL.Seconds())).
+		Suffix("ON CONFLICT (" + tableIdempotencyKeysScopeColumn + ", " + tableIdempotencyKeysUserIDColumn + ", " +
+			tableIdempotencyKeysKeyColumn + ") DO UPDATE SET " +
+			tableIdempotencyKeysResultIDColumn + " = NULL, " +
+			tableIdempotencyKeysCreatedAtColumn + " = now(), " +
+			tableIdempotencyKeysExpiresAtColumn + " = excluded." + tableIdempotencyKeysExpiresAtColumn +
+			" WHERE " + tableIdempotencyKeysName + "." + tableIdempotencyKeysExpiresAtColumn + " <= now() " +
+			"RETURNING " + tableIdempotencyKeysKeyColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderReserve.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build reserve idempotency key query", logger.Err(err))
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "idempotency_repository.ReserveIdempotencyKey",
+		QueryRaw: query,
+	}
+
+	var reserved string
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&reserved)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute reserve idempotency key query", logger.Err(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetIdempotencyResult возвращает результат запроса, сохраненный для ключа идемпотентности
+func (r *repo) GetIdempotencyResult(ctx context.Context, key *model.IdempotencyKey) (int64, error) {
+	builderResult := sq.Select(tableIdempotencyKeysResultIDColumn).
+		From(tableIdempotencyKeysName).
+		Where(sq.Eq{
+			tableIdempotencyKeysScopeColumn:  key.Scope,
+			tableIdempotencyKeysUserIDColumn: key.UserID,
+			tableIdempotencyKeysKeyColumn:    key.Key,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderResult.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build idempotency result query", logger.Err(err))
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "idempotency_repository.GetIdempotencyResult",
+		QueryRaw: query,
+	}
+
+	var resultID *int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&resultID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute idempotency result query", logger.Err(err))
+		return 0, err
+	}
+
+	// Ключ занимается и получает результат в одной транзакции, поэтому после ее коммита результат всегда есть
+	if resultID == nil {
+		return 0, errors.New("idempotency key has no result")
+	}
+
+	return *resultID, nil
+}
+
+// SaveIdempotencyResult сохраняет результат запроса для занятого ключа идемпотентности
+func (r *repo) SaveIdempotencyResult(ctx context.Context, key *model.IdempotencyKey, resultID int64) error {
+	builderSave := sq.Update(tableIdempotencyKeysName).
+		Set(tableIdempotencyKeysResultIDColumn, resultID).
+		Where(sq.Eq{
+			tableIdempotencyKeysScopeColumn:  key.Scope,
+			tableIdempotencyKeysUserIDColumn: key.UserID,
+			tableIdempotencyKeysKeyColumn:    key.Key,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSave.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build save idempotency result query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "idempotency_repository.SaveIdempotencyResult",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute save idempotency result query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys удаляет просроченные ключи идемпотентности пачками по batchSize,
+// каждая пачка удаляется отдельным запросом, чтобы не держать блокировки на всех просроченных ключах сразу.
+// Ключ, который занят заново между выборкой и удалением, уже не просрочен и остается.
+// Возвращает количество удаленных ключей.
+func (r *repo) DeleteExpiredIdempotencyKeys(ctx context.Context, batchSize uint64) (int64, error) {
+	if batchSize == 0 {
+		return 0, errors.New("idempotency keys batch size must be positive")
+	}
+
+	keyColumns := "(" + tableIdempotencyKeysScopeColumn + ", " + tableIdempotencyKeysUserIDColumn + ", " +
+		tableIdempotencyKeysKeyColumn + ")"
+
+	expiredKeys := sq.Select(tableIdempotencyKeysScopeColumn, tableIdempotencyKeysUserIDColumn, tableIdempotencyKeysKeyColumn).
+		From(tableIdempotencyKeysName).
+		Where(sq.Expr(tableIdempotencyKeysExpiresAtColumn + " <= now()")).
+		Limit(batchSize)
+
+	builderDelete := sq.Delete(tableIdempotencyKeysName).
+		Where(sq.Expr(keyColumns+" IN (?)", expiredKeys)).
+		Where(sq.Expr(tableIdempotencyKeysExpiresAtColumn + " <= now()")).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build delete expired idempotency keys query", logger.Err(err))
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "idempotency_repository.DeleteExpiredIdempotencyKeys",
+		QueryRaw: query,
+	}
+
+	var deleted int64
+	for {
+		tag, err := r.db.DB().ExecContext(ctx, q, args...)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to execute delete expired idempotency keys query", logger.Err(err))
+			return deleted, err
+		}
+
+		deleted += tag.RowsAffected()
+
+		if uint64(tag.RowsAffected()) < batchSize {
+			return deleted, nil
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository/chat/converter"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+// AddChatMembers добавляет пользователей в чат с ролью участника, уже состоящие в нем пользователи пропускаются
+func (r *repo) AddChatMembers(ctx context.Context, chatID int64, usersID []string) error {
+	return r.insertChatUsers(ctx, chatID, usersID, model.RoleMember)
+}
+
+// RemoveChatMembers удаляет пользователей из чата, не состоящие в нем пользователи пропускаются.
+// Владелец чата не удаляется, сначала он должен передать владение.
+func (r *repo) RemoveChatMembers(ctx context.Context, chatID int64, usersID []string) error {
+	deleteChatUsersBuilder := sq.Delete(tableChatUsersName).
+		Where(sq.Eq{
+			tableChatUsersChatIDColumn: chatID,
+			tableChatUsersUserIDColumn: usersID,
+		}).
+		Where(sq.NotEq{tableChatUsersRoleColumn: model.RoleOwner}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := deleteChatUsersBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build remove chat members query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.RemoveChatMembers",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute remove chat members query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// ListChatMembers возвращает участников чата, упорядоченных по ID пользователя
+func (r *repo) ListChatMembers(ctx context.Context, filter *model.MemberListFilter) ([]*model.Member, error) {
+	builderListMembers := sq.Select(tableChatUsersUserIDColumn, tableChatUsersRoleColumn).
+		From(tableChatUsersName).
+		Where(sq.Eq{tableChatUsersChatIDColumn: filter.ChatID}).
+		OrderBy(tableChatUsersUserIDColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Limit > 0 {
+		builderListMembers = builderListMembers.Limit(filter.Limit)
+	}
+
+	if filter.Cursor != nil {
+		builderListMembers = builderListMembers.Where(sq.Gt{tableChatUsersUserIDColumn: filter.Cursor.UserID})
+	}
+
+	query, args, err := builderListMembers.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build list chat members query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.ListChatMembers",
+		QueryRaw: query,
+	}
+
+	var members []*modelRepo.Member
+	err = r.db.DB().ScanAllContext(ctx, &members, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute list chat members query", logger.Err(err))
+		return nil, err
+	}
+
+	return converter.ToMembersFromRepo(members), nil
+}
+
+// CountChatMembers возвращает количество участников чата
+func (r *repo) CountChatMembers(ctx context.Context, chatID int64) (int64, error) {
+	builderCountMembers := sq.Select("COUNT(*)").
+		From(tableChatUsersName).
+		Where(sq.Eq{tableChatUsersChatIDColumn: chatID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderCountMembers.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build count chat members query", logger.Err(err))
+		return 0, err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.CountChatMembers",
+		QueryRaw: query,
+	}
+
+	var count int64
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&count)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute count chat members query", logger.Err(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetMemberRole возвращает роль пользователя в чате или пустую роль, если он не состоит в чате
+func (r *repo) GetMemberRole(ctx context.Context, chatID int64, userID string) (model.Role, error) {
+	builderMemberRole := sq.Select(tableChatUsersRoleColumn).
+		From(tableChatUsersName).
+		Where(sq.Eq{
+			tableChatUsersChatIDColumn: chatID,
+			tableChatUsersUserIDColumn: userID,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderMemberRole.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build member role query", logger.Err(err))
+		return "", err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.GetMemberRole",
+		QueryRaw: query,
+	}
+
+	var role model.Role
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute member role query", logger.Err(err))
+		return "", err
+	}
+
+	return role, nil
+}
+
+// SetMemberRole изменяет роль участника чата
+func (r *repo) SetMemberRole(ctx context.Context, chatID int64, userID string, role model.Role) error {
+	builderSetRole := sq.Update(tableChatUsersName).
+		Set(tableChatUsersRoleColumn, role).
+		Where(sq.Eq{
+			tableChatUsersChatIDColumn: chatID,
+			tableChatUsersUserIDColumn: userID,
+		}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSetRole.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build set member role query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.SetMemberRole",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute set member role query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
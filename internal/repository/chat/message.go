@@ -0,0 +1,257 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository/chat/converter"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+// LockMessage возвращает сообщение, блокируя его строку до конца транзакции,
+// или nil, если сообщение не найдено
+func (r *repo) LockMessage(ctx context.Context, id int64) (*model.Message, error) {
+	builderLockMessage := selectMessages().
+		Where(sq.Eq{tableMessagesIDColumn: id}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderLockMessage.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build lock message query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.LockMessage",
+		QueryRaw: query,
+	}
+
+	var message modelRepo.Message
+	err = r.db.DB().ScanOneContext(ctx, &message, q, args...)
+	if pgxscan.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute lock message query", logger.Err(err))
+		return nil, err
+	}
+
+	return converter.ToMessageFromRepo(&message), nil
+}
+
+// EditMessage сохраняет текущую версию сообщения в истории правок и заменяет его текст
+func (r *repo) EditMessage(ctx context.Context, id int64, text string) error {
+	builderSaveVersion := sq.Insert(tableMessageEditsName).
+		Columns(tableMessageEditsMessageIDColumn, tableMessageEditsMessageColumn).
+		Select(
+			sq.Select(tableMessagesIDColumn, tableMessagesMessageColumn).
+				From(tableMessagesName).
+				Where(sq.Eq{tableMessagesIDColumn: id}),
+		).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderSaveVersion.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build save message version query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "message_edits_repository.Create",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute save message version query", logger.Err(err))
+		return err
+	}
+
+	builderEditMessage := sq.Update(tableMessagesName).
+		Set(tableMessagesMessageColumn, db.Sensitive{Value: text}).
+		Set(tableMessagesEditedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{tableMessagesIDColumn: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err = builderEditMessage.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build edit message query", logger.Err(err))
+		return err
+	}
+
+	q = db.Query{
+		Name:     "chat_repository.EditMessage",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute edit message query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMessage мягко удаляет сообщение: текст очищается, а запись остается в истории,
+// чтобы не сдвигать курсоры пагинации. Предыдущие версии из истории правок удаляются
+// вместе с текстом, сообщение открепляется и перестает учитываться в счетчиках непрочитанных.
+// Вызывается в транзакции для еще не удаленного сообщения.
+func (r *repo) DeleteMessage(ctx context.Context, message *model.Message) error {
+	builderDeleteVersions := sq.Delete(tableMessageEditsName).
+		Where(sq.Eq{tableMessageEditsMessageIDColumn: message.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderDeleteVersions.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build delete message versions query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "message_edits_repository.Delete",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute delete message versions query", logger.Err(err))
+		return err
+	}
+
+	builderDeleteMessage := sq.Update(tableMessagesName).
+		Set(tableMessagesMessageColumn, "").
+		Set(tableMessagesDeletedAtColumn, sq.Expr("now()")).
+		Set(tableMessagesPinnedAtColumn, nil).
+		Where(sq.Eq{tableMessagesIDColumn: message.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err = builderDeleteMessage.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build delete message query", logger.Err(err))
+		return err
+	}
+
+	q = db.Query{
+		Name:     "chat_repository.DeleteMessage",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute delete message query", logger.Err(err))
+		return err
+	}
+
+	err = r.decrementUnread(ctx, message)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PinMessage закрепляет или открепляет сообщение. Повторное закрепление обновляет время закрепления.
+func (r *repo) PinMessage(ctx context.Context, id int64, pinned bool) error {
+	var pinnedAt interface{}
+	if pinned {
+		pinnedAt = sq.Expr("now()")
+	}
+
+	builderPinMessage := sq.Update(tableMessagesName).
+		Set(tableMessagesPinnedAtColumn, pinnedAt).
+		Where(sq.Eq{tableMessagesIDColumn: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderPinMessage.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build pin message query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.PinMessage",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute pin message query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// ListThreadReplies возвращает ответы в треде от старых к новым, читая их из реплики
+func (r *repo) ListThreadReplies(ctx context.Context, filter *model.ThreadFilter) ([]*model.Message, error) {
+	builderListReplies := selectMessages().
+		Where(sq.Eq{tableMessagesReplyToColumn: filter.RootID}).
+		OrderBy(tableMessagesCreatedAtColumn, tableMessagesIDColumn).
+		Limit(filter.Limit).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Cursor != nil {
+		builderListReplies = builderListReplies.Where(
+			sq.Expr("("+tableMessagesCreatedAtColumn+", "+tableMessagesIDColumn+") > (?, ?)",
+				filter.Cursor.CreatedAt, filter.Cursor.ID),
+		)
+	}
+
+	query, args, err := builderListReplies.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build list thread replies query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.ListThreadReplies",
+		QueryRaw: query,
+	}
+
+	var messages []*modelRepo.Message
+	err = r.db.Replica().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute list thread replies query", logger.Err(err))
+		return nil, err
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+// touchThreadRoot увеличивает счетчик ответов корневого сообщения и сдвигает время последнего ответа
+func (r *repo) touchThreadRoot(ctx context.Context, rootID int64, repliedAt time.Time) error {
+	builderTouchRoot := sq.Update(tableMessagesName).
+		Set(tableMessagesReplyCountColumn, sq.Expr(tableMessagesReplyCountColumn+" + 1")).
+		Set(tableMessagesLastReplyAtColumn, sq.Expr("GREATEST("+tableMessagesLastReplyAtColumn+", ?::timestamp)", repliedAt)).
+		Where(sq.Eq{tableMessagesIDColumn: rootID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderTouchRoot.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build touch thread root query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.TouchThreadRoot",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute touch thread root query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
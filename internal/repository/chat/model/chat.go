@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Chat модель чата в репо слое вместе с последним сообщением
+type Chat struct {
+	ID            int64      `db:"id"`
+	Name          string     `db:"name"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+	LastMessageAt *time.Time `db:"last_message_at"`
+	// Поля последнего сообщения пустые, если в чате еще нет сообщений
+	LastMessageID        *int64     `db:"last_message_id"`
+	LastMessageUserID    *int64     `db:"last_message_user_id"`
+	LastMessageText      *string    `db:"last_message_text"`
+	LastMessageCreatedAt *time.Time `db:"last_message_created_at"`
+	// UnreadCount выбирается только вместе с участником чата
+	UnreadCount int64 `db:"unread_count"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Member модель участника чата в репо слое
+type Member struct {
+	UserID int64  `db:"user_id"`
+	Role   string `db:"role"`
+}
+
+// ReadReceipt модель отметки о прочтении в репо слое
+type ReadReceipt struct {
+	UserID int64     `db:"user_id"`
+	ReadAt time.Time `db:"read_at"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Message модель сообщения в репо слое
+type Message struct {
+	ID        int64      `db:"id"`
+	ChatID    int64      `db:"chat_id"`
+	UserID    int64      `db:"user_id"`
+	Message   string     `db:"message"`
+	CreatedAt time.Time  `db:"created_at"`
+	EditedAt  *time.Time `db:"edited_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+
+	ReplyToMessageID *int64     `db:"reply_to_message_id"`
+	ReplyCount       int64      `db:"reply_count"`
+	LastReplyAt      *time.Time `db:"last_reply_at"`
+
+	PinnedAt *time.Time `db:"pinned_at"`
+}
+
+// MessageNotification полезная нагрузка NOTIFY о новом сообщении
+type MessageNotification struct {
+	ID        int64     `json:"id"`
+	ChatID    int64     `json:"chat_id"`
+	From      string    `json:"from,omitempty"`
+	Text      string    `json:"text,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+	// Partial выставляется, если сообщение не поместилось в NOTIFY и его нужно дочитать из БД
+	Partial bool `json:"partial,omitempty"`
+}
@@ -0,0 +1,76 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository/chat/converter"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+const (
+	messagesChannel = "chat_messages"
+
+	// maxNotifyPayloadSize запас до лимита PostgreSQL на размер полезной нагрузки NOTIFY в 8000 байт
+	maxNotifyPayloadSize = 7900
+)
+
+// notifyMessage отправляет уведомление о новом сообщении другим экземплярам сервиса.
+// Вызывается в транзакции вставки сообщения, поэтому уведомление уходит только после коммита.
+func (r *repo) notifyMessage(ctx context.Context, message *model.Message) error {
+	notification := converter.ToMessageNotificationFromService(message)
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	if len(payload) > maxNotifyPayloadSize {
+		notification = &modelRepo.MessageNotification{
+			ID:        message.ID,
+			ChatID:    message.ChatID,
+			CreatedAt: message.CreatedAt,
+			Partial:   true,
+		}
+
+		payload, err = json.Marshal(notification)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.db.DB().Notify(ctx, messagesChannel, string(payload))
+}
+
+// ListenMessages передает в handler сообщения, отправленные через любой экземпляр сервиса,
+// пока не будет отменен контекст
+func (r *repo) ListenMessages(ctx context.Context, handler func(message *model.Message)) error {
+	return r.db.DB().Listen(ctx, messagesChannel, func(payload string) {
+		notification := &modelRepo.MessageNotification{}
+		if err := json.Unmarshal([]byte(payload), notification); err != nil {
+			slog.ErrorContext(ctx, "failed to decode message notification", logger.Err(err))
+			return
+		}
+
+		if !notification.Partial {
+			handler(converter.ToMessageFromNotification(notification))
+			return
+		}
+
+		message, err := r.GetMessage(ctx, notification.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get notified message", slog.Int64("message_id", notification.ID), logger.Err(err))
+			return
+		}
+
+		// Сообщение могло исчезнуть вместе с чатом до того, как его дочитали
+		if message == nil {
+			return
+		}
+
+		handler(message)
+	})
+}
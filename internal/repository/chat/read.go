@@ -0,0 +1,162 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository/chat/converter"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+// lastReadPositionExpr позиция последнего прочитанного сообщения, у ничего не прочитавших она меньше любого сообщения
+const lastReadPositionExpr = "(coalesce(" + tableChatUsersLastReadAtColumn + ", '-infinity'::timestamp), " +
+	"coalesce(" + tableChatUsersLastReadIDColumn + ", 0))"
+
+// maxUnreadRecount сколько непрочитанных сообщений MarkRead пересчитывает не больше
+const maxUnreadRecount = 1000
+
+// MarkRead сдвигает отметку о прочтении участника до указанного сообщения и пересчитывает
+// количество непрочитанных без учета удаленных сообщений. Пересчет читает не больше maxUnreadRecount
+// строк после отметки, поэтому при большем числе непрочитанных счетчик насыщается на maxUnreadRecount
+// и дальше растет с новыми сообщениями: значение от maxUnreadRecount означает "не меньше".
+// Отметка не сдвигается назад.
+func (r *repo) MarkRead(ctx context.Context, chatID int64, userID string, message *model.Message) error {
+	unreadMessages := sq.Select("1").
+		From(tableMessagesName).
+		Where(sq.Eq{tableMessagesChatIDColumn: chatID}).
+		Where(sq.NotEq{tableMessagesUserIDColumn: userID}).
+		Where(sq.Eq{tableMessagesDeletedAtColumn: nil}).
+		Where(sq.Expr("("+tableMessagesCreatedAtColumn+", "+tableMessagesIDColumn+") > (?, ?)",
+			message.CreatedAt, message.ID)).
+		Limit(maxUnreadRecount)
+
+	unreadCount := sq.Select("count(*)").
+		FromSelect(unreadMessages, "unread")
+
+	builderMarkRead := sq.Update(tableChatUsersName).
+		Set(tableChatUsersLastReadIDColumn, message.ID).
+		Set(tableChatUsersLastReadAtColumn, message.CreatedAt).
+		Set(tableChatUsersReadAtColumn, sq.Expr("now()")).
+		Set(tableChatUsersUnreadCountColumn, sq.Expr("(?)", unreadCount)).
+		Where(sq.Eq{
+			tableChatUsersChatIDColumn: chatID,
+			tableChatUsersUserIDColumn: userID,
+		}).
+		Where(sq.Expr(lastReadPositionExpr+" < (?::timestamp, ?::int)", message.CreatedAt, message.ID)).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderMarkRead.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build mark read query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.MarkRead",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute mark read query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// ListReadReceipts возвращает участников, чья отметка о прочтении не раньше сообщения, кроме его автора.
+// Отметки читаются из реплики
+func (r *repo) ListReadReceipts(ctx context.Context, message *model.Message) ([]*model.ReadReceipt, error) {
+	builderReceipts := sq.Select(tableChatUsersUserIDColumn, tableChatUsersReadAtColumn).
+		From(tableChatUsersName).
+		Where(sq.Eq{tableChatUsersChatIDColumn: message.ChatID}).
+		Where(sq.NotEq{tableChatUsersUserIDColumn: message.From}).
+		Where(sq.Expr(lastReadPositionExpr+" >= (?::timestamp, ?::int)", message.CreatedAt, message.ID)).
+		OrderBy(tableChatUsersReadAtColumn, tableChatUsersUserIDColumn).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderReceipts.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build read receipts query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.ListReadReceipts",
+		QueryRaw: query,
+	}
+
+	var receipts []*modelRepo.ReadReceipt
+	err = r.db.Replica().ScanAllContext(ctx, &receipts, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute read receipts query", logger.Err(err))
+		return nil, err
+	}
+
+	return converter.ToReadReceiptsFromRepo(receipts), nil
+}
+
+// incrementUnread увеличивает счетчик непрочитанных у всех участников чата, кроме отправителя.
+// Инкремент атомарен и не теряется при параллельном пересчете счетчика в MarkRead
+func (r *repo) incrementUnread(ctx context.Context, chatID int64, senderID string) error {
+	builderIncrement := sq.Update(tableChatUsersName).
+		Set(tableChatUsersUnreadCountColumn, sq.Expr(tableChatUsersUnreadCountColumn+" + 1")).
+		Where(sq.Eq{tableChatUsersChatIDColumn: chatID}).
+		Where(sq.NotEq{tableChatUsersUserIDColumn: senderID}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderIncrement.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build increment unread query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.IncrementUnread",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute increment unread query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// decrementUnread уменьшает счетчик непрочитанных у участников, которые еще не прочитали удаляемое сообщение
+func (r *repo) decrementUnread(ctx context.Context, message *model.Message) error {
+	builderDecrement := sq.Update(tableChatUsersName).
+		Set(tableChatUsersUnreadCountColumn, sq.Expr(tableChatUsersUnreadCountColumn+" - 1")).
+		Where(sq.Eq{tableChatUsersChatIDColumn: message.ChatID}).
+		Where(sq.NotEq{tableChatUsersUserIDColumn: message.From}).
+		Where(sq.Gt{tableChatUsersUnreadCountColumn: 0}).
+		Where(sq.Expr(lastReadPositionExpr+" < (?::timestamp, ?::int)", message.CreatedAt, message.ID)).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderDecrement.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build decrement unread query", logger.Err(err))
+		return err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.DecrementUnread",
+		QueryRaw: query,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute decrement unread query", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
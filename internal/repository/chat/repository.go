@@ -2,27 +2,54 @@ package chat
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
 	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/logger"
 	"github.com/ipv02/chat-server/internal/model"
 	"github.com/ipv02/chat-server/internal/repository"
+	"github.com/ipv02/chat-server/internal/repository/chat/converter"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
 )
 
 const (
-	tableChatName       = "chat"
-	tableChatIDColumn   = "id"
-	tableChatNameColumn = "name"
+	tableChatName                = "chat"
+	tableChatIDColumn            = "id"
+	tableChatNameColumn          = "name"
+	tableChatCreatedAtColumn     = "created_at"
+	tableChatUpdatedAtColumn     = "updated_at"
+	tableChatLastMessageAtColumn = "last_message_at"
 
 	tableChatUsersName         = "chat_users"
 	tableChatUsersChatIDColumn = "chat_id"
 	tableChatUsersUserIDColumn = "user_id"
-
-	tableMessagesName            = "messages"
-	tableMessagesUserIDColumn    = "user_id"
-	tableMessagesMessageColumn   = "message"
-	tableMessagesCreatedAtColumn = "created_at"
+	tableChatUsersRoleColumn   = "role"
+
+	tableChatUsersLastReadIDColumn  = "last_read_message_id"
+	tableChatUsersLastReadAtColumn  = "last_read_message_at"
+	tableChatUsersReadAtColumn      = "read_at"
+	tableChatUsersUnreadCountColumn = "unread_count"
+
+	tableMessagesName               = "messages"
+	tableMessagesIDColumn           = "id"
+	tableMessagesChatIDColumn       = "chat_id"
+	tableMessagesUserIDColumn       = "user_id"
+	tableMessagesMessageColumn      = "message"
+	tableMessagesCreatedAtColumn    = "created_at"
+	tableMessagesClientSentAtColumn = "client_sent_at"
+	tableMessagesEditedAtColumn     = "edited_at"
+	tableMessagesDeletedAtColumn    = "deleted_at"
+	tableMessagesReplyToColumn      = "reply_to_message_id"
+	tableMessagesReplyCountColumn   = "reply_count"
+	tableMessagesLastReplyAtColumn  = "last_reply_at"
+	tableMessagesPinnedAtColumn     = "pinned_at"
+
+	tableMessageEditsName            = "message_edits"
+	tableMessageEditsMessageIDColumn = "message_id"
+	tableMessageEditsMessageColumn   = "message"
 )
 
 type repo struct {
@@ -41,7 +68,11 @@ func (r *repo) CreateChat(ctx context.Context, chat *model.ChatCreate) (int64, e
 		return 0, err
 	}
 
-	if err := r.insertChatUsers(ctx, chatID, chat.UsersID); err != nil {
+	if err := r.insertChatUsers(ctx, chatID, []string{chat.CreatorID}, model.RoleOwner); err != nil {
+		return 0, err
+	}
+
+	if err := r.insertChatUsers(ctx, chatID, chat.UsersID, model.RoleMember); err != nil {
 		return 0, err
 	}
 
@@ -58,7 +89,7 @@ func (r *repo) insertChat(ctx context.Context, chatName string) (int64, error) {
 
 	query, args, err := builderChatInsert.ToSql()
 	if err != nil {
-		log.Printf("failed to build chat insert query: %v", err)
+		slog.ErrorContext(ctx, "failed to build chat insert query", logger.Err(err))
 		return 0, err
 	}
 
@@ -70,26 +101,28 @@ func (r *repo) insertChat(ctx context.Context, chatName string) (int64, error) {
 	var chatID int64
 	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&chatID)
 	if err != nil {
-		log.Printf("failed to execute chat insert query: %v", err)
+		slog.ErrorContext(ctx, "failed to execute chat insert query", logger.Err(err))
 		return 0, err
 	}
 
 	return chatID, nil
 }
 
-// insertChatUsers Вставка пользователей в таблицу chat_users за одно обращение к БД
-func (r *repo) insertChatUsers(ctx context.Context, chatID int64, userIDs []string) error {
+// insertChatUsers Вставка пользователей с указанной ролью в таблицу chat_users за одно обращение к БД,
+// пользователи, уже состоящие в чате, пропускаются
+func (r *repo) insertChatUsers(ctx context.Context, chatID int64, userIDs []string, role model.Role) error {
 	builderChatUsersInsert := sq.Insert(tableChatUsersName).
-		Columns(tableChatUsersChatIDColumn, tableChatUsersUserIDColumn).
+		Columns(tableChatUsersChatIDColumn, tableChatUsersUserIDColumn, tableChatUsersRoleColumn).
+		Suffix("ON CONFLICT DO NOTHING").
 		PlaceholderFormat(sq.Dollar)
 
 	for _, userID := range userIDs {
-		builderChatUsersInsert = builderChatUsersInsert.Values(chatID, userID)
+		builderChatUsersInsert = builderChatUsersInsert.Values(chatID, userID, role)
 	}
 
 	query, args, err := builderChatUsersInsert.ToSql()
 	if err != nil {
-		log.Printf("failed to build chat_users insert query: %v", err)
+		slog.ErrorContext(ctx, "failed to build chat_users insert query", logger.Err(err))
 		return err
 	}
 
@@ -100,7 +133,7 @@ func (r *repo) insertChatUsers(ctx context.Context, chatID int64, userIDs []stri
 
 	_, err = r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		log.Printf("failed to execute chat_users insert query: %v", err)
+		slog.ErrorContext(ctx, "failed to execute chat_users insert query", logger.Err(err))
 		return err
 	}
 
@@ -110,12 +143,12 @@ func (r *repo) insertChatUsers(ctx context.Context, chatID int64, userIDs []stri
 // DeleteChat удаление чата в базе данных
 func (r *repo) DeleteChat(ctx context.Context, id int64) error {
 	if err := r.deleteChatByID(ctx, id); err != nil {
-		log.Printf("failed to delete chat: %v", err)
+		slog.ErrorContext(ctx, "failed to delete chat", logger.Err(err))
 		return err
 	}
 
 	if err := r.deleteChatUsersByChatID(ctx, id); err != nil {
-		log.Printf("failed to delete chat users: %v", err)
+		slog.ErrorContext(ctx, "failed to delete chat users", logger.Err(err))
 		return err
 	}
 
@@ -130,7 +163,7 @@ func (r *repo) deleteChatByID(ctx context.Context, id int64) error {
 
 	query, args, err := deleteChatBuilder.ToSql()
 	if err != nil {
-		log.Printf("failed to build delete chat query: %v", err)
+		slog.ErrorContext(ctx, "failed to build delete chat query", logger.Err(err))
 		return err
 	}
 
@@ -141,7 +174,7 @@ func (r *repo) deleteChatByID(ctx context.Context, id int64) error {
 
 	_, err = r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		log.Printf("failed to execute delete chat query: %v", err)
+		slog.ErrorContext(ctx, "failed to execute delete chat query", logger.Err(err))
 		return err
 	}
 
@@ -156,7 +189,7 @@ func (r *repo) deleteChatUsersByChatID(ctx context.Context, chatID int64) error
 
 	query, args, err := deleteChatUsersBuilder.ToSql()
 	if err != nil {
-		log.Printf("failed to build delete chat users query: %v", err)
+		slog.ErrorContext(ctx, "failed to build delete chat users query", logger.Err(err))
 		return err
 	}
 
@@ -167,26 +200,45 @@ func (r *repo) deleteChatUsersByChatID(ctx context.Context, chatID int64) error
 
 	_, err = r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		log.Printf("failed to execute delete chat users query: %v", err)
+		slog.ErrorContext(ctx, "failed to execute delete chat users query", logger.Err(err))
 		return err
 	}
 
 	return nil
 }
 
-// SendMessage запись в базу данных отправленных сообщений
-func (r *repo) SendMessage(ctx context.Context, chat *model.ChatSendMessage) error {
-	var messageID int64
+// SendMessage запись в базу данных отправленных сообщений, возвращает сохраненное сообщение.
+// Время сообщения выставляет сервер, время клиента сохраняется только для справки.
+// Для ответа в треде обновляет счетчики корневого сообщения в той же транзакции.
+func (r *repo) SendMessage(ctx context.Context, chat *model.ChatSendMessage) (*model.Message, error) {
+	var replyTo *int64
+	if chat.ReplyToMessageID != 0 {
+		replyTo = &chat.ReplyToMessageID
+	}
+
+	var clientSentAt *time.Time
+	if chat.Timestamp != nil {
+		sentAt := chat.Timestamp.AsTime()
+		clientSentAt = &sentAt
+	}
+
 	insertMessageBuilder := sq.Insert(tableMessagesName).
-		Columns(tableMessagesUserIDColumn, tableMessagesMessageColumn, tableMessagesCreatedAtColumn).
-		Values(chat.From, chat.Text, chat.Timestamp).
+		Columns(
+			tableMessagesChatIDColumn,
+			tableMessagesUserIDColumn,
+			tableMessagesMessageColumn,
+			tableMessagesCreatedAtColumn,
+			tableMessagesClientSentAtColumn,
+			tableMessagesReplyToColumn,
+		).
+		Values(chat.ChatID, chat.From, db.Sensitive{Value: chat.Text}, sq.Expr("now()"), clientSentAt, replyTo).
 		PlaceholderFormat(sq.Dollar).
-		Suffix("RETURNING id")
+		Suffix("RETURNING " + tableMessagesIDColumn + ", " + tableMessagesCreatedAtColumn)
 
 	query, args, err := insertMessageBuilder.ToSql()
 	if err != nil {
-		log.Printf("failed to build query: %v", err)
-		return err
+		slog.ErrorContext(ctx, "failed to build query", logger.Err(err))
+		return nil, err
 	}
 
 	q := db.Query{
@@ -194,11 +246,204 @@ func (r *repo) SendMessage(ctx context.Context, chat *model.ChatSendMessage) err
 		QueryRaw: query,
 	}
 
-	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&messageID)
+	message := &model.Message{
+		ChatID:           chat.ChatID,
+		From:             chat.From,
+		Text:             chat.Text,
+		ReplyToMessageID: chat.ReplyToMessageID,
+	}
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&message.ID, &message.CreatedAt)
 	if err != nil {
-		log.Printf("failed to execute query: %v", err)
-		return err
+		slog.ErrorContext(ctx, "failed to execute query", logger.Err(err))
+		return nil, err
+	}
+
+	// Блокировка строки чата упорядочивает параллельные отправки в чат до изменения счетчиков непрочитанных
+	err = r.touchChatLastMessage(ctx, chat.ChatID, message.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if replyTo != nil {
+		err = r.touchThreadRoot(ctx, *replyTo, message.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = r.incrementUnread(ctx, chat.ChatID, chat.From)
+	if err != nil {
+		return nil, err
+	}
+
+	// Отправитель прочитал чат до своего сообщения
+	err = r.MarkRead(ctx, chat.ChatID, chat.From, message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.notifyMessage(ctx, message)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to notify about message", logger.Err(err))
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// IsChatExists проверяет, существует ли чат с указанным ID
+func (r *repo) IsChatExists(ctx context.Context, chatID int64) (bool, error) {
+	builderChatExists := sq.Select("1").
+		From(tableChatName).
+		Where(sq.Eq{tableChatIDColumn: chatID}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderChatExists.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build chat exists query", logger.Err(err))
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.IsChatExists",
+		QueryRaw: query,
+	}
+
+	var exists bool
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&exists)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute chat exists query", logger.Err(err))
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// IsChatMember проверяет, состоит ли пользователь в указанном чате
+func (r *repo) IsChatMember(ctx context.Context, chatID int64, userID string) (bool, error) {
+	builderChatMember := sq.Select("1").
+		From(tableChatUsersName).
+		Where(sq.Eq{
+			tableChatUsersChatIDColumn: chatID,
+			tableChatUsersUserIDColumn: userID,
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderChatMember.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build chat member query", logger.Err(err))
+		return false, err
+	}
+
+	q := db.Query{
+		Name:     "chat_users_repository.IsChatMember",
+		QueryRaw: query,
+	}
+
+	var isMember bool
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&isMember)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute chat member query", logger.Err(err))
+		return false, err
+	}
+
+	return isMember, nil
+}
+
+// selectMessages строит выборку сообщений со всеми полями модели
+func selectMessages() sq.SelectBuilder {
+	return sq.Select(
+		tableMessagesIDColumn,
+		tableMessagesChatIDColumn,
+		tableMessagesUserIDColumn,
+		tableMessagesMessageColumn,
+		tableMessagesCreatedAtColumn,
+		tableMessagesEditedAtColumn,
+		tableMessagesDeletedAtColumn,
+		tableMessagesReplyToColumn,
+		tableMessagesReplyCountColumn,
+		tableMessagesLastReplyAtColumn,
+		tableMessagesPinnedAtColumn,
+	).
+		From(tableMessagesName)
+}
+
+// GetMessage возвращает сообщение по его ID или nil, если сообщение не найдено
+func (r *repo) GetMessage(ctx context.Context, id int64) (*model.Message, error) {
+	builderGetMessage := selectMessages().
+		Where(sq.Eq{tableMessagesIDColumn: id}).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builderGetMessage.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build get message query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.GetMessage",
+		QueryRaw: query,
+	}
+
+	var message modelRepo.Message
+	err = r.db.DB().ScanOneContext(ctx, &message, q, args...)
+	if pgxscan.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute get message query", logger.Err(err))
+		return nil, err
+	}
+
+	return converter.ToMessageFromRepo(&message), nil
+}
+
+// ListMessages возвращает историю сообщений чата от новых к старым.
+// История читается из реплики, для чтения только что отправленных сообщений контекст помечается db.WithPrimary
+func (r *repo) ListMessages(ctx context.Context, filter *model.MessageListFilter) ([]*model.Message, error) {
+	builderListMessages := selectMessages().
+		Where(sq.Eq{tableMessagesChatIDColumn: filter.ChatID}).
+		OrderBy(tableMessagesCreatedAtColumn+" DESC", tableMessagesIDColumn+" DESC").
+		Limit(filter.Limit).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Cursor != nil {
+		builderListMessages = builderListMessages.Where(
+			sq.Expr("("+tableMessagesCreatedAtColumn+", "+tableMessagesIDColumn+") < (?, ?)",
+				filter.Cursor.CreatedAt, filter.Cursor.ID),
+		)
+	}
+
+	if filter.Before != nil {
+		builderListMessages = builderListMessages.Where(sq.Lt{tableMessagesCreatedAtColumn: *filter.Before})
+	}
+
+	if filter.After != nil {
+		builderListMessages = builderListMessages.Where(sq.Gt{tableMessagesCreatedAtColumn: *filter.After})
+	}
+
+	query, args, err := builderListMessages.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build list messages query", logger.Err(err))
+		return nil, err
+	}
+
+	q := db.Query{
+		Name:     "chat_repository.ListMessages",
+		QueryRaw: query,
+	}
+
+	var messages []*modelRepo.Message
+	err = r.db.Replica().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to execute list messages query", logger.Err(err))
+		return nil, err
 	}
 
-	return err
+	return converter.ToMessagesFromRepo(messages), nil
 }
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	chatRepository "github.com/ipv02/chat-server/internal/repository/chat"
+)
+
+func TestDeleteExpiredIdempotencyKeys(t *testing.T) {
+	t.Parallel()
+
+	errConnClosed := errors.New("conn closed")
+
+	tests := []struct {
+		name      string
+		batchSize uint64
+		// batches результаты запросов по порядку, пустой результат - ошибка запроса
+		batches []string
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:      "nothing expired case",
+			batchSize: 2,
+			batches:   []string{"DELETE 0"},
+			want:      0,
+		},
+		{
+			name:      "several batches case",
+			batchSize: 2,
+			batches:   []string{"DELETE 2", "DELETE 2", "DELETE 1"},
+			want:      5,
+		},
+		{
+			name:      "full last batch case",
+			batchSize: 2,
+			batches:   []string{"DELETE 2", "DELETE 0"},
+			want:      2,
+		},
+		{
+			name:      "query error case",
+			batchSize: 2,
+			batches:   []string{"DELETE 2", ""},
+			want:      2,
+			wantErr:   true,
+		},
+		{
+			name:      "zero batch size case",
+			batchSize: 0,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			dbMock := dbMocks.NewDBMock(mc)
+
+			var calls int
+			if len(tt.batches) > 0 {
+				dbMock.ExecContextMock.Set(func(_ context.Context, _ db.Query, _ ...interface{}) (pgconn.CommandTag, error) {
+					batch := tt.batches[calls]
+					calls++
+
+					if batch == "" {
+						return nil, errConnClosed
+					}
+
+					return pgconn.CommandTag(batch), nil
+				})
+			}
+
+			client := dbMocks.NewClientMock(mc).DBMock.Optional().Return(dbMock)
+
+			deleted, err := chatRepository.NewRepository(client).DeleteExpiredIdempotencyKeys(context.Background(), tt.batchSize)
+
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.want, deleted)
+			require.Equal(t, len(tt.batches), calls)
+		})
+	}
+}
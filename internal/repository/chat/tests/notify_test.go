@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	chatRepository "github.com/ipv02/chat-server/internal/repository/chat"
+	modelRepo "github.com/ipv02/chat-server/internal/repository/chat/model"
+)
+
+func TestListenMessages(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		payloads []string
+		// partial уведомление без текста, сообщение дочитывается из БД
+		partial bool
+		// stored сообщение, которое дочитывается из БД для частичного уведомления, nil - сообщение удалено
+		stored *modelRepo.Message
+		want   []*model.Message
+	}{
+		{
+			name:     "full notification case",
+			payloads: []string{`{"id":1,"chat_id":10,"from":"u1","text":"hello","created_at":"2024-11-01T12:00:00Z"}`},
+			want:     []*model.Message{{ID: 1, ChatID: 10, Text: "hello", CreatedAt: createdAt}},
+		},
+		{
+			name: "malformed payload skipped case",
+			payloads: []string{
+				`not json`,
+				`{"id":"1"}`,
+				`{"id":2,"chat_id":10,"from":"u1","text":"after","created_at":"2024-11-01T12:00:00Z"}`,
+			},
+			want: []*model.Message{{ID: 2, ChatID: 10, Text: "after", CreatedAt: createdAt}},
+		},
+		{
+			name:     "partial notification case",
+			payloads: []string{`{"id":3,"chat_id":10,"created_at":"2024-11-01T12:00:00Z","partial":true}`},
+			partial:  true,
+			stored:   &modelRepo.Message{ID: 3, ChatID: 10, UserID: 7, Message: "long text", CreatedAt: createdAt},
+			want:     []*model.Message{{ID: 3, ChatID: 10, Text: "long text", CreatedAt: createdAt}},
+		},
+		{
+			name:     "partial notification of deleted chat case",
+			payloads: []string{`{"id":4,"chat_id":10,"created_at":"2024-11-01T12:00:00Z","partial":true}`},
+			partial:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			dbMock := dbMocks.NewDBMock(mc).ListenMock.Set(func(_ context.Context, _ string, handler func(payload string)) error {
+				for _, payload := range tt.payloads {
+					handler(payload)
+				}
+
+				return nil
+			})
+
+			if tt.partial {
+				dbMock.ScanOneContextMock.Set(func(_ context.Context, dest interface{}, _ db.Query, _ ...interface{}) error {
+					if tt.stored == nil {
+						return pgx.ErrNoRows
+					}
+
+					*dest.(*modelRepo.Message) = *tt.stored
+
+					return nil
+				})
+			}
+
+			client := dbMocks.NewClientMock(mc).DBMock.Return(dbMock)
+
+			var got []*model.Message
+			err := chatRepository.NewRepository(client).ListenMessages(context.Background(), func(message *model.Message) {
+				got = append(got, message)
+			})
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].ID, got[i].ID)
+				require.Equal(t, tt.want[i].ChatID, got[i].ChatID)
+				require.Equal(t, tt.want[i].Text, got[i].Text)
+				require.True(t, tt.want[i].CreatedAt.Equal(got[i].CreatedAt))
+			}
+		})
+	}
+}
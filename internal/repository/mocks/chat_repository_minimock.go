@@ -19,6 +19,20 @@ type ChatRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddChatMembers          func(ctx context.Context, chatID int64, usersID []string) (err error)
+	funcAddChatMembersOrigin    string
+	inspectFuncAddChatMembers   func(ctx context.Context, chatID int64, usersID []string)
+	afterAddChatMembersCounter  uint64
+	beforeAddChatMembersCounter uint64
+	AddChatMembersMock          mChatRepositoryMockAddChatMembers
+
+	funcCountChatMembers          func(ctx context.Context, chatID int64) (i1 int64, err error)
+	funcCountChatMembersOrigin    string
+	inspectFuncCountChatMembers   func(ctx context.Context, chatID int64)
+	afterCountChatMembersCounter  uint64
+	beforeCountChatMembersCounter uint64
+	CountChatMembersMock          mChatRepositoryMockCountChatMembers
+
 	funcCreateChat          func(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error)
 	funcCreateChatOrigin    string
 	inspectFuncCreateChat   func(ctx context.Context, chat *model.ChatCreate)
@@ -33,12 +47,166 @@ type ChatRepositoryMock struct {
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatRepositoryMockDeleteChat
 
-	funcSendMessage          func(ctx context.Context, chat *model.ChatSendMessage) (err error)
+	funcDeleteExpiredIdempotencyKeys          func(ctx context.Context, batchSize uint64) (i1 int64, err error)
+	funcDeleteExpiredIdempotencyKeysOrigin    string
+	inspectFuncDeleteExpiredIdempotencyKeys   func(ctx context.Context, batchSize uint64)
+	afterDeleteExpiredIdempotencyKeysCounter  uint64
+	beforeDeleteExpiredIdempotencyKeysCounter uint64
+	DeleteExpiredIdempotencyKeysMock          mChatRepositoryMockDeleteExpiredIdempotencyKeys
+
+	funcDeleteMessage          func(ctx context.Context, message *model.Message) (err error)
+	funcDeleteMessageOrigin    string
+	inspectFuncDeleteMessage   func(ctx context.Context, message *model.Message)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatRepositoryMockDeleteMessage
+
+	funcEditMessage          func(ctx context.Context, id int64, text string) (err error)
+	funcEditMessageOrigin    string
+	inspectFuncEditMessage   func(ctx context.Context, id int64, text string)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mChatRepositoryMockEditMessage
+
+	funcGetChat          func(ctx context.Context, id int64) (cp1 *model.Chat, err error)
+	funcGetChatOrigin    string
+	inspectFuncGetChat   func(ctx context.Context, id int64)
+	afterGetChatCounter  uint64
+	beforeGetChatCounter uint64
+	GetChatMock          mChatRepositoryMockGetChat
+
+	funcGetIdempotencyResult          func(ctx context.Context, key *model.IdempotencyKey) (i1 int64, err error)
+	funcGetIdempotencyResultOrigin    string
+	inspectFuncGetIdempotencyResult   func(ctx context.Context, key *model.IdempotencyKey)
+	afterGetIdempotencyResultCounter  uint64
+	beforeGetIdempotencyResultCounter uint64
+	GetIdempotencyResultMock          mChatRepositoryMockGetIdempotencyResult
+
+	funcGetMemberRole          func(ctx context.Context, chatID int64, userID string) (r1 model.Role, err error)
+	funcGetMemberRoleOrigin    string
+	inspectFuncGetMemberRole   func(ctx context.Context, chatID int64, userID string)
+	afterGetMemberRoleCounter  uint64
+	beforeGetMemberRoleCounter uint64
+	GetMemberRoleMock          mChatRepositoryMockGetMemberRole
+
+	funcGetMessage          func(ctx context.Context, id int64) (mp1 *model.Message, err error)
+	funcGetMessageOrigin    string
+	inspectFuncGetMessage   func(ctx context.Context, id int64)
+	afterGetMessageCounter  uint64
+	beforeGetMessageCounter uint64
+	GetMessageMock          mChatRepositoryMockGetMessage
+
+	funcIsChatExists          func(ctx context.Context, chatID int64) (b1 bool, err error)
+	funcIsChatExistsOrigin    string
+	inspectFuncIsChatExists   func(ctx context.Context, chatID int64)
+	afterIsChatExistsCounter  uint64
+	beforeIsChatExistsCounter uint64
+	IsChatExistsMock          mChatRepositoryMockIsChatExists
+
+	funcIsChatMember          func(ctx context.Context, chatID int64, userID string) (b1 bool, err error)
+	funcIsChatMemberOrigin    string
+	inspectFuncIsChatMember   func(ctx context.Context, chatID int64, userID string)
+	afterIsChatMemberCounter  uint64
+	beforeIsChatMemberCounter uint64
+	IsChatMemberMock          mChatRepositoryMockIsChatMember
+
+	funcListChatMembers          func(ctx context.Context, filter *model.MemberListFilter) (mpa1 []*model.Member, err error)
+	funcListChatMembersOrigin    string
+	inspectFuncListChatMembers   func(ctx context.Context, filter *model.MemberListFilter)
+	afterListChatMembersCounter  uint64
+	beforeListChatMembersCounter uint64
+	ListChatMembersMock          mChatRepositoryMockListChatMembers
+
+	funcListMessages          func(ctx context.Context, filter *model.MessageListFilter) (mpa1 []*model.Message, err error)
+	funcListMessagesOrigin    string
+	inspectFuncListMessages   func(ctx context.Context, filter *model.MessageListFilter)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mChatRepositoryMockListMessages
+
+	funcListReadReceipts          func(ctx context.Context, message *model.Message) (rpa1 []*model.ReadReceipt, err error)
+	funcListReadReceiptsOrigin    string
+	inspectFuncListReadReceipts   func(ctx context.Context, message *model.Message)
+	afterListReadReceiptsCounter  uint64
+	beforeListReadReceiptsCounter uint64
+	ListReadReceiptsMock          mChatRepositoryMockListReadReceipts
+
+	funcListThreadReplies          func(ctx context.Context, filter *model.ThreadFilter) (mpa1 []*model.Message, err error)
+	funcListThreadRepliesOrigin    string
+	inspectFuncListThreadReplies   func(ctx context.Context, filter *model.ThreadFilter)
+	afterListThreadRepliesCounter  uint64
+	beforeListThreadRepliesCounter uint64
+	ListThreadRepliesMock          mChatRepositoryMockListThreadReplies
+
+	funcListUserChats          func(ctx context.Context, filter *model.ChatListFilter) (cpa1 []*model.Chat, err error)
+	funcListUserChatsOrigin    string
+	inspectFuncListUserChats   func(ctx context.Context, filter *model.ChatListFilter)
+	afterListUserChatsCounter  uint64
+	beforeListUserChatsCounter uint64
+	ListUserChatsMock          mChatRepositoryMockListUserChats
+
+	funcListenMessages          func(ctx context.Context, handler func(message *model.Message)) (err error)
+	funcListenMessagesOrigin    string
+	inspectFuncListenMessages   func(ctx context.Context, handler func(message *model.Message))
+	afterListenMessagesCounter  uint64
+	beforeListenMessagesCounter uint64
+	ListenMessagesMock          mChatRepositoryMockListenMessages
+
+	funcLockMessage          func(ctx context.Context, id int64) (mp1 *model.Message, err error)
+	funcLockMessageOrigin    string
+	inspectFuncLockMessage   func(ctx context.Context, id int64)
+	afterLockMessageCounter  uint64
+	beforeLockMessageCounter uint64
+	LockMessageMock          mChatRepositoryMockLockMessage
+
+	funcMarkRead          func(ctx context.Context, chatID int64, userID string, message *model.Message) (err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, chatID int64, userID string, message *model.Message)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatRepositoryMockMarkRead
+
+	funcPinMessage          func(ctx context.Context, id int64, pinned bool) (err error)
+	funcPinMessageOrigin    string
+	inspectFuncPinMessage   func(ctx context.Context, id int64, pinned bool)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mChatRepositoryMockPinMessage
+
+	funcRemoveChatMembers          func(ctx context.Context, chatID int64, usersID []string) (err error)
+	funcRemoveChatMembersOrigin    string
+	inspectFuncRemoveChatMembers   func(ctx context.Context, chatID int64, usersID []string)
+	afterRemoveChatMembersCounter  uint64
+	beforeRemoveChatMembersCounter uint64
+	RemoveChatMembersMock          mChatRepositoryMockRemoveChatMembers
+
+	funcReserveIdempotencyKey          func(ctx context.Context, key *model.IdempotencyKey) (b1 bool, err error)
+	funcReserveIdempotencyKeyOrigin    string
+	inspectFuncReserveIdempotencyKey   func(ctx context.Context, key *model.IdempotencyKey)
+	afterReserveIdempotencyKeyCounter  uint64
+	beforeReserveIdempotencyKeyCounter uint64
+	ReserveIdempotencyKeyMock          mChatRepositoryMockReserveIdempotencyKey
+
+	funcSaveIdempotencyResult          func(ctx context.Context, key *model.IdempotencyKey, resultID int64) (err error)
+	funcSaveIdempotencyResultOrigin    string
+	inspectFuncSaveIdempotencyResult   func(ctx context.Context, key *model.IdempotencyKey, resultID int64)
+	afterSaveIdempotencyResultCounter  uint64
+	beforeSaveIdempotencyResultCounter uint64
+	SaveIdempotencyResultMock          mChatRepositoryMockSaveIdempotencyResult
+
+	funcSendMessage          func(ctx context.Context, chat *model.ChatSendMessage) (mp1 *model.Message, err error)
 	funcSendMessageOrigin    string
 	inspectFuncSendMessage   func(ctx context.Context, chat *model.ChatSendMessage)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatRepositoryMockSendMessage
+
+	funcSetMemberRole          func(ctx context.Context, chatID int64, userID string, role model.Role) (err error)
+	funcSetMemberRoleOrigin    string
+	inspectFuncSetMemberRole   func(ctx context.Context, chatID int64, userID string, role model.Role)
+	afterSetMemberRoleCounter  uint64
+	beforeSetMemberRoleCounter uint64
+	SetMemberRoleMock          mChatRepositoryMockSetMemberRole
 }
 
 // NewChatRepositoryMock returns a mock for mm_repository.ChatRepository
@@ -49,67 +217,141 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddChatMembersMock = mChatRepositoryMockAddChatMembers{mock: m}
+	m.AddChatMembersMock.callArgs = []*ChatRepositoryMockAddChatMembersParams{}
+
+	m.CountChatMembersMock = mChatRepositoryMockCountChatMembers{mock: m}
+	m.CountChatMembersMock.callArgs = []*ChatRepositoryMockCountChatMembersParams{}
+
 	m.CreateChatMock = mChatRepositoryMockCreateChat{mock: m}
 	m.CreateChatMock.callArgs = []*ChatRepositoryMockCreateChatParams{}
 
 	m.DeleteChatMock = mChatRepositoryMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatRepositoryMockDeleteChatParams{}
 
+	m.DeleteExpiredIdempotencyKeysMock = mChatRepositoryMockDeleteExpiredIdempotencyKeys{mock: m}
+	m.DeleteExpiredIdempotencyKeysMock.callArgs = []*ChatRepositoryMockDeleteExpiredIdempotencyKeysParams{}
+
+	m.DeleteMessageMock = mChatRepositoryMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatRepositoryMockDeleteMessageParams{}
+
+	m.EditMessageMock = mChatRepositoryMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*ChatRepositoryMockEditMessageParams{}
+
+	m.GetChatMock = mChatRepositoryMockGetChat{mock: m}
+	m.GetChatMock.callArgs = []*ChatRepositoryMockGetChatParams{}
+
+	m.GetIdempotencyResultMock = mChatRepositoryMockGetIdempotencyResult{mock: m}
+	m.GetIdempotencyResultMock.callArgs = []*ChatRepositoryMockGetIdempotencyResultParams{}
+
+	m.GetMemberRoleMock = mChatRepositoryMockGetMemberRole{mock: m}
+	m.GetMemberRoleMock.callArgs = []*ChatRepositoryMockGetMemberRoleParams{}
+
+	m.GetMessageMock = mChatRepositoryMockGetMessage{mock: m}
+	m.GetMessageMock.callArgs = []*ChatRepositoryMockGetMessageParams{}
+
+	m.IsChatExistsMock = mChatRepositoryMockIsChatExists{mock: m}
+	m.IsChatExistsMock.callArgs = []*ChatRepositoryMockIsChatExistsParams{}
+
+	m.IsChatMemberMock = mChatRepositoryMockIsChatMember{mock: m}
+	m.IsChatMemberMock.callArgs = []*ChatRepositoryMockIsChatMemberParams{}
+
+	m.ListChatMembersMock = mChatRepositoryMockListChatMembers{mock: m}
+	m.ListChatMembersMock.callArgs = []*ChatRepositoryMockListChatMembersParams{}
+
+	m.ListMessagesMock = mChatRepositoryMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*ChatRepositoryMockListMessagesParams{}
+
+	m.ListReadReceiptsMock = mChatRepositoryMockListReadReceipts{mock: m}
+	m.ListReadReceiptsMock.callArgs = []*ChatRepositoryMockListReadReceiptsParams{}
+
+	m.ListThreadRepliesMock = mChatRepositoryMockListThreadReplies{mock: m}
+	m.ListThreadRepliesMock.callArgs = []*ChatRepositoryMockListThreadRepliesParams{}
+
+	m.ListUserChatsMock = mChatRepositoryMockListUserChats{mock: m}
+	m.ListUserChatsMock.callArgs = []*ChatRepositoryMockListUserChatsParams{}
+
+	m.ListenMessagesMock = mChatRepositoryMockListenMessages{mock: m}
+	m.ListenMessagesMock.callArgs = []*ChatRepositoryMockListenMessagesParams{}
+
+	m.LockMessageMock = mChatRepositoryMockLockMessage{mock: m}
+	m.LockMessageMock.callArgs = []*ChatRepositoryMockLockMessageParams{}
+
+	m.MarkReadMock = mChatRepositoryMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatRepositoryMockMarkReadParams{}
+
+	m.PinMessageMock = mChatRepositoryMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*ChatRepositoryMockPinMessageParams{}
+
+	m.RemoveChatMembersMock = mChatRepositoryMockRemoveChatMembers{mock: m}
+	m.RemoveChatMembersMock.callArgs = []*ChatRepositoryMockRemoveChatMembersParams{}
+
+	m.ReserveIdempotencyKeyMock = mChatRepositoryMockReserveIdempotencyKey{mock: m}
+	m.ReserveIdempotencyKeyMock.callArgs = []*ChatRepositoryMockReserveIdempotencyKeyParams{}
+
+	m.SaveIdempotencyResultMock = mChatRepositoryMockSaveIdempotencyResult{mock: m}
+	m.SaveIdempotencyResultMock.callArgs = []*ChatRepositoryMockSaveIdempotencyResultParams{}
+
 	m.SendMessageMock = mChatRepositoryMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatRepositoryMockSendMessageParams{}
 
+	m.SetMemberRoleMock = mChatRepositoryMockSetMemberRole{mock: m}
+	m.SetMemberRoleMock.callArgs = []*ChatRepositoryMockSetMemberRoleParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatRepositoryMockCreateChat struct {
+type mChatRepositoryMockAddChatMembers struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockCreateChatExpectation
-	expectations       []*ChatRepositoryMockCreateChatExpectation
+	defaultExpectation *ChatRepositoryMockAddChatMembersExpectation
+	expectations       []*ChatRepositoryMockAddChatMembersExpectation
 
-	callArgs []*ChatRepositoryMockCreateChatParams
+	callArgs []*ChatRepositoryMockAddChatMembersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockCreateChatExpectation specifies expectation struct of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatExpectation struct {
+// ChatRepositoryMockAddChatMembersExpectation specifies expectation struct of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockCreateChatParams
-	paramPtrs          *ChatRepositoryMockCreateChatParamPtrs
-	expectationOrigins ChatRepositoryMockCreateChatExpectationOrigins
-	results            *ChatRepositoryMockCreateChatResults
+	params             *ChatRepositoryMockAddChatMembersParams
+	paramPtrs          *ChatRepositoryMockAddChatMembersParamPtrs
+	expectationOrigins ChatRepositoryMockAddChatMembersExpectationOrigins
+	results            *ChatRepositoryMockAddChatMembersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockCreateChatParams contains parameters of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatParams struct {
-	ctx  context.Context
-	chat *model.ChatCreate
+// ChatRepositoryMockAddChatMembersParams contains parameters of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersParams struct {
+	ctx     context.Context
+	chatID  int64
+	usersID []string
 }
 
-// ChatRepositoryMockCreateChatParamPtrs contains pointers to parameters of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatParamPtrs struct {
-	ctx  *context.Context
-	chat **model.ChatCreate
+// ChatRepositoryMockAddChatMembersParamPtrs contains pointers to parameters of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	usersID *[]string
 }
 
-// ChatRepositoryMockCreateChatResults contains results of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatResults struct {
-	i1  int64
+// ChatRepositoryMockAddChatMembersResults contains results of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersResults struct {
 	err error
 }
 
-// ChatRepositoryMockCreateChatOrigins contains origins of expectations of the ChatRepository.CreateChat
-type ChatRepositoryMockCreateChatExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originChat string
+// ChatRepositoryMockAddChatMembersOrigins contains origins of expectations of the ChatRepository.AddChatMembers
+type ChatRepositoryMockAddChatMembersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChatID  string
+	originUsersID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -117,341 +359,370 @@ type ChatRepositoryMockCreateChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateChat *mChatRepositoryMockCreateChat) Optional() *mChatRepositoryMockCreateChat {
-	mmCreateChat.optional = true
-	return mmCreateChat
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Optional() *mChatRepositoryMockAddChatMembers {
+	mmAddChatMembers.optional = true
+	return mmAddChatMembers
 }
 
-// Expect sets up expected params for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Expect(ctx context.Context, chat *model.ChatCreate) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// Expect sets up expected params for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Expect(ctx context.Context, chatID int64, usersID []string) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by ExpectParams functions")
+	if mmAddChatMembers.defaultExpectation.paramPtrs != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by ExpectParams functions")
 	}
 
-	mmCreateChat.defaultExpectation.params = &ChatRepositoryMockCreateChatParams{ctx, chat}
-	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateChat.expectations {
-		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
-			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+	mmAddChatMembers.defaultExpectation.params = &ChatRepositoryMockAddChatMembersParams{ctx, chatID, usersID}
+	mmAddChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddChatMembers.expectations {
+		if minimock.Equal(e.params, mmAddChatMembers.defaultExpectation.params) {
+			mmAddChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddChatMembers.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateChat
+	return mmAddChatMembers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddChatMembersParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmAddChatMembers
 }
 
-// ExpectChatParam2 sets up expected param chat for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectChatParam2(chat *model.ChatCreate) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddChatMembersParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
-	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+	mmAddChatMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmAddChatMembers
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Inspect(f func(ctx context.Context, chat *model.ChatCreate)) *mChatRepositoryMockCreateChat {
-	if mmCreateChat.mock.inspectFuncCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CreateChat")
+// ExpectUsersIDParam3 sets up expected param usersID for ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) ExpectUsersIDParam3(usersID []string) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	mmCreateChat.mock.inspectFuncCreateChat = f
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{}
+	}
 
-	return mmCreateChat
+	if mmAddChatMembers.defaultExpectation.params != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Expect")
+	}
+
+	if mmAddChatMembers.defaultExpectation.paramPtrs == nil {
+		mmAddChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddChatMembersParamPtrs{}
+	}
+	mmAddChatMembers.defaultExpectation.paramPtrs.usersID = &usersID
+	mmAddChatMembers.defaultExpectation.expectationOrigins.originUsersID = minimock.CallerInfo(1)
+
+	return mmAddChatMembers
 }
 
-// Return sets up results that will be returned by ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Return(i1 int64, err error) *ChatRepositoryMock {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Inspect(f func(ctx context.Context, chatID int64, usersID []string)) *mChatRepositoryMockAddChatMembers {
+	if mmAddChatMembers.mock.inspectFuncAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddChatMembers")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{mock: mmCreateChat.mock}
+	mmAddChatMembers.mock.inspectFuncAddChatMembers = f
+
+	return mmAddChatMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.AddChatMembers
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Return(err error) *ChatRepositoryMock {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
-	mmCreateChat.defaultExpectation.results = &ChatRepositoryMockCreateChatResults{i1, err}
-	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+
+	if mmAddChatMembers.defaultExpectation == nil {
+		mmAddChatMembers.defaultExpectation = &ChatRepositoryMockAddChatMembersExpectation{mock: mmAddChatMembers.mock}
+	}
+	mmAddChatMembers.defaultExpectation.results = &ChatRepositoryMockAddChatMembersResults{err}
+	mmAddChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers.mock
 }
 
-// Set uses given function f to mock the ChatRepository.CreateChat method
-func (mmCreateChat *mChatRepositoryMockCreateChat) Set(f func(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error)) *ChatRepositoryMock {
-	if mmCreateChat.defaultExpectation != nil {
-		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CreateChat method")
+// Set uses given function f to mock the ChatRepository.AddChatMembers method
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Set(f func(ctx context.Context, chatID int64, usersID []string) (err error)) *ChatRepositoryMock {
+	if mmAddChatMembers.defaultExpectation != nil {
+		mmAddChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddChatMembers method")
 	}
 
-	if len(mmCreateChat.expectations) > 0 {
-		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CreateChat method")
+	if len(mmAddChatMembers.expectations) > 0 {
+		mmAddChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddChatMembers method")
 	}
 
-	mmCreateChat.mock.funcCreateChat = f
-	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+	mmAddChatMembers.mock.funcAddChatMembers = f
+	mmAddChatMembers.mock.funcAddChatMembersOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers.mock
 }
 
-// When sets expectation for the ChatRepository.CreateChat which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.AddChatMembers which will trigger the result defined by the following
 // Then helper
-func (mmCreateChat *mChatRepositoryMockCreateChat) When(ctx context.Context, chat *model.ChatCreate) *ChatRepositoryMockCreateChatExpectation {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) When(ctx context.Context, chatID int64, usersID []string) *ChatRepositoryMockAddChatMembersExpectation {
+	if mmAddChatMembers.mock.funcAddChatMembers != nil {
+		mmAddChatMembers.mock.t.Fatalf("ChatRepositoryMock.AddChatMembers mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockCreateChatExpectation{
-		mock:               mmCreateChat.mock,
-		params:             &ChatRepositoryMockCreateChatParams{ctx, chat},
-		expectationOrigins: ChatRepositoryMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockAddChatMembersExpectation{
+		mock:               mmAddChatMembers.mock,
+		params:             &ChatRepositoryMockAddChatMembersParams{ctx, chatID, usersID},
+		expectationOrigins: ChatRepositoryMockAddChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	mmAddChatMembers.expectations = append(mmAddChatMembers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.CreateChat return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockCreateChatExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockCreateChatResults{i1, err}
+// Then sets up ChatRepository.AddChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddChatMembersExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddChatMembersResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.CreateChat should be invoked
-func (mmCreateChat *mChatRepositoryMockCreateChat) Times(n uint64) *mChatRepositoryMockCreateChat {
+// Times sets number of times ChatRepository.AddChatMembers should be invoked
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Times(n uint64) *mChatRepositoryMockAddChatMembers {
 	if n == 0 {
-		mmCreateChat.mock.t.Fatalf("Times of ChatRepositoryMock.CreateChat mock can not be zero")
+		mmAddChatMembers.mock.t.Fatalf("Times of ChatRepositoryMock.AddChatMembers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
-	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateChat
+	mm_atomic.StoreUint64(&mmAddChatMembers.expectedInvocations, n)
+	mmAddChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddChatMembers
 }
 
-func (mmCreateChat *mChatRepositoryMockCreateChat) invocationsDone() bool {
-	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) invocationsDone() bool {
+	if len(mmAddChatMembers.expectations) == 0 && mmAddChatMembers.defaultExpectation == nil && mmAddChatMembers.mock.funcAddChatMembers == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddChatMembers.mock.afterAddChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddChatMembers.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateChat implements mm_repository.ChatRepository
-func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+// AddChatMembers implements mm_repository.ChatRepository
+func (mmAddChatMembers *ChatRepositoryMock) AddChatMembers(ctx context.Context, chatID int64, usersID []string) (err error) {
+	mm_atomic.AddUint64(&mmAddChatMembers.beforeAddChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddChatMembers.afterAddChatMembersCounter, 1)
 
-	mmCreateChat.t.Helper()
+	mmAddChatMembers.t.Helper()
 
-	if mmCreateChat.inspectFuncCreateChat != nil {
-		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	if mmAddChatMembers.inspectFuncAddChatMembers != nil {
+		mmAddChatMembers.inspectFuncAddChatMembers(ctx, chatID, usersID)
 	}
 
-	mm_params := ChatRepositoryMockCreateChatParams{ctx, chat}
+	mm_params := ChatRepositoryMockAddChatMembersParams{ctx, chatID, usersID}
 
 	// Record call args
-	mmCreateChat.CreateChatMock.mutex.Lock()
-	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
-	mmCreateChat.CreateChatMock.mutex.Unlock()
+	mmAddChatMembers.AddChatMembersMock.mutex.Lock()
+	mmAddChatMembers.AddChatMembersMock.callArgs = append(mmAddChatMembers.AddChatMembersMock.callArgs, &mm_params)
+	mmAddChatMembers.AddChatMembersMock.mutex.Unlock()
 
-	for _, e := range mmCreateChat.CreateChatMock.expectations {
+	for _, e := range mmAddChatMembers.AddChatMembersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+	if mmAddChatMembers.AddChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddChatMembers.AddChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddChatMembers.AddChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddChatMembers.AddChatMembersMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockCreateChatParams{ctx, chat}
+		mm_got := ChatRepositoryMockAddChatMembersParams{ctx, chatID, usersID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
-				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.usersID != nil && !minimock.Equal(*mm_want_ptrs.usersID, mm_got.usersID) {
+				mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameter usersID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.originUsersID, *mm_want_ptrs.usersID, mm_got.usersID, minimock.Diff(*mm_want_ptrs.usersID, mm_got.usersID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddChatMembers.t.Errorf("ChatRepositoryMock.AddChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddChatMembers.AddChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		mm_results := mmAddChatMembers.AddChatMembersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateChat.t.Fatal("No results are set for the ChatRepositoryMock.CreateChat")
+			mmAddChatMembers.t.Fatal("No results are set for the ChatRepositoryMock.AddChatMembers")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateChat.funcCreateChat != nil {
-		return mmCreateChat.funcCreateChat(ctx, chat)
+	if mmAddChatMembers.funcAddChatMembers != nil {
+		return mmAddChatMembers.funcAddChatMembers(ctx, chatID, usersID)
 	}
-	mmCreateChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CreateChat. %v %v", ctx, chat)
+	mmAddChatMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.AddChatMembers. %v %v %v", ctx, chatID, usersID)
 	return
 }
 
-// CreateChatAfterCounter returns a count of finished ChatRepositoryMock.CreateChat invocations
-func (mmCreateChat *ChatRepositoryMock) CreateChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+// AddChatMembersAfterCounter returns a count of finished ChatRepositoryMock.AddChatMembers invocations
+func (mmAddChatMembers *ChatRepositoryMock) AddChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChatMembers.afterAddChatMembersCounter)
 }
 
-// CreateChatBeforeCounter returns a count of ChatRepositoryMock.CreateChat invocations
-func (mmCreateChat *ChatRepositoryMock) CreateChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+// AddChatMembersBeforeCounter returns a count of ChatRepositoryMock.AddChatMembers invocations
+func (mmAddChatMembers *ChatRepositoryMock) AddChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChatMembers.beforeAddChatMembersCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.CreateChat.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddChatMembers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateChat *mChatRepositoryMockCreateChat) Calls() []*ChatRepositoryMockCreateChatParams {
-	mmCreateChat.mutex.RLock()
+func (mmAddChatMembers *mChatRepositoryMockAddChatMembers) Calls() []*ChatRepositoryMockAddChatMembersParams {
+	mmAddChatMembers.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockCreateChatParams, len(mmCreateChat.callArgs))
-	copy(argCopy, mmCreateChat.callArgs)
+	argCopy := make([]*ChatRepositoryMockAddChatMembersParams, len(mmAddChatMembers.callArgs))
+	copy(argCopy, mmAddChatMembers.callArgs)
 
-	mmCreateChat.mutex.RUnlock()
+	mmAddChatMembers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// MinimockAddChatMembersDone returns true if the count of the AddChatMembers invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockCreateChatDone() bool {
-	if m.CreateChatMock.optional {
+func (m *ChatRepositoryMock) MinimockAddChatMembersDone() bool {
+	if m.AddChatMembersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateChatMock.expectations {
+	for _, e := range m.AddChatMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateChatMock.invocationsDone()
+	return m.AddChatMembersMock.invocationsDone()
 }
 
-// MinimockCreateChatInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockCreateChatInspect() {
-	for _, e := range m.CreateChatMock.expectations {
+// MinimockAddChatMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddChatMembersInspect() {
+	for _, e := range m.AddChatMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	afterAddChatMembersCounter := mm_atomic.LoadUint64(&m.afterAddChatMembersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
-		if m.CreateChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+	if m.AddChatMembersMock.defaultExpectation != nil && afterAddChatMembersCounter < 1 {
+		if m.AddChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s", m.AddChatMembersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s with params: %#v", m.AddChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.AddChatMembersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	if m.funcAddChatMembers != nil && afterAddChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddChatMembers at\n%s", m.funcAddChatMembersOrigin)
 	}
 
-	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CreateChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	if !m.AddChatMembersMock.invocationsDone() && afterAddChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddChatMembersMock.expectedInvocations), m.AddChatMembersMock.expectedInvocationsOrigin, afterAddChatMembersCounter)
 	}
 }
 
-type mChatRepositoryMockDeleteChat struct {
+type mChatRepositoryMockCountChatMembers struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockDeleteChatExpectation
-	expectations       []*ChatRepositoryMockDeleteChatExpectation
+	defaultExpectation *ChatRepositoryMockCountChatMembersExpectation
+	expectations       []*ChatRepositoryMockCountChatMembersExpectation
 
-	callArgs []*ChatRepositoryMockDeleteChatParams
+	callArgs []*ChatRepositoryMockCountChatMembersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockDeleteChatExpectation specifies expectation struct of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatExpectation struct {
+// ChatRepositoryMockCountChatMembersExpectation specifies expectation struct of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockDeleteChatParams
-	paramPtrs          *ChatRepositoryMockDeleteChatParamPtrs
-	expectationOrigins ChatRepositoryMockDeleteChatExpectationOrigins
-	results            *ChatRepositoryMockDeleteChatResults
+	params             *ChatRepositoryMockCountChatMembersParams
+	paramPtrs          *ChatRepositoryMockCountChatMembersParamPtrs
+	expectationOrigins ChatRepositoryMockCountChatMembersExpectationOrigins
+	results            *ChatRepositoryMockCountChatMembersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockDeleteChatParams contains parameters of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatParams struct {
-	ctx context.Context
-	id  int64
+// ChatRepositoryMockCountChatMembersParams contains parameters of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersParams struct {
+	ctx    context.Context
+	chatID int64
 }
 
-// ChatRepositoryMockDeleteChatParamPtrs contains pointers to parameters of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// ChatRepositoryMockCountChatMembersParamPtrs contains pointers to parameters of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
 }
 
-// ChatRepositoryMockDeleteChatResults contains results of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatResults struct {
+// ChatRepositoryMockCountChatMembersResults contains results of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersResults struct {
+	i1  int64
 	err error
 }
 
-// ChatRepositoryMockDeleteChatOrigins contains origins of expectations of the ChatRepository.DeleteChat
-type ChatRepositoryMockDeleteChatExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// ChatRepositoryMockCountChatMembersOrigins contains origins of expectations of the ChatRepository.CountChatMembers
+type ChatRepositoryMockCountChatMembersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -459,292 +730,8421 @@ type ChatRepositoryMockDeleteChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Optional() *mChatRepositoryMockDeleteChat {
-	mmDeleteChat.optional = true
-	return mmDeleteChat
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Optional() *mChatRepositoryMockCountChatMembers {
+	mmCountChatMembers.optional = true
+	return mmCountChatMembers
 }
 
-// Expect sets up expected params for ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Expect(ctx context.Context, id int64) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// Expect sets up expected params for ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by ExpectParams functions")
+	if mmCountChatMembers.defaultExpectation.paramPtrs != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteChat.defaultExpectation.params = &ChatRepositoryMockDeleteChatParams{ctx, id}
-	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	mmCountChatMembers.defaultExpectation.params = &ChatRepositoryMockCountChatMembersParams{ctx, chatID}
+	mmCountChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountChatMembers.expectations {
+		if minimock.Equal(e.params, mmCountChatMembers.defaultExpectation.params) {
+			mmCountChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountChatMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmCountChatMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{}
+	}
+
+	if mmCountChatMembers.defaultExpectation.params != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Expect")
+	}
+
+	if mmCountChatMembers.defaultExpectation.paramPtrs == nil {
+		mmCountChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockCountChatMembersParamPtrs{}
+	}
+	mmCountChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountChatMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{}
+	}
+
+	if mmCountChatMembers.defaultExpectation.params != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Expect")
+	}
+
+	if mmCountChatMembers.defaultExpectation.paramPtrs == nil {
+		mmCountChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockCountChatMembersParamPtrs{}
+	}
+	mmCountChatMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmCountChatMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmCountChatMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockCountChatMembers {
+	if mmCountChatMembers.mock.inspectFuncCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CountChatMembers")
+	}
+
+	mmCountChatMembers.mock.inspectFuncCountChatMembers = f
+
+	return mmCountChatMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.CountChatMembers
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	if mmCountChatMembers.defaultExpectation == nil {
+		mmCountChatMembers.defaultExpectation = &ChatRepositoryMockCountChatMembersExpectation{mock: mmCountChatMembers.mock}
+	}
+	mmCountChatMembers.defaultExpectation.results = &ChatRepositoryMockCountChatMembersResults{i1, err}
+	mmCountChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountChatMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.CountChatMembers method
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Set(f func(ctx context.Context, chatID int64) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmCountChatMembers.defaultExpectation != nil {
+		mmCountChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CountChatMembers method")
+	}
+
+	if len(mmCountChatMembers.expectations) > 0 {
+		mmCountChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CountChatMembers method")
+	}
+
+	mmCountChatMembers.mock.funcCountChatMembers = f
+	mmCountChatMembers.mock.funcCountChatMembersOrigin = minimock.CallerInfo(1)
+	return mmCountChatMembers.mock
+}
+
+// When sets expectation for the ChatRepository.CountChatMembers which will trigger the result defined by the following
+// Then helper
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) When(ctx context.Context, chatID int64) *ChatRepositoryMockCountChatMembersExpectation {
+	if mmCountChatMembers.mock.funcCountChatMembers != nil {
+		mmCountChatMembers.mock.t.Fatalf("ChatRepositoryMock.CountChatMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCountChatMembersExpectation{
+		mock:               mmCountChatMembers.mock,
+		params:             &ChatRepositoryMockCountChatMembersParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockCountChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountChatMembers.expectations = append(mmCountChatMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.CountChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCountChatMembersExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCountChatMembersResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.CountChatMembers should be invoked
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Times(n uint64) *mChatRepositoryMockCountChatMembers {
+	if n == 0 {
+		mmCountChatMembers.mock.t.Fatalf("Times of ChatRepositoryMock.CountChatMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountChatMembers.expectedInvocations, n)
+	mmCountChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountChatMembers
+}
+
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) invocationsDone() bool {
+	if len(mmCountChatMembers.expectations) == 0 && mmCountChatMembers.defaultExpectation == nil && mmCountChatMembers.mock.funcCountChatMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountChatMembers.mock.afterCountChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountChatMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountChatMembers implements mm_repository.ChatRepository
+func (mmCountChatMembers *ChatRepositoryMock) CountChatMembers(ctx context.Context, chatID int64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountChatMembers.beforeCountChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountChatMembers.afterCountChatMembersCounter, 1)
+
+	mmCountChatMembers.t.Helper()
+
+	if mmCountChatMembers.inspectFuncCountChatMembers != nil {
+		mmCountChatMembers.inspectFuncCountChatMembers(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockCountChatMembersParams{ctx, chatID}
+
+	// Record call args
+	mmCountChatMembers.CountChatMembersMock.mutex.Lock()
+	mmCountChatMembers.CountChatMembersMock.callArgs = append(mmCountChatMembers.CountChatMembersMock.callArgs, &mm_params)
+	mmCountChatMembers.CountChatMembersMock.mutex.Unlock()
+
+	for _, e := range mmCountChatMembers.CountChatMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountChatMembers.CountChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountChatMembers.CountChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountChatMembers.CountChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmCountChatMembers.CountChatMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCountChatMembersParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountChatMembers.t.Errorf("ChatRepositoryMock.CountChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountChatMembers.CountChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCountChatMembers.t.Errorf("ChatRepositoryMock.CountChatMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountChatMembers.CountChatMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountChatMembers.t.Errorf("ChatRepositoryMock.CountChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountChatMembers.CountChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountChatMembers.CountChatMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountChatMembers.t.Fatal("No results are set for the ChatRepositoryMock.CountChatMembers")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountChatMembers.funcCountChatMembers != nil {
+		return mmCountChatMembers.funcCountChatMembers(ctx, chatID)
+	}
+	mmCountChatMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.CountChatMembers. %v %v", ctx, chatID)
+	return
+}
+
+// CountChatMembersAfterCounter returns a count of finished ChatRepositoryMock.CountChatMembers invocations
+func (mmCountChatMembers *ChatRepositoryMock) CountChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountChatMembers.afterCountChatMembersCounter)
+}
+
+// CountChatMembersBeforeCounter returns a count of ChatRepositoryMock.CountChatMembers invocations
+func (mmCountChatMembers *ChatRepositoryMock) CountChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountChatMembers.beforeCountChatMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.CountChatMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountChatMembers *mChatRepositoryMockCountChatMembers) Calls() []*ChatRepositoryMockCountChatMembersParams {
+	mmCountChatMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCountChatMembersParams, len(mmCountChatMembers.callArgs))
+	copy(argCopy, mmCountChatMembers.callArgs)
+
+	mmCountChatMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountChatMembersDone returns true if the count of the CountChatMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCountChatMembersDone() bool {
+	if m.CountChatMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountChatMembersMock.invocationsDone()
+}
+
+// MinimockCountChatMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCountChatMembersInspect() {
+	for _, e := range m.CountChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountChatMembersCounter := mm_atomic.LoadUint64(&m.afterCountChatMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountChatMembersMock.defaultExpectation != nil && afterCountChatMembersCounter < 1 {
+		if m.CountChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s", m.CountChatMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s with params: %#v", m.CountChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.CountChatMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountChatMembers != nil && afterCountChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.CountChatMembers at\n%s", m.funcCountChatMembersOrigin)
+	}
+
+	if !m.CountChatMembersMock.invocationsDone() && afterCountChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CountChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountChatMembersMock.expectedInvocations), m.CountChatMembersMock.expectedInvocationsOrigin, afterCountChatMembersCounter)
+	}
+}
+
+type mChatRepositoryMockCreateChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockCreateChatExpectation
+	expectations       []*ChatRepositoryMockCreateChatExpectation
+
+	callArgs []*ChatRepositoryMockCreateChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockCreateChatExpectation specifies expectation struct of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockCreateChatParams
+	paramPtrs          *ChatRepositoryMockCreateChatParamPtrs
+	expectationOrigins ChatRepositoryMockCreateChatExpectationOrigins
+	results            *ChatRepositoryMockCreateChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockCreateChatParams contains parameters of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatParams struct {
+	ctx  context.Context
+	chat *model.ChatCreate
+}
+
+// ChatRepositoryMockCreateChatParamPtrs contains pointers to parameters of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatParamPtrs struct {
+	ctx  *context.Context
+	chat **model.ChatCreate
+}
+
+// ChatRepositoryMockCreateChatResults contains results of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockCreateChatOrigins contains origins of expectations of the ChatRepository.CreateChat
+type ChatRepositoryMockCreateChatExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateChat *mChatRepositoryMockCreateChat) Optional() *mChatRepositoryMockCreateChat {
+	mmCreateChat.optional = true
+	return mmCreateChat
+}
+
+// Expect sets up expected params for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) Expect(ctx context.Context, chat *model.ChatCreate) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by ExpectParams functions")
+	}
+
+	mmCreateChat.defaultExpectation.params = &ChatRepositoryMockCreateChatParams{ctx, chat}
+	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateChat.expectations {
+		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
+			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectChatParam2(chat *model.ChatCreate) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
+	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) Inspect(f func(ctx context.Context, chat *model.ChatCreate)) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.inspectFuncCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CreateChat")
+	}
+
+	mmCreateChat.mock.inspectFuncCreateChat = f
+
+	return mmCreateChat
+}
+
+// Return sets up results that will be returned by ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{mock: mmCreateChat.mock}
+	}
+	mmCreateChat.defaultExpectation.results = &ChatRepositoryMockCreateChatResults{i1, err}
+	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.CreateChat method
+func (mmCreateChat *mChatRepositoryMockCreateChat) Set(f func(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmCreateChat.defaultExpectation != nil {
+		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CreateChat method")
+	}
+
+	if len(mmCreateChat.expectations) > 0 {
+		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CreateChat method")
+	}
+
+	mmCreateChat.mock.funcCreateChat = f
+	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// When sets expectation for the ChatRepository.CreateChat which will trigger the result defined by the following
+// Then helper
+func (mmCreateChat *mChatRepositoryMockCreateChat) When(ctx context.Context, chat *model.ChatCreate) *ChatRepositoryMockCreateChatExpectation {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCreateChatExpectation{
+		mock:               mmCreateChat.mock,
+		params:             &ChatRepositoryMockCreateChatParams{ctx, chat},
+		expectationOrigins: ChatRepositoryMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.CreateChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCreateChatExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCreateChatResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.CreateChat should be invoked
+func (mmCreateChat *mChatRepositoryMockCreateChat) Times(n uint64) *mChatRepositoryMockCreateChat {
+	if n == 0 {
+		mmCreateChat.mock.t.Fatalf("Times of ChatRepositoryMock.CreateChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
+	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateChat
+}
+
+func (mmCreateChat *mChatRepositoryMockCreateChat) invocationsDone() bool {
+	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateChat implements mm_repository.ChatRepository
+func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+
+	mmCreateChat.t.Helper()
+
+	if mmCreateChat.inspectFuncCreateChat != nil {
+		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	}
+
+	mm_params := ChatRepositoryMockCreateChatParams{ctx, chat}
+
+	// Record call args
+	mmCreateChat.CreateChatMock.mutex.Lock()
+	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
+	mmCreateChat.CreateChatMock.mutex.Unlock()
+
+	for _, e := range mmCreateChat.CreateChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCreateChatParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateChat.t.Fatal("No results are set for the ChatRepositoryMock.CreateChat")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateChat.funcCreateChat != nil {
+		return mmCreateChat.funcCreateChat(ctx, chat)
+	}
+	mmCreateChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CreateChat. %v %v", ctx, chat)
+	return
+}
+
+// CreateChatAfterCounter returns a count of finished ChatRepositoryMock.CreateChat invocations
+func (mmCreateChat *ChatRepositoryMock) CreateChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+}
+
+// CreateChatBeforeCounter returns a count of ChatRepositoryMock.CreateChat invocations
+func (mmCreateChat *ChatRepositoryMock) CreateChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.CreateChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateChat *mChatRepositoryMockCreateChat) Calls() []*ChatRepositoryMockCreateChatParams {
+	mmCreateChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCreateChatParams, len(mmCreateChat.callArgs))
+	copy(argCopy, mmCreateChat.callArgs)
+
+	mmCreateChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCreateChatDone() bool {
+	if m.CreateChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateChatMock.invocationsDone()
+}
+
+// MinimockCreateChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCreateChatInspect() {
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
+		if m.CreateChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	}
+
+	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CreateChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	}
+}
+
+type mChatRepositoryMockDeleteChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockDeleteChatExpectation
+	expectations       []*ChatRepositoryMockDeleteChatExpectation
+
+	callArgs []*ChatRepositoryMockDeleteChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockDeleteChatExpectation specifies expectation struct of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockDeleteChatParams
+	paramPtrs          *ChatRepositoryMockDeleteChatParamPtrs
+	expectationOrigins ChatRepositoryMockDeleteChatExpectationOrigins
+	results            *ChatRepositoryMockDeleteChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockDeleteChatParams contains parameters of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockDeleteChatParamPtrs contains pointers to parameters of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockDeleteChatResults contains results of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatResults struct {
+	err error
+}
+
+// ChatRepositoryMockDeleteChatOrigins contains origins of expectations of the ChatRepository.DeleteChat
+type ChatRepositoryMockDeleteChatExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Optional() *mChatRepositoryMockDeleteChat {
+	mmDeleteChat.optional = true
+	return mmDeleteChat
+}
+
+// Expect sets up expected params for ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Expect(ctx context.Context, id int64) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteChat.defaultExpectation.params = &ChatRepositoryMockDeleteChatParams{ctx, id}
+	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDeleteChat.expectations {
 		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
 			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteChat
+	return mmDeleteChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectIdParam2(id int64) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.id = &id
+	mmDeleteChat.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockDeleteChat {
+	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteChat")
+	}
+
+	mmDeleteChat.mock.inspectFuncDeleteChat = f
+
+	return mmDeleteChat
+}
+
+// Return sets up results that will be returned by ChatRepository.DeleteChat
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Return(err error) *ChatRepositoryMock {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	}
+	mmDeleteChat.defaultExpectation.results = &ChatRepositoryMockDeleteChatResults{err}
+	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.DeleteChat method
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Set(f func(ctx context.Context, id int64) (err error)) *ChatRepositoryMock {
+	if mmDeleteChat.defaultExpectation != nil {
+		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteChat method")
+	}
+
+	if len(mmDeleteChat.expectations) > 0 {
+		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteChat method")
+	}
+
+	mmDeleteChat.mock.funcDeleteChat = f
+	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// When sets expectation for the ChatRepository.DeleteChat which will trigger the result defined by the following
+// Then helper
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) When(ctx context.Context, id int64) *ChatRepositoryMockDeleteChatExpectation {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockDeleteChatExpectation{
+		mock:               mmDeleteChat.mock,
+		params:             &ChatRepositoryMockDeleteChatParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.DeleteChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockDeleteChatExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockDeleteChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.DeleteChat should be invoked
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Times(n uint64) *mChatRepositoryMockDeleteChat {
+	if n == 0 {
+		mmDeleteChat.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
+	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat
+}
+
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) invocationsDone() bool {
+	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteChat implements mm_repository.ChatRepository
+func (mmDeleteChat *ChatRepositoryMock) DeleteChat(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+
+	mmDeleteChat.t.Helper()
+
+	if mmDeleteChat.inspectFuncDeleteChat != nil {
+		mmDeleteChat.inspectFuncDeleteChat(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockDeleteChatParams{ctx, id}
+
+	// Record call args
+	mmDeleteChat.DeleteChatMock.mutex.Lock()
+	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
+	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+
+	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockDeleteChatParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteChat.t.Fatal("No results are set for the ChatRepositoryMock.DeleteChat")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteChat.funcDeleteChat != nil {
+		return mmDeleteChat.funcDeleteChat(ctx, id)
+	}
+	mmDeleteChat.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteChat. %v %v", ctx, id)
+	return
+}
+
+// DeleteChatAfterCounter returns a count of finished ChatRepositoryMock.DeleteChat invocations
+func (mmDeleteChat *ChatRepositoryMock) DeleteChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+}
+
+// DeleteChatBeforeCounter returns a count of ChatRepositoryMock.DeleteChat invocations
+func (mmDeleteChat *ChatRepositoryMock) DeleteChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteChat *mChatRepositoryMockDeleteChat) Calls() []*ChatRepositoryMockDeleteChatParams {
+	mmDeleteChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockDeleteChatParams, len(mmDeleteChat.callArgs))
+	copy(argCopy, mmDeleteChat.callArgs)
+
+	mmDeleteChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockDeleteChatDone() bool {
+	if m.DeleteChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteChatMock.invocationsDone()
+}
+
+// MinimockDeleteChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockDeleteChatInspect() {
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
+		if m.DeleteChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	}
+
+	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	}
+}
+
+type mChatRepositoryMockDeleteExpiredIdempotencyKeys struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation
+	expectations       []*ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation
+
+	callArgs []*ChatRepositoryMockDeleteExpiredIdempotencyKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation specifies expectation struct of the ChatRepository.DeleteExpiredIdempotencyKeys
+type ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockDeleteExpiredIdempotencyKeysParams
+	paramPtrs          *ChatRepositoryMockDeleteExpiredIdempotencyKeysParamPtrs
+	expectationOrigins ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectationOrigins
+	results            *ChatRepositoryMockDeleteExpiredIdempotencyKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockDeleteExpiredIdempotencyKeysParams contains parameters of the ChatRepository.DeleteExpiredIdempotencyKeys
+type ChatRepositoryMockDeleteExpiredIdempotencyKeysParams struct {
+	ctx       context.Context
+	batchSize uint64
+}
+
+// ChatRepositoryMockDeleteExpiredIdempotencyKeysParamPtrs contains pointers to parameters of the ChatRepository.DeleteExpiredIdempotencyKeys
+type ChatRepositoryMockDeleteExpiredIdempotencyKeysParamPtrs struct {
+	ctx       *context.Context
+	batchSize *uint64
+}
+
+// ChatRepositoryMockDeleteExpiredIdempotencyKeysResults contains results of the ChatRepository.DeleteExpiredIdempotencyKeys
+type ChatRepositoryMockDeleteExpiredIdempotencyKeysResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockDeleteExpiredIdempotencyKeysOrigins contains origins of expectations of the ChatRepository.DeleteExpiredIdempotencyKeys
+type ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originBatchSize string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) Optional() *mChatRepositoryMockDeleteExpiredIdempotencyKeys {
+	mmDeleteExpiredIdempotencyKeys.optional = true
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// Expect sets up expected params for ChatRepository.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) Expect(ctx context.Context, batchSize uint64) *mChatRepositoryMockDeleteExpiredIdempotencyKeys {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation = &ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation{}
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.params = &ChatRepositoryMockDeleteExpiredIdempotencyKeysParams{ctx, batchSize}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredIdempotencyKeys.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredIdempotencyKeys.defaultExpectation.params) {
+			mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredIdempotencyKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteExpiredIdempotencyKeys {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation = &ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation{}
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.params != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteExpiredIdempotencyKeysParamPtrs{}
+	}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// ExpectBatchSizeParam2 sets up expected param batchSize for ChatRepository.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) ExpectBatchSizeParam2(batchSize uint64) *mChatRepositoryMockDeleteExpiredIdempotencyKeys {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation = &ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation{}
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.params != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteExpiredIdempotencyKeysParamPtrs{}
+	}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs.batchSize = &batchSize
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.expectationOrigins.originBatchSize = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) Inspect(f func(ctx context.Context, batchSize uint64)) *mChatRepositoryMockDeleteExpiredIdempotencyKeys {
+	if mmDeleteExpiredIdempotencyKeys.mock.inspectFuncDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteExpiredIdempotencyKeys")
+	}
+
+	mmDeleteExpiredIdempotencyKeys.mock.inspectFuncDeleteExpiredIdempotencyKeys = f
+
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// Return sets up results that will be returned by ChatRepository.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation = &ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation{mock: mmDeleteExpiredIdempotencyKeys.mock}
+	}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.results = &ChatRepositoryMockDeleteExpiredIdempotencyKeysResults{i1, err}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredIdempotencyKeys.mock
+}
+
+// Set uses given function f to mock the ChatRepository.DeleteExpiredIdempotencyKeys method
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) Set(f func(ctx context.Context, batchSize uint64) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteExpiredIdempotencyKeys method")
+	}
+
+	if len(mmDeleteExpiredIdempotencyKeys.expectations) > 0 {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteExpiredIdempotencyKeys method")
+	}
+
+	mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys = f
+	mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeysOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredIdempotencyKeys.mock
+}
+
+// When sets expectation for the ChatRepository.DeleteExpiredIdempotencyKeys which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) When(ctx context.Context, batchSize uint64) *ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation{
+		mock:               mmDeleteExpiredIdempotencyKeys.mock,
+		params:             &ChatRepositoryMockDeleteExpiredIdempotencyKeysParams{ctx, batchSize},
+		expectationOrigins: ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredIdempotencyKeys.expectations = append(mmDeleteExpiredIdempotencyKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.DeleteExpiredIdempotencyKeys return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockDeleteExpiredIdempotencyKeysExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockDeleteExpiredIdempotencyKeysResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.DeleteExpiredIdempotencyKeys should be invoked
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) Times(n uint64) *mChatRepositoryMockDeleteExpiredIdempotencyKeys {
+	if n == 0 {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteExpiredIdempotencyKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredIdempotencyKeys.expectedInvocations, n)
+	mmDeleteExpiredIdempotencyKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) invocationsDone() bool {
+	if len(mmDeleteExpiredIdempotencyKeys.expectations) == 0 && mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil && mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.mock.afterDeleteExpiredIdempotencyKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredIdempotencyKeys implements mm_repository.ChatRepository
+func (mmDeleteExpiredIdempotencyKeys *ChatRepositoryMock) DeleteExpiredIdempotencyKeys(ctx context.Context, batchSize uint64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredIdempotencyKeys.beforeDeleteExpiredIdempotencyKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredIdempotencyKeys.afterDeleteExpiredIdempotencyKeysCounter, 1)
+
+	mmDeleteExpiredIdempotencyKeys.t.Helper()
+
+	if mmDeleteExpiredIdempotencyKeys.inspectFuncDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.inspectFuncDeleteExpiredIdempotencyKeys(ctx, batchSize)
+	}
+
+	mm_params := ChatRepositoryMockDeleteExpiredIdempotencyKeysParams{ctx, batchSize}
+
+	// Record call args
+	mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.mutex.Lock()
+	mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.callArgs = append(mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.callArgs, &mm_params)
+	mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockDeleteExpiredIdempotencyKeysParams{ctx, batchSize}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredIdempotencyKeys.t.Errorf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.batchSize != nil && !minimock.Equal(*mm_want_ptrs.batchSize, mm_got.batchSize) {
+				mmDeleteExpiredIdempotencyKeys.t.Errorf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys got unexpected parameter batchSize, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.originBatchSize, *mm_want_ptrs.batchSize, mm_got.batchSize, minimock.Diff(*mm_want_ptrs.batchSize, mm_got.batchSize))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredIdempotencyKeys.t.Errorf("ChatRepositoryMock.DeleteExpiredIdempotencyKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredIdempotencyKeys.t.Fatal("No results are set for the ChatRepositoryMock.DeleteExpiredIdempotencyKeys")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteExpiredIdempotencyKeys.funcDeleteExpiredIdempotencyKeys != nil {
+		return mmDeleteExpiredIdempotencyKeys.funcDeleteExpiredIdempotencyKeys(ctx, batchSize)
+	}
+	mmDeleteExpiredIdempotencyKeys.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteExpiredIdempotencyKeys. %v %v", ctx, batchSize)
+	return
+}
+
+// DeleteExpiredIdempotencyKeysAfterCounter returns a count of finished ChatRepositoryMock.DeleteExpiredIdempotencyKeys invocations
+func (mmDeleteExpiredIdempotencyKeys *ChatRepositoryMock) DeleteExpiredIdempotencyKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.afterDeleteExpiredIdempotencyKeysCounter)
+}
+
+// DeleteExpiredIdempotencyKeysBeforeCounter returns a count of ChatRepositoryMock.DeleteExpiredIdempotencyKeys invocations
+func (mmDeleteExpiredIdempotencyKeys *ChatRepositoryMock) DeleteExpiredIdempotencyKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.beforeDeleteExpiredIdempotencyKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteExpiredIdempotencyKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredIdempotencyKeys *mChatRepositoryMockDeleteExpiredIdempotencyKeys) Calls() []*ChatRepositoryMockDeleteExpiredIdempotencyKeysParams {
+	mmDeleteExpiredIdempotencyKeys.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockDeleteExpiredIdempotencyKeysParams, len(mmDeleteExpiredIdempotencyKeys.callArgs))
+	copy(argCopy, mmDeleteExpiredIdempotencyKeys.callArgs)
+
+	mmDeleteExpiredIdempotencyKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredIdempotencyKeysDone returns true if the count of the DeleteExpiredIdempotencyKeys invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockDeleteExpiredIdempotencyKeysDone() bool {
+	if m.DeleteExpiredIdempotencyKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredIdempotencyKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredIdempotencyKeysMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredIdempotencyKeysInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockDeleteExpiredIdempotencyKeysInspect() {
+	for _, e := range m.DeleteExpiredIdempotencyKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteExpiredIdempotencyKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredIdempotencyKeysCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredIdempotencyKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredIdempotencyKeysMock.defaultExpectation != nil && afterDeleteExpiredIdempotencyKeysCounter < 1 {
+		if m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteExpiredIdempotencyKeys at\n%s", m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteExpiredIdempotencyKeys at\n%s with params: %#v", m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredIdempotencyKeys != nil && afterDeleteExpiredIdempotencyKeysCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.DeleteExpiredIdempotencyKeys at\n%s", m.funcDeleteExpiredIdempotencyKeysOrigin)
+	}
+
+	if !m.DeleteExpiredIdempotencyKeysMock.invocationsDone() && afterDeleteExpiredIdempotencyKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteExpiredIdempotencyKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredIdempotencyKeysMock.expectedInvocations), m.DeleteExpiredIdempotencyKeysMock.expectedInvocationsOrigin, afterDeleteExpiredIdempotencyKeysCounter)
+	}
+}
+
+type mChatRepositoryMockDeleteMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockDeleteMessageExpectation
+	expectations       []*ChatRepositoryMockDeleteMessageExpectation
+
+	callArgs []*ChatRepositoryMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockDeleteMessageExpectation specifies expectation struct of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockDeleteMessageParams
+	paramPtrs          *ChatRepositoryMockDeleteMessageParamPtrs
+	expectationOrigins ChatRepositoryMockDeleteMessageExpectationOrigins
+	results            *ChatRepositoryMockDeleteMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockDeleteMessageParams contains parameters of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageParams struct {
+	ctx     context.Context
+	message *model.Message
+}
+
+// ChatRepositoryMockDeleteMessageParamPtrs contains pointers to parameters of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageParamPtrs struct {
+	ctx     *context.Context
+	message **model.Message
+}
+
+// ChatRepositoryMockDeleteMessageResults contains results of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageResults struct {
+	err error
+}
+
+// ChatRepositoryMockDeleteMessageOrigins contains origins of expectations of the ChatRepository.DeleteMessage
+type ChatRepositoryMockDeleteMessageExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Optional() *mChatRepositoryMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Expect(ctx context.Context, message *model.Message) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &ChatRepositoryMockDeleteMessageParams{ctx, message}
+	mmDeleteMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectMessageParam2 sets up expected param message for ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) ExpectMessageParam2(message *model.Message) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.message = &message
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Inspect(f func(ctx context.Context, message *model.Message)) *mChatRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.DeleteMessage
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Return(err error) *ChatRepositoryMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatRepositoryMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &ChatRepositoryMockDeleteMessageResults{err}
+	mmDeleteMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.DeleteMessage method
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Set(f func(ctx context.Context, message *model.Message) (err error)) *ChatRepositoryMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	mmDeleteMessage.mock.funcDeleteMessageOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the ChatRepository.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) When(ctx context.Context, message *model.Message) *ChatRepositoryMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockDeleteMessageExpectation{
+		mock:               mmDeleteMessage.mock,
+		params:             &ChatRepositoryMockDeleteMessageParams{ctx, message},
+		expectationOrigins: ChatRepositoryMockDeleteMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockDeleteMessageExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockDeleteMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Times(n uint64) *mChatRepositoryMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	mmDeleteMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements mm_repository.ChatRepository
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessage(ctx context.Context, message *model.Message) (err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	mmDeleteMessage.t.Helper()
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, message)
+	}
+
+	mm_params := ChatRepositoryMockDeleteMessageParams{ctx, message}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockDeleteMessageParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("ChatRepositoryMock.DeleteMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the ChatRepositoryMock.DeleteMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, message)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteMessage. %v %v", ctx, message)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished ChatRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of ChatRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatRepositoryMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mChatRepositoryMockDeleteMessage) Calls() []*ChatRepositoryMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s", m.DeleteMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s with params: %#v", m.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.DeleteMessage at\n%s", m.funcDeleteMessageOrigin)
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), m.DeleteMessageMock.expectedInvocationsOrigin, afterDeleteMessageCounter)
+	}
+}
+
+type mChatRepositoryMockEditMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockEditMessageExpectation
+	expectations       []*ChatRepositoryMockEditMessageExpectation
+
+	callArgs []*ChatRepositoryMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockEditMessageExpectation specifies expectation struct of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockEditMessageParams
+	paramPtrs          *ChatRepositoryMockEditMessageParamPtrs
+	expectationOrigins ChatRepositoryMockEditMessageExpectationOrigins
+	results            *ChatRepositoryMockEditMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockEditMessageParams contains parameters of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageParams struct {
+	ctx  context.Context
+	id   int64
+	text string
+}
+
+// ChatRepositoryMockEditMessageParamPtrs contains pointers to parameters of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageParamPtrs struct {
+	ctx  *context.Context
+	id   *int64
+	text *string
+}
+
+// ChatRepositoryMockEditMessageResults contains results of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageResults struct {
+	err error
+}
+
+// ChatRepositoryMockEditMessageOrigins contains origins of expectations of the ChatRepository.EditMessage
+type ChatRepositoryMockEditMessageExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originText string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mChatRepositoryMockEditMessage) Optional() *mChatRepositoryMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) Expect(ctx context.Context, id int64, text string) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &ChatRepositoryMockEditMessageParams{ctx, id, text}
+	mmEditMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEditMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) ExpectIdParam2(id int64) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.id = &id
+	mmEditMessage.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectTextParam3 sets up expected param text for ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) ExpectTextParam3(text string) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.text = &text
+	mmEditMessage.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) Inspect(f func(ctx context.Context, id int64, text string)) *mChatRepositoryMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.EditMessage
+func (mmEditMessage *mChatRepositoryMockEditMessage) Return(err error) *ChatRepositoryMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatRepositoryMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &ChatRepositoryMockEditMessageResults{err}
+	mmEditMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.EditMessage method
+func (mmEditMessage *mChatRepositoryMockEditMessage) Set(f func(ctx context.Context, id int64, text string) (err error)) *ChatRepositoryMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	mmEditMessage.mock.funcEditMessageOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the ChatRepository.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mChatRepositoryMockEditMessage) When(ctx context.Context, id int64, text string) *ChatRepositoryMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockEditMessageExpectation{
+		mock:               mmEditMessage.mock,
+		params:             &ChatRepositoryMockEditMessageParams{ctx, id, text},
+		expectationOrigins: ChatRepositoryMockEditMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.EditMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockEditMessageExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockEditMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.EditMessage should be invoked
+func (mmEditMessage *mChatRepositoryMockEditMessage) Times(n uint64) *mChatRepositoryMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of ChatRepositoryMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	mmEditMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mChatRepositoryMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements mm_repository.ChatRepository
+func (mmEditMessage *ChatRepositoryMock) EditMessage(ctx context.Context, id int64, text string) (err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	mmEditMessage.t.Helper()
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, id, text)
+	}
+
+	mm_params := ChatRepositoryMockEditMessageParams{ctx, id, text}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockEditMessageParams{ctx, id, text}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("ChatRepositoryMock.EditMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the ChatRepositoryMock.EditMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, id, text)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.EditMessage. %v %v %v", ctx, id, text)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished ChatRepositoryMock.EditMessage invocations
+func (mmEditMessage *ChatRepositoryMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of ChatRepositoryMock.EditMessage invocations
+func (mmEditMessage *ChatRepositoryMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mChatRepositoryMockEditMessage) Calls() []*ChatRepositoryMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s", m.EditMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s with params: %#v", m.EditMessageMock.defaultExpectation.expectationOrigins.origin, *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.EditMessage at\n%s", m.funcEditMessageOrigin)
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.EditMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), m.EditMessageMock.expectedInvocationsOrigin, afterEditMessageCounter)
+	}
+}
+
+type mChatRepositoryMockGetChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetChatExpectation
+	expectations       []*ChatRepositoryMockGetChatExpectation
+
+	callArgs []*ChatRepositoryMockGetChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetChatExpectation specifies expectation struct of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetChatParams
+	paramPtrs          *ChatRepositoryMockGetChatParamPtrs
+	expectationOrigins ChatRepositoryMockGetChatExpectationOrigins
+	results            *ChatRepositoryMockGetChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetChatParams contains parameters of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockGetChatParamPtrs contains pointers to parameters of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockGetChatResults contains results of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// ChatRepositoryMockGetChatOrigins contains origins of expectations of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChat *mChatRepositoryMockGetChat) Optional() *mChatRepositoryMockGetChat {
+	mmGetChat.optional = true
+	return mmGetChat
+}
+
+// Expect sets up expected params for ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) Expect(ctx context.Context, id int64) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by ExpectParams functions")
+	}
+
+	mmGetChat.defaultExpectation.params = &ChatRepositoryMockGetChatParams{ctx, id}
+	mmGetChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChat.expectations {
+		if minimock.Equal(e.params, mmGetChat.defaultExpectation.params) {
+			mmGetChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChat.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) ExpectIdParam2(id int64) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.id = &id
+	mmGetChat.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.inspectFuncGetChat != nil {
+		mmGetChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetChat")
+	}
+
+	mmGetChat.mock.inspectFuncGetChat = f
+
+	return mmGetChat
+}
+
+// Return sets up results that will be returned by ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) Return(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{mock: mmGetChat.mock}
+	}
+	mmGetChat.defaultExpectation.results = &ChatRepositoryMockGetChatResults{cp1, err}
+	mmGetChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetChat method
+func (mmGetChat *mChatRepositoryMockGetChat) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatRepositoryMock {
+	if mmGetChat.defaultExpectation != nil {
+		mmGetChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetChat method")
+	}
+
+	if len(mmGetChat.expectations) > 0 {
+		mmGetChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetChat method")
+	}
+
+	mmGetChat.mock.funcGetChat = f
+	mmGetChat.mock.funcGetChatOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// When sets expectation for the ChatRepository.GetChat which will trigger the result defined by the following
+// Then helper
+func (mmGetChat *mChatRepositoryMockGetChat) When(ctx context.Context, id int64) *ChatRepositoryMockGetChatExpectation {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetChatExpectation{
+		mock:               mmGetChat.mock,
+		params:             &ChatRepositoryMockGetChatParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockGetChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChat.expectations = append(mmGetChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetChatExpectation) Then(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetChatResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetChat should be invoked
+func (mmGetChat *mChatRepositoryMockGetChat) Times(n uint64) *mChatRepositoryMockGetChat {
+	if n == 0 {
+		mmGetChat.mock.t.Fatalf("Times of ChatRepositoryMock.GetChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChat.expectedInvocations, n)
+	mmGetChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChat
+}
+
+func (mmGetChat *mChatRepositoryMockGetChat) invocationsDone() bool {
+	if len(mmGetChat.expectations) == 0 && mmGetChat.defaultExpectation == nil && mmGetChat.mock.funcGetChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChat.mock.afterGetChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChat implements mm_repository.ChatRepository
+func (mmGetChat *ChatRepositoryMock) GetChat(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGetChat.beforeGetChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChat.afterGetChatCounter, 1)
+
+	mmGetChat.t.Helper()
+
+	if mmGetChat.inspectFuncGetChat != nil {
+		mmGetChat.inspectFuncGetChat(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockGetChatParams{ctx, id}
+
+	// Record call args
+	mmGetChat.GetChatMock.mutex.Lock()
+	mmGetChat.GetChatMock.callArgs = append(mmGetChat.GetChatMock.callArgs, &mm_params)
+	mmGetChat.GetChatMock.mutex.Unlock()
+
+	for _, e := range mmGetChat.GetChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetChat.GetChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChat.GetChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChat.GetChatMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChat.GetChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetChatParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChat.t.Errorf("ChatRepositoryMock.GetChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetChat.t.Errorf("ChatRepositoryMock.GetChat got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChat.t.Errorf("ChatRepositoryMock.GetChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChat.GetChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChat.t.Fatal("No results are set for the ChatRepositoryMock.GetChat")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetChat.funcGetChat != nil {
+		return mmGetChat.funcGetChat(ctx, id)
+	}
+	mmGetChat.t.Fatalf("Unexpected call to ChatRepositoryMock.GetChat. %v %v", ctx, id)
+	return
+}
+
+// GetChatAfterCounter returns a count of finished ChatRepositoryMock.GetChat invocations
+func (mmGetChat *ChatRepositoryMock) GetChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.afterGetChatCounter)
+}
+
+// GetChatBeforeCounter returns a count of ChatRepositoryMock.GetChat invocations
+func (mmGetChat *ChatRepositoryMock) GetChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.beforeGetChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChat *mChatRepositoryMockGetChat) Calls() []*ChatRepositoryMockGetChatParams {
+	mmGetChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetChatParams, len(mmGetChat.callArgs))
+	copy(argCopy, mmGetChat.callArgs)
+
+	mmGetChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatDone returns true if the count of the GetChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetChatDone() bool {
+	if m.GetChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMock.invocationsDone()
+}
+
+// MinimockGetChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetChatInspect() {
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChatCounter := mm_atomic.LoadUint64(&m.afterGetChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMock.defaultExpectation != nil && afterGetChatCounter < 1 {
+		if m.GetChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s", m.GetChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s with params: %#v", m.GetChatMock.defaultExpectation.expectationOrigins.origin, *m.GetChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChat != nil && afterGetChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s", m.funcGetChatOrigin)
+	}
+
+	if !m.GetChatMock.invocationsDone() && afterGetChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMock.expectedInvocations), m.GetChatMock.expectedInvocationsOrigin, afterGetChatCounter)
+	}
+}
+
+type mChatRepositoryMockGetIdempotencyResult struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetIdempotencyResultExpectation
+	expectations       []*ChatRepositoryMockGetIdempotencyResultExpectation
+
+	callArgs []*ChatRepositoryMockGetIdempotencyResultParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetIdempotencyResultExpectation specifies expectation struct of the ChatRepository.GetIdempotencyResult
+type ChatRepositoryMockGetIdempotencyResultExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetIdempotencyResultParams
+	paramPtrs          *ChatRepositoryMockGetIdempotencyResultParamPtrs
+	expectationOrigins ChatRepositoryMockGetIdempotencyResultExpectationOrigins
+	results            *ChatRepositoryMockGetIdempotencyResultResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetIdempotencyResultParams contains parameters of the ChatRepository.GetIdempotencyResult
+type ChatRepositoryMockGetIdempotencyResultParams struct {
+	ctx context.Context
+	key *model.IdempotencyKey
+}
+
+// ChatRepositoryMockGetIdempotencyResultParamPtrs contains pointers to parameters of the ChatRepository.GetIdempotencyResult
+type ChatRepositoryMockGetIdempotencyResultParamPtrs struct {
+	ctx *context.Context
+	key **model.IdempotencyKey
+}
+
+// ChatRepositoryMockGetIdempotencyResultResults contains results of the ChatRepository.GetIdempotencyResult
+type ChatRepositoryMockGetIdempotencyResultResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockGetIdempotencyResultOrigins contains origins of expectations of the ChatRepository.GetIdempotencyResult
+type ChatRepositoryMockGetIdempotencyResultExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) Optional() *mChatRepositoryMockGetIdempotencyResult {
+	mmGetIdempotencyResult.optional = true
+	return mmGetIdempotencyResult
+}
+
+// Expect sets up expected params for ChatRepository.GetIdempotencyResult
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) Expect(ctx context.Context, key *model.IdempotencyKey) *mChatRepositoryMockGetIdempotencyResult {
+	if mmGetIdempotencyResult.mock.funcGetIdempotencyResult != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by Set")
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation == nil {
+		mmGetIdempotencyResult.defaultExpectation = &ChatRepositoryMockGetIdempotencyResultExpectation{}
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation.paramPtrs != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by ExpectParams functions")
+	}
+
+	mmGetIdempotencyResult.defaultExpectation.params = &ChatRepositoryMockGetIdempotencyResultParams{ctx, key}
+	mmGetIdempotencyResult.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetIdempotencyResult.expectations {
+		if minimock.Equal(e.params, mmGetIdempotencyResult.defaultExpectation.params) {
+			mmGetIdempotencyResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetIdempotencyResult.defaultExpectation.params)
+		}
+	}
+
+	return mmGetIdempotencyResult
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetIdempotencyResult
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetIdempotencyResult {
+	if mmGetIdempotencyResult.mock.funcGetIdempotencyResult != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by Set")
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation == nil {
+		mmGetIdempotencyResult.defaultExpectation = &ChatRepositoryMockGetIdempotencyResultExpectation{}
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation.params != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by Expect")
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation.paramPtrs == nil {
+		mmGetIdempotencyResult.defaultExpectation.paramPtrs = &ChatRepositoryMockGetIdempotencyResultParamPtrs{}
+	}
+	mmGetIdempotencyResult.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetIdempotencyResult.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetIdempotencyResult
+}
+
+// ExpectKeyParam2 sets up expected param key for ChatRepository.GetIdempotencyResult
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) ExpectKeyParam2(key *model.IdempotencyKey) *mChatRepositoryMockGetIdempotencyResult {
+	if mmGetIdempotencyResult.mock.funcGetIdempotencyResult != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by Set")
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation == nil {
+		mmGetIdempotencyResult.defaultExpectation = &ChatRepositoryMockGetIdempotencyResultExpectation{}
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation.params != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by Expect")
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation.paramPtrs == nil {
+		mmGetIdempotencyResult.defaultExpectation.paramPtrs = &ChatRepositoryMockGetIdempotencyResultParamPtrs{}
+	}
+	mmGetIdempotencyResult.defaultExpectation.paramPtrs.key = &key
+	mmGetIdempotencyResult.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGetIdempotencyResult
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetIdempotencyResult
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) Inspect(f func(ctx context.Context, key *model.IdempotencyKey)) *mChatRepositoryMockGetIdempotencyResult {
+	if mmGetIdempotencyResult.mock.inspectFuncGetIdempotencyResult != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetIdempotencyResult")
+	}
+
+	mmGetIdempotencyResult.mock.inspectFuncGetIdempotencyResult = f
+
+	return mmGetIdempotencyResult
+}
+
+// Return sets up results that will be returned by ChatRepository.GetIdempotencyResult
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmGetIdempotencyResult.mock.funcGetIdempotencyResult != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by Set")
+	}
+
+	if mmGetIdempotencyResult.defaultExpectation == nil {
+		mmGetIdempotencyResult.defaultExpectation = &ChatRepositoryMockGetIdempotencyResultExpectation{mock: mmGetIdempotencyResult.mock}
+	}
+	mmGetIdempotencyResult.defaultExpectation.results = &ChatRepositoryMockGetIdempotencyResultResults{i1, err}
+	mmGetIdempotencyResult.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetIdempotencyResult.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetIdempotencyResult method
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) Set(f func(ctx context.Context, key *model.IdempotencyKey) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmGetIdempotencyResult.defaultExpectation != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetIdempotencyResult method")
+	}
+
+	if len(mmGetIdempotencyResult.expectations) > 0 {
+		mmGetIdempotencyResult.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetIdempotencyResult method")
+	}
+
+	mmGetIdempotencyResult.mock.funcGetIdempotencyResult = f
+	mmGetIdempotencyResult.mock.funcGetIdempotencyResultOrigin = minimock.CallerInfo(1)
+	return mmGetIdempotencyResult.mock
+}
+
+// When sets expectation for the ChatRepository.GetIdempotencyResult which will trigger the result defined by the following
+// Then helper
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) When(ctx context.Context, key *model.IdempotencyKey) *ChatRepositoryMockGetIdempotencyResultExpectation {
+	if mmGetIdempotencyResult.mock.funcGetIdempotencyResult != nil {
+		mmGetIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.GetIdempotencyResult mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetIdempotencyResultExpectation{
+		mock:               mmGetIdempotencyResult.mock,
+		params:             &ChatRepositoryMockGetIdempotencyResultParams{ctx, key},
+		expectationOrigins: ChatRepositoryMockGetIdempotencyResultExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetIdempotencyResult.expectations = append(mmGetIdempotencyResult.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetIdempotencyResult return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetIdempotencyResultExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetIdempotencyResultResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetIdempotencyResult should be invoked
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) Times(n uint64) *mChatRepositoryMockGetIdempotencyResult {
+	if n == 0 {
+		mmGetIdempotencyResult.mock.t.Fatalf("Times of ChatRepositoryMock.GetIdempotencyResult mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetIdempotencyResult.expectedInvocations, n)
+	mmGetIdempotencyResult.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetIdempotencyResult
+}
+
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) invocationsDone() bool {
+	if len(mmGetIdempotencyResult.expectations) == 0 && mmGetIdempotencyResult.defaultExpectation == nil && mmGetIdempotencyResult.mock.funcGetIdempotencyResult == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetIdempotencyResult.mock.afterGetIdempotencyResultCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetIdempotencyResult.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetIdempotencyResult implements mm_repository.ChatRepository
+func (mmGetIdempotencyResult *ChatRepositoryMock) GetIdempotencyResult(ctx context.Context, key *model.IdempotencyKey) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetIdempotencyResult.beforeGetIdempotencyResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetIdempotencyResult.afterGetIdempotencyResultCounter, 1)
+
+	mmGetIdempotencyResult.t.Helper()
+
+	if mmGetIdempotencyResult.inspectFuncGetIdempotencyResult != nil {
+		mmGetIdempotencyResult.inspectFuncGetIdempotencyResult(ctx, key)
+	}
+
+	mm_params := ChatRepositoryMockGetIdempotencyResultParams{ctx, key}
+
+	// Record call args
+	mmGetIdempotencyResult.GetIdempotencyResultMock.mutex.Lock()
+	mmGetIdempotencyResult.GetIdempotencyResultMock.callArgs = append(mmGetIdempotencyResult.GetIdempotencyResultMock.callArgs, &mm_params)
+	mmGetIdempotencyResult.GetIdempotencyResultMock.mutex.Unlock()
+
+	for _, e := range mmGetIdempotencyResult.GetIdempotencyResultMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation.params
+		mm_want_ptrs := mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetIdempotencyResultParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetIdempotencyResult.t.Errorf("ChatRepositoryMock.GetIdempotencyResult got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGetIdempotencyResult.t.Errorf("ChatRepositoryMock.GetIdempotencyResult got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetIdempotencyResult.t.Errorf("ChatRepositoryMock.GetIdempotencyResult got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetIdempotencyResult.GetIdempotencyResultMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetIdempotencyResult.t.Fatal("No results are set for the ChatRepositoryMock.GetIdempotencyResult")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetIdempotencyResult.funcGetIdempotencyResult != nil {
+		return mmGetIdempotencyResult.funcGetIdempotencyResult(ctx, key)
+	}
+	mmGetIdempotencyResult.t.Fatalf("Unexpected call to ChatRepositoryMock.GetIdempotencyResult. %v %v", ctx, key)
+	return
+}
+
+// GetIdempotencyResultAfterCounter returns a count of finished ChatRepositoryMock.GetIdempotencyResult invocations
+func (mmGetIdempotencyResult *ChatRepositoryMock) GetIdempotencyResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetIdempotencyResult.afterGetIdempotencyResultCounter)
+}
+
+// GetIdempotencyResultBeforeCounter returns a count of ChatRepositoryMock.GetIdempotencyResult invocations
+func (mmGetIdempotencyResult *ChatRepositoryMock) GetIdempotencyResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetIdempotencyResult.beforeGetIdempotencyResultCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetIdempotencyResult.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetIdempotencyResult *mChatRepositoryMockGetIdempotencyResult) Calls() []*ChatRepositoryMockGetIdempotencyResultParams {
+	mmGetIdempotencyResult.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetIdempotencyResultParams, len(mmGetIdempotencyResult.callArgs))
+	copy(argCopy, mmGetIdempotencyResult.callArgs)
+
+	mmGetIdempotencyResult.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetIdempotencyResultDone returns true if the count of the GetIdempotencyResult invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetIdempotencyResultDone() bool {
+	if m.GetIdempotencyResultMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetIdempotencyResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetIdempotencyResultMock.invocationsDone()
+}
+
+// MinimockGetIdempotencyResultInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetIdempotencyResultInspect() {
+	for _, e := range m.GetIdempotencyResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetIdempotencyResult at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetIdempotencyResultCounter := mm_atomic.LoadUint64(&m.afterGetIdempotencyResultCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetIdempotencyResultMock.defaultExpectation != nil && afterGetIdempotencyResultCounter < 1 {
+		if m.GetIdempotencyResultMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetIdempotencyResult at\n%s", m.GetIdempotencyResultMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetIdempotencyResult at\n%s with params: %#v", m.GetIdempotencyResultMock.defaultExpectation.expectationOrigins.origin, *m.GetIdempotencyResultMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetIdempotencyResult != nil && afterGetIdempotencyResultCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetIdempotencyResult at\n%s", m.funcGetIdempotencyResultOrigin)
+	}
+
+	if !m.GetIdempotencyResultMock.invocationsDone() && afterGetIdempotencyResultCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetIdempotencyResult at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetIdempotencyResultMock.expectedInvocations), m.GetIdempotencyResultMock.expectedInvocationsOrigin, afterGetIdempotencyResultCounter)
+	}
+}
+
+type mChatRepositoryMockGetMemberRole struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetMemberRoleExpectation
+	expectations       []*ChatRepositoryMockGetMemberRoleExpectation
+
+	callArgs []*ChatRepositoryMockGetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetMemberRoleExpectation specifies expectation struct of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetMemberRoleParams
+	paramPtrs          *ChatRepositoryMockGetMemberRoleParamPtrs
+	expectationOrigins ChatRepositoryMockGetMemberRoleExpectationOrigins
+	results            *ChatRepositoryMockGetMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetMemberRoleParams contains parameters of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleParams struct {
+	ctx    context.Context
+	chatID int64
+	userID string
+}
+
+// ChatRepositoryMockGetMemberRoleParamPtrs contains pointers to parameters of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *string
+}
+
+// ChatRepositoryMockGetMemberRoleResults contains results of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleResults struct {
+	r1  model.Role
+	err error
+}
+
+// ChatRepositoryMockGetMemberRoleOrigins contains origins of expectations of the ChatRepository.GetMemberRole
+type ChatRepositoryMockGetMemberRoleExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Optional() *mChatRepositoryMockGetMemberRole {
+	mmGetMemberRole.optional = true
+	return mmGetMemberRole
+}
+
+// Expect sets up expected params for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Expect(ctx context.Context, chatID int64, userID string) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmGetMemberRole.defaultExpectation.params = &ChatRepositoryMockGetMemberRoleParams{ctx, chatID, userID}
+	mmGetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMemberRole.expectations {
+		if minimock.Equal(e.params, mmGetMemberRole.defaultExpectation.params) {
+			mmGetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.params != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Expect")
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmGetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMemberRoleParamPtrs{}
+	}
+	mmGetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.params != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Expect")
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmGetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMemberRoleParamPtrs{}
+	}
+	mmGetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetMemberRole
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) ExpectUserIDParam3(userID string) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{}
+	}
+
+	if mmGetMemberRole.defaultExpectation.params != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Expect")
+	}
+
+	if mmGetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmGetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMemberRoleParamPtrs{}
+	}
+	mmGetMemberRole.defaultExpectation.paramPtrs.userID = &userID
+	mmGetMemberRole.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Inspect(f func(ctx context.Context, chatID int64, userID string)) *mChatRepositoryMockGetMemberRole {
+	if mmGetMemberRole.mock.inspectFuncGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetMemberRole")
+	}
+
+	mmGetMemberRole.mock.inspectFuncGetMemberRole = f
+
+	return mmGetMemberRole
+}
+
+// Return sets up results that will be returned by ChatRepository.GetMemberRole
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Return(r1 model.Role, err error) *ChatRepositoryMock {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	if mmGetMemberRole.defaultExpectation == nil {
+		mmGetMemberRole.defaultExpectation = &ChatRepositoryMockGetMemberRoleExpectation{mock: mmGetMemberRole.mock}
+	}
+	mmGetMemberRole.defaultExpectation.results = &ChatRepositoryMockGetMemberRoleResults{r1, err}
+	mmGetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetMemberRole method
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Set(f func(ctx context.Context, chatID int64, userID string) (r1 model.Role, err error)) *ChatRepositoryMock {
+	if mmGetMemberRole.defaultExpectation != nil {
+		mmGetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetMemberRole method")
+	}
+
+	if len(mmGetMemberRole.expectations) > 0 {
+		mmGetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetMemberRole method")
+	}
+
+	mmGetMemberRole.mock.funcGetMemberRole = f
+	mmGetMemberRole.mock.funcGetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmGetMemberRole.mock
+}
+
+// When sets expectation for the ChatRepository.GetMemberRole which will trigger the result defined by the following
+// Then helper
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) When(ctx context.Context, chatID int64, userID string) *ChatRepositoryMockGetMemberRoleExpectation {
+	if mmGetMemberRole.mock.funcGetMemberRole != nil {
+		mmGetMemberRole.mock.t.Fatalf("ChatRepositoryMock.GetMemberRole mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetMemberRoleExpectation{
+		mock:               mmGetMemberRole.mock,
+		params:             &ChatRepositoryMockGetMemberRoleParams{ctx, chatID, userID},
+		expectationOrigins: ChatRepositoryMockGetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMemberRole.expectations = append(mmGetMemberRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetMemberRoleExpectation) Then(r1 model.Role, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetMemberRoleResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetMemberRole should be invoked
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Times(n uint64) *mChatRepositoryMockGetMemberRole {
+	if n == 0 {
+		mmGetMemberRole.mock.t.Fatalf("Times of ChatRepositoryMock.GetMemberRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMemberRole.expectedInvocations, n)
+	mmGetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMemberRole
+}
+
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) invocationsDone() bool {
+	if len(mmGetMemberRole.expectations) == 0 && mmGetMemberRole.defaultExpectation == nil && mmGetMemberRole.mock.funcGetMemberRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMemberRole.mock.afterGetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMemberRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMemberRole implements mm_repository.ChatRepository
+func (mmGetMemberRole *ChatRepositoryMock) GetMemberRole(ctx context.Context, chatID int64, userID string) (r1 model.Role, err error) {
+	mm_atomic.AddUint64(&mmGetMemberRole.beforeGetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMemberRole.afterGetMemberRoleCounter, 1)
+
+	mmGetMemberRole.t.Helper()
+
+	if mmGetMemberRole.inspectFuncGetMemberRole != nil {
+		mmGetMemberRole.inspectFuncGetMemberRole(ctx, chatID, userID)
+	}
+
+	mm_params := ChatRepositoryMockGetMemberRoleParams{ctx, chatID, userID}
+
+	// Record call args
+	mmGetMemberRole.GetMemberRoleMock.mutex.Lock()
+	mmGetMemberRole.GetMemberRoleMock.callArgs = append(mmGetMemberRole.GetMemberRoleMock.callArgs, &mm_params)
+	mmGetMemberRole.GetMemberRoleMock.mutex.Unlock()
+
+	for _, e := range mmGetMemberRole.GetMemberRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetMemberRole.GetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMemberRole.GetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMemberRole.GetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMemberRole.GetMemberRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetMemberRoleParams{ctx, chatID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMemberRole.t.Errorf("ChatRepositoryMock.GetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMemberRole.GetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMemberRole.GetMemberRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMemberRole.t.Fatal("No results are set for the ChatRepositoryMock.GetMemberRole")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetMemberRole.funcGetMemberRole != nil {
+		return mmGetMemberRole.funcGetMemberRole(ctx, chatID, userID)
+	}
+	mmGetMemberRole.t.Fatalf("Unexpected call to ChatRepositoryMock.GetMemberRole. %v %v %v", ctx, chatID, userID)
+	return
+}
+
+// GetMemberRoleAfterCounter returns a count of finished ChatRepositoryMock.GetMemberRole invocations
+func (mmGetMemberRole *ChatRepositoryMock) GetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMemberRole.afterGetMemberRoleCounter)
+}
+
+// GetMemberRoleBeforeCounter returns a count of ChatRepositoryMock.GetMemberRole invocations
+func (mmGetMemberRole *ChatRepositoryMock) GetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMemberRole.beforeGetMemberRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetMemberRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMemberRole *mChatRepositoryMockGetMemberRole) Calls() []*ChatRepositoryMockGetMemberRoleParams {
+	mmGetMemberRole.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetMemberRoleParams, len(mmGetMemberRole.callArgs))
+	copy(argCopy, mmGetMemberRole.callArgs)
+
+	mmGetMemberRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMemberRoleDone returns true if the count of the GetMemberRole invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetMemberRoleDone() bool {
+	if m.GetMemberRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMemberRoleMock.invocationsDone()
+}
+
+// MinimockGetMemberRoleInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetMemberRoleInspect() {
+	for _, e := range m.GetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterGetMemberRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMemberRoleMock.defaultExpectation != nil && afterGetMemberRoleCounter < 1 {
+		if m.GetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s", m.GetMemberRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s with params: %#v", m.GetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.GetMemberRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMemberRole != nil && afterGetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetMemberRole at\n%s", m.funcGetMemberRoleOrigin)
+	}
+
+	if !m.GetMemberRoleMock.invocationsDone() && afterGetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMemberRoleMock.expectedInvocations), m.GetMemberRoleMock.expectedInvocationsOrigin, afterGetMemberRoleCounter)
+	}
+}
+
+type mChatRepositoryMockGetMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetMessageExpectation
+	expectations       []*ChatRepositoryMockGetMessageExpectation
+
+	callArgs []*ChatRepositoryMockGetMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetMessageExpectation specifies expectation struct of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetMessageParams
+	paramPtrs          *ChatRepositoryMockGetMessageParamPtrs
+	expectationOrigins ChatRepositoryMockGetMessageExpectationOrigins
+	results            *ChatRepositoryMockGetMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetMessageParams contains parameters of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockGetMessageParamPtrs contains pointers to parameters of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockGetMessageResults contains results of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatRepositoryMockGetMessageOrigins contains origins of expectations of the ChatRepository.GetMessage
+type ChatRepositoryMockGetMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMessage *mChatRepositoryMockGetMessage) Optional() *mChatRepositoryMockGetMessage {
+	mmGetMessage.optional = true
+	return mmGetMessage
+}
+
+// Expect sets up expected params for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Expect(ctx context.Context, id int64) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by ExpectParams functions")
+	}
+
+	mmGetMessage.defaultExpectation.params = &ChatRepositoryMockGetMessageParams{ctx, id}
+	mmGetMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMessage.expectations {
+		if minimock.Equal(e.params, mmGetMessage.defaultExpectation.params) {
+			mmGetMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMessage
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) ExpectIdParam2(id int64) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.id = &id
+	mmGetMessage.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockGetMessage {
+	if mmGetMessage.mock.inspectFuncGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetMessage")
+	}
+
+	mmGetMessage.mock.inspectFuncGetMessage = f
+
+	return mmGetMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.GetMessage
+func (mmGetMessage *mChatRepositoryMockGetMessage) Return(mp1 *model.Message, err error) *ChatRepositoryMock {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &ChatRepositoryMockGetMessageExpectation{mock: mmGetMessage.mock}
+	}
+	mmGetMessage.defaultExpectation.results = &ChatRepositoryMockGetMessageResults{mp1, err}
+	mmGetMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetMessage method
+func (mmGetMessage *mChatRepositoryMockGetMessage) Set(f func(ctx context.Context, id int64) (mp1 *model.Message, err error)) *ChatRepositoryMock {
+	if mmGetMessage.defaultExpectation != nil {
+		mmGetMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetMessage method")
+	}
+
+	if len(mmGetMessage.expectations) > 0 {
+		mmGetMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetMessage method")
+	}
+
+	mmGetMessage.mock.funcGetMessage = f
+	mmGetMessage.mock.funcGetMessageOrigin = minimock.CallerInfo(1)
+	return mmGetMessage.mock
+}
+
+// When sets expectation for the ChatRepository.GetMessage which will trigger the result defined by the following
+// Then helper
+func (mmGetMessage *mChatRepositoryMockGetMessage) When(ctx context.Context, id int64) *ChatRepositoryMockGetMessageExpectation {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("ChatRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetMessageExpectation{
+		mock:               mmGetMessage.mock,
+		params:             &ChatRepositoryMockGetMessageParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockGetMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMessage.expectations = append(mmGetMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetMessageExpectation) Then(mp1 *model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetMessage should be invoked
+func (mmGetMessage *mChatRepositoryMockGetMessage) Times(n uint64) *mChatRepositoryMockGetMessage {
+	if n == 0 {
+		mmGetMessage.mock.t.Fatalf("Times of ChatRepositoryMock.GetMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMessage.expectedInvocations, n)
+	mmGetMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMessage
+}
+
+func (mmGetMessage *mChatRepositoryMockGetMessage) invocationsDone() bool {
+	if len(mmGetMessage.expectations) == 0 && mmGetMessage.defaultExpectation == nil && mmGetMessage.mock.funcGetMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMessage.mock.afterGetMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMessage implements mm_repository.ChatRepository
+func (mmGetMessage *ChatRepositoryMock) GetMessage(ctx context.Context, id int64) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmGetMessage.beforeGetMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMessage.afterGetMessageCounter, 1)
+
+	mmGetMessage.t.Helper()
+
+	if mmGetMessage.inspectFuncGetMessage != nil {
+		mmGetMessage.inspectFuncGetMessage(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockGetMessageParams{ctx, id}
+
+	// Record call args
+	mmGetMessage.GetMessageMock.mutex.Lock()
+	mmGetMessage.GetMessageMock.callArgs = append(mmGetMessage.GetMessageMock.callArgs, &mm_params)
+	mmGetMessage.GetMessageMock.mutex.Unlock()
+
+	for _, e := range mmGetMessage.GetMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetMessage.GetMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMessage.GetMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMessage.GetMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMessage.GetMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetMessageParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMessage.t.Errorf("ChatRepositoryMock.GetMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMessage.GetMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMessage.t.Fatal("No results are set for the ChatRepositoryMock.GetMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetMessage.funcGetMessage != nil {
+		return mmGetMessage.funcGetMessage(ctx, id)
+	}
+	mmGetMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.GetMessage. %v %v", ctx, id)
+	return
+}
+
+// GetMessageAfterCounter returns a count of finished ChatRepositoryMock.GetMessage invocations
+func (mmGetMessage *ChatRepositoryMock) GetMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.afterGetMessageCounter)
+}
+
+// GetMessageBeforeCounter returns a count of ChatRepositoryMock.GetMessage invocations
+func (mmGetMessage *ChatRepositoryMock) GetMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.beforeGetMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMessage *mChatRepositoryMockGetMessage) Calls() []*ChatRepositoryMockGetMessageParams {
+	mmGetMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetMessageParams, len(mmGetMessage.callArgs))
+	copy(argCopy, mmGetMessage.callArgs)
+
+	mmGetMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMessageDone returns true if the count of the GetMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetMessageDone() bool {
+	if m.GetMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMessageMock.invocationsDone()
+}
+
+// MinimockGetMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetMessageInspect() {
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMessageCounter := mm_atomic.LoadUint64(&m.afterGetMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMessageMock.defaultExpectation != nil && afterGetMessageCounter < 1 {
+		if m.GetMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s", m.GetMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s with params: %#v", m.GetMessageMock.defaultExpectation.expectationOrigins.origin, *m.GetMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMessage != nil && afterGetMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetMessage at\n%s", m.funcGetMessageOrigin)
+	}
+
+	if !m.GetMessageMock.invocationsDone() && afterGetMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMessageMock.expectedInvocations), m.GetMessageMock.expectedInvocationsOrigin, afterGetMessageCounter)
+	}
+}
+
+type mChatRepositoryMockIsChatExists struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockIsChatExistsExpectation
+	expectations       []*ChatRepositoryMockIsChatExistsExpectation
+
+	callArgs []*ChatRepositoryMockIsChatExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockIsChatExistsExpectation specifies expectation struct of the ChatRepository.IsChatExists
+type ChatRepositoryMockIsChatExistsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockIsChatExistsParams
+	paramPtrs          *ChatRepositoryMockIsChatExistsParamPtrs
+	expectationOrigins ChatRepositoryMockIsChatExistsExpectationOrigins
+	results            *ChatRepositoryMockIsChatExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockIsChatExistsParams contains parameters of the ChatRepository.IsChatExists
+type ChatRepositoryMockIsChatExistsParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockIsChatExistsParamPtrs contains pointers to parameters of the ChatRepository.IsChatExists
+type ChatRepositoryMockIsChatExistsParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockIsChatExistsResults contains results of the ChatRepository.IsChatExists
+type ChatRepositoryMockIsChatExistsResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockIsChatExistsOrigins contains origins of expectations of the ChatRepository.IsChatExists
+type ChatRepositoryMockIsChatExistsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) Optional() *mChatRepositoryMockIsChatExists {
+	mmIsChatExists.optional = true
+	return mmIsChatExists
+}
+
+// Expect sets up expected params for ChatRepository.IsChatExists
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockIsChatExists {
+	if mmIsChatExists.mock.funcIsChatExists != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by Set")
+	}
+
+	if mmIsChatExists.defaultExpectation == nil {
+		mmIsChatExists.defaultExpectation = &ChatRepositoryMockIsChatExistsExpectation{}
+	}
+
+	if mmIsChatExists.defaultExpectation.paramPtrs != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by ExpectParams functions")
+	}
+
+	mmIsChatExists.defaultExpectation.params = &ChatRepositoryMockIsChatExistsParams{ctx, chatID}
+	mmIsChatExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsChatExists.expectations {
+		if minimock.Equal(e.params, mmIsChatExists.defaultExpectation.params) {
+			mmIsChatExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsChatExists.defaultExpectation.params)
+		}
+	}
+
+	return mmIsChatExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.IsChatExists
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockIsChatExists {
+	if mmIsChatExists.mock.funcIsChatExists != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by Set")
+	}
+
+	if mmIsChatExists.defaultExpectation == nil {
+		mmIsChatExists.defaultExpectation = &ChatRepositoryMockIsChatExistsExpectation{}
+	}
+
+	if mmIsChatExists.defaultExpectation.params != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by Expect")
+	}
+
+	if mmIsChatExists.defaultExpectation.paramPtrs == nil {
+		mmIsChatExists.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatExistsParamPtrs{}
+	}
+	mmIsChatExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsChatExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsChatExists
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.IsChatExists
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockIsChatExists {
+	if mmIsChatExists.mock.funcIsChatExists != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by Set")
+	}
+
+	if mmIsChatExists.defaultExpectation == nil {
+		mmIsChatExists.defaultExpectation = &ChatRepositoryMockIsChatExistsExpectation{}
+	}
+
+	if mmIsChatExists.defaultExpectation.params != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by Expect")
+	}
+
+	if mmIsChatExists.defaultExpectation.paramPtrs == nil {
+		mmIsChatExists.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatExistsParamPtrs{}
+	}
+	mmIsChatExists.defaultExpectation.paramPtrs.chatID = &chatID
+	mmIsChatExists.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmIsChatExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.IsChatExists
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockIsChatExists {
+	if mmIsChatExists.mock.inspectFuncIsChatExists != nil {
+		mmIsChatExists.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.IsChatExists")
+	}
+
+	mmIsChatExists.mock.inspectFuncIsChatExists = f
+
+	return mmIsChatExists
+}
+
+// Return sets up results that will be returned by ChatRepository.IsChatExists
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmIsChatExists.mock.funcIsChatExists != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by Set")
+	}
+
+	if mmIsChatExists.defaultExpectation == nil {
+		mmIsChatExists.defaultExpectation = &ChatRepositoryMockIsChatExistsExpectation{mock: mmIsChatExists.mock}
+	}
+	mmIsChatExists.defaultExpectation.results = &ChatRepositoryMockIsChatExistsResults{b1, err}
+	mmIsChatExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsChatExists.mock
+}
+
+// Set uses given function f to mock the ChatRepository.IsChatExists method
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) Set(f func(ctx context.Context, chatID int64) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmIsChatExists.defaultExpectation != nil {
+		mmIsChatExists.mock.t.Fatalf("Default expectation is already set for the ChatRepository.IsChatExists method")
+	}
+
+	if len(mmIsChatExists.expectations) > 0 {
+		mmIsChatExists.mock.t.Fatalf("Some expectations are already set for the ChatRepository.IsChatExists method")
+	}
+
+	mmIsChatExists.mock.funcIsChatExists = f
+	mmIsChatExists.mock.funcIsChatExistsOrigin = minimock.CallerInfo(1)
+	return mmIsChatExists.mock
+}
+
+// When sets expectation for the ChatRepository.IsChatExists which will trigger the result defined by the following
+// Then helper
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) When(ctx context.Context, chatID int64) *ChatRepositoryMockIsChatExistsExpectation {
+	if mmIsChatExists.mock.funcIsChatExists != nil {
+		mmIsChatExists.mock.t.Fatalf("ChatRepositoryMock.IsChatExists mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockIsChatExistsExpectation{
+		mock:               mmIsChatExists.mock,
+		params:             &ChatRepositoryMockIsChatExistsParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockIsChatExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsChatExists.expectations = append(mmIsChatExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.IsChatExists return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockIsChatExistsExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockIsChatExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.IsChatExists should be invoked
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) Times(n uint64) *mChatRepositoryMockIsChatExists {
+	if n == 0 {
+		mmIsChatExists.mock.t.Fatalf("Times of ChatRepositoryMock.IsChatExists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsChatExists.expectedInvocations, n)
+	mmIsChatExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsChatExists
+}
+
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) invocationsDone() bool {
+	if len(mmIsChatExists.expectations) == 0 && mmIsChatExists.defaultExpectation == nil && mmIsChatExists.mock.funcIsChatExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsChatExists.mock.afterIsChatExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsChatExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsChatExists implements mm_repository.ChatRepository
+func (mmIsChatExists *ChatRepositoryMock) IsChatExists(ctx context.Context, chatID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsChatExists.beforeIsChatExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsChatExists.afterIsChatExistsCounter, 1)
+
+	mmIsChatExists.t.Helper()
+
+	if mmIsChatExists.inspectFuncIsChatExists != nil {
+		mmIsChatExists.inspectFuncIsChatExists(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockIsChatExistsParams{ctx, chatID}
+
+	// Record call args
+	mmIsChatExists.IsChatExistsMock.mutex.Lock()
+	mmIsChatExists.IsChatExistsMock.callArgs = append(mmIsChatExists.IsChatExistsMock.callArgs, &mm_params)
+	mmIsChatExists.IsChatExistsMock.mutex.Unlock()
+
+	for _, e := range mmIsChatExists.IsChatExistsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsChatExists.IsChatExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsChatExists.IsChatExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsChatExists.IsChatExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmIsChatExists.IsChatExistsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockIsChatExistsParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsChatExists.t.Errorf("ChatRepositoryMock.IsChatExists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatExists.IsChatExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmIsChatExists.t.Errorf("ChatRepositoryMock.IsChatExists got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatExists.IsChatExistsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsChatExists.t.Errorf("ChatRepositoryMock.IsChatExists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsChatExists.IsChatExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsChatExists.IsChatExistsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsChatExists.t.Fatal("No results are set for the ChatRepositoryMock.IsChatExists")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsChatExists.funcIsChatExists != nil {
+		return mmIsChatExists.funcIsChatExists(ctx, chatID)
+	}
+	mmIsChatExists.t.Fatalf("Unexpected call to ChatRepositoryMock.IsChatExists. %v %v", ctx, chatID)
+	return
+}
+
+// IsChatExistsAfterCounter returns a count of finished ChatRepositoryMock.IsChatExists invocations
+func (mmIsChatExists *ChatRepositoryMock) IsChatExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsChatExists.afterIsChatExistsCounter)
+}
+
+// IsChatExistsBeforeCounter returns a count of ChatRepositoryMock.IsChatExists invocations
+func (mmIsChatExists *ChatRepositoryMock) IsChatExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsChatExists.beforeIsChatExistsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.IsChatExists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsChatExists *mChatRepositoryMockIsChatExists) Calls() []*ChatRepositoryMockIsChatExistsParams {
+	mmIsChatExists.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockIsChatExistsParams, len(mmIsChatExists.callArgs))
+	copy(argCopy, mmIsChatExists.callArgs)
+
+	mmIsChatExists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsChatExistsDone returns true if the count of the IsChatExists invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockIsChatExistsDone() bool {
+	if m.IsChatExistsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsChatExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsChatExistsMock.invocationsDone()
+}
+
+// MinimockIsChatExistsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockIsChatExistsInspect() {
+	for _, e := range m.IsChatExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatExists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsChatExistsCounter := mm_atomic.LoadUint64(&m.afterIsChatExistsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsChatExistsMock.defaultExpectation != nil && afterIsChatExistsCounter < 1 {
+		if m.IsChatExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatExists at\n%s", m.IsChatExistsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatExists at\n%s with params: %#v", m.IsChatExistsMock.defaultExpectation.expectationOrigins.origin, *m.IsChatExistsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsChatExists != nil && afterIsChatExistsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.IsChatExists at\n%s", m.funcIsChatExistsOrigin)
+	}
+
+	if !m.IsChatExistsMock.invocationsDone() && afterIsChatExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.IsChatExists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsChatExistsMock.expectedInvocations), m.IsChatExistsMock.expectedInvocationsOrigin, afterIsChatExistsCounter)
+	}
+}
+
+type mChatRepositoryMockIsChatMember struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockIsChatMemberExpectation
+	expectations       []*ChatRepositoryMockIsChatMemberExpectation
+
+	callArgs []*ChatRepositoryMockIsChatMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockIsChatMemberExpectation specifies expectation struct of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockIsChatMemberParams
+	paramPtrs          *ChatRepositoryMockIsChatMemberParamPtrs
+	expectationOrigins ChatRepositoryMockIsChatMemberExpectationOrigins
+	results            *ChatRepositoryMockIsChatMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockIsChatMemberParams contains parameters of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberParams struct {
+	ctx    context.Context
+	chatID int64
+	userID string
+}
+
+// ChatRepositoryMockIsChatMemberParamPtrs contains pointers to parameters of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *string
+}
+
+// ChatRepositoryMockIsChatMemberResults contains results of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockIsChatMemberOrigins contains origins of expectations of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Optional() *mChatRepositoryMockIsChatMember {
+	mmIsChatMember.optional = true
+	return mmIsChatMember
+}
+
+// Expect sets up expected params for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Expect(ctx context.Context, chatID int64, userID string) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by ExpectParams functions")
+	}
+
+	mmIsChatMember.defaultExpectation.params = &ChatRepositoryMockIsChatMemberParams{ctx, chatID, userID}
+	mmIsChatMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsChatMember.expectations {
+		if minimock.Equal(e.params, mmIsChatMember.defaultExpectation.params) {
+			mmIsChatMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsChatMember.defaultExpectation.params)
+		}
+	}
+
+	return mmIsChatMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.params != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Expect")
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs == nil {
+		mmIsChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatMemberParamPtrs{}
+	}
+	mmIsChatMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsChatMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsChatMember
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.params != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Expect")
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs == nil {
+		mmIsChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatMemberParamPtrs{}
+	}
+	mmIsChatMember.defaultExpectation.paramPtrs.chatID = &chatID
+	mmIsChatMember.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmIsChatMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) ExpectUserIDParam3(userID string) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.params != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Expect")
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs == nil {
+		mmIsChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatMemberParamPtrs{}
+	}
+	mmIsChatMember.defaultExpectation.paramPtrs.userID = &userID
+	mmIsChatMember.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmIsChatMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Inspect(f func(ctx context.Context, chatID int64, userID string)) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.inspectFuncIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.IsChatMember")
+	}
+
+	mmIsChatMember.mock.inspectFuncIsChatMember = f
+
+	return mmIsChatMember
+}
+
+// Return sets up results that will be returned by ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{mock: mmIsChatMember.mock}
+	}
+	mmIsChatMember.defaultExpectation.results = &ChatRepositoryMockIsChatMemberResults{b1, err}
+	mmIsChatMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsChatMember.mock
+}
+
+// Set uses given function f to mock the ChatRepository.IsChatMember method
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Set(f func(ctx context.Context, chatID int64, userID string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmIsChatMember.defaultExpectation != nil {
+		mmIsChatMember.mock.t.Fatalf("Default expectation is already set for the ChatRepository.IsChatMember method")
+	}
+
+	if len(mmIsChatMember.expectations) > 0 {
+		mmIsChatMember.mock.t.Fatalf("Some expectations are already set for the ChatRepository.IsChatMember method")
+	}
+
+	mmIsChatMember.mock.funcIsChatMember = f
+	mmIsChatMember.mock.funcIsChatMemberOrigin = minimock.CallerInfo(1)
+	return mmIsChatMember.mock
+}
+
+// When sets expectation for the ChatRepository.IsChatMember which will trigger the result defined by the following
+// Then helper
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) When(ctx context.Context, chatID int64, userID string) *ChatRepositoryMockIsChatMemberExpectation {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockIsChatMemberExpectation{
+		mock:               mmIsChatMember.mock,
+		params:             &ChatRepositoryMockIsChatMemberParams{ctx, chatID, userID},
+		expectationOrigins: ChatRepositoryMockIsChatMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsChatMember.expectations = append(mmIsChatMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.IsChatMember return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockIsChatMemberExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockIsChatMemberResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.IsChatMember should be invoked
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Times(n uint64) *mChatRepositoryMockIsChatMember {
+	if n == 0 {
+		mmIsChatMember.mock.t.Fatalf("Times of ChatRepositoryMock.IsChatMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsChatMember.expectedInvocations, n)
+	mmIsChatMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsChatMember
+}
+
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) invocationsDone() bool {
+	if len(mmIsChatMember.expectations) == 0 && mmIsChatMember.defaultExpectation == nil && mmIsChatMember.mock.funcIsChatMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsChatMember.mock.afterIsChatMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsChatMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsChatMember implements mm_repository.ChatRepository
+func (mmIsChatMember *ChatRepositoryMock) IsChatMember(ctx context.Context, chatID int64, userID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsChatMember.beforeIsChatMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsChatMember.afterIsChatMemberCounter, 1)
+
+	mmIsChatMember.t.Helper()
+
+	if mmIsChatMember.inspectFuncIsChatMember != nil {
+		mmIsChatMember.inspectFuncIsChatMember(ctx, chatID, userID)
+	}
+
+	mm_params := ChatRepositoryMockIsChatMemberParams{ctx, chatID, userID}
+
+	// Record call args
+	mmIsChatMember.IsChatMemberMock.mutex.Lock()
+	mmIsChatMember.IsChatMemberMock.callArgs = append(mmIsChatMember.IsChatMemberMock.callArgs, &mm_params)
+	mmIsChatMember.IsChatMemberMock.mutex.Unlock()
+
+	for _, e := range mmIsChatMember.IsChatMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsChatMember.IsChatMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsChatMember.IsChatMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsChatMember.IsChatMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmIsChatMember.IsChatMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockIsChatMemberParams{ctx, chatID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsChatMember.IsChatMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsChatMember.t.Fatal("No results are set for the ChatRepositoryMock.IsChatMember")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsChatMember.funcIsChatMember != nil {
+		return mmIsChatMember.funcIsChatMember(ctx, chatID, userID)
+	}
+	mmIsChatMember.t.Fatalf("Unexpected call to ChatRepositoryMock.IsChatMember. %v %v %v", ctx, chatID, userID)
+	return
+}
+
+// IsChatMemberAfterCounter returns a count of finished ChatRepositoryMock.IsChatMember invocations
+func (mmIsChatMember *ChatRepositoryMock) IsChatMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsChatMember.afterIsChatMemberCounter)
+}
+
+// IsChatMemberBeforeCounter returns a count of ChatRepositoryMock.IsChatMember invocations
+func (mmIsChatMember *ChatRepositoryMock) IsChatMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsChatMember.beforeIsChatMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.IsChatMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Calls() []*ChatRepositoryMockIsChatMemberParams {
+	mmIsChatMember.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockIsChatMemberParams, len(mmIsChatMember.callArgs))
+	copy(argCopy, mmIsChatMember.callArgs)
+
+	mmIsChatMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsChatMemberDone returns true if the count of the IsChatMember invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockIsChatMemberDone() bool {
+	if m.IsChatMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsChatMemberMock.invocationsDone()
+}
+
+// MinimockIsChatMemberInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockIsChatMemberInspect() {
+	for _, e := range m.IsChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsChatMemberCounter := mm_atomic.LoadUint64(&m.afterIsChatMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsChatMemberMock.defaultExpectation != nil && afterIsChatMemberCounter < 1 {
+		if m.IsChatMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s", m.IsChatMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s with params: %#v", m.IsChatMemberMock.defaultExpectation.expectationOrigins.origin, *m.IsChatMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsChatMember != nil && afterIsChatMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s", m.funcIsChatMemberOrigin)
+	}
+
+	if !m.IsChatMemberMock.invocationsDone() && afterIsChatMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.IsChatMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsChatMemberMock.expectedInvocations), m.IsChatMemberMock.expectedInvocationsOrigin, afterIsChatMemberCounter)
+	}
+}
+
+type mChatRepositoryMockListChatMembers struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListChatMembersExpectation
+	expectations       []*ChatRepositoryMockListChatMembersExpectation
+
+	callArgs []*ChatRepositoryMockListChatMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListChatMembersExpectation specifies expectation struct of the ChatRepository.ListChatMembers
+type ChatRepositoryMockListChatMembersExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListChatMembersParams
+	paramPtrs          *ChatRepositoryMockListChatMembersParamPtrs
+	expectationOrigins ChatRepositoryMockListChatMembersExpectationOrigins
+	results            *ChatRepositoryMockListChatMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListChatMembersParams contains parameters of the ChatRepository.ListChatMembers
+type ChatRepositoryMockListChatMembersParams struct {
+	ctx    context.Context
+	filter *model.MemberListFilter
+}
+
+// ChatRepositoryMockListChatMembersParamPtrs contains pointers to parameters of the ChatRepository.ListChatMembers
+type ChatRepositoryMockListChatMembersParamPtrs struct {
+	ctx    *context.Context
+	filter **model.MemberListFilter
+}
+
+// ChatRepositoryMockListChatMembersResults contains results of the ChatRepository.ListChatMembers
+type ChatRepositoryMockListChatMembersResults struct {
+	mpa1 []*model.Member
+	err  error
+}
+
+// ChatRepositoryMockListChatMembersOrigins contains origins of expectations of the ChatRepository.ListChatMembers
+type ChatRepositoryMockListChatMembersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) Optional() *mChatRepositoryMockListChatMembers {
+	mmListChatMembers.optional = true
+	return mmListChatMembers
+}
+
+// Expect sets up expected params for ChatRepository.ListChatMembers
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) Expect(ctx context.Context, filter *model.MemberListFilter) *mChatRepositoryMockListChatMembers {
+	if mmListChatMembers.mock.funcListChatMembers != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by Set")
+	}
+
+	if mmListChatMembers.defaultExpectation == nil {
+		mmListChatMembers.defaultExpectation = &ChatRepositoryMockListChatMembersExpectation{}
+	}
+
+	if mmListChatMembers.defaultExpectation.paramPtrs != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by ExpectParams functions")
+	}
+
+	mmListChatMembers.defaultExpectation.params = &ChatRepositoryMockListChatMembersParams{ctx, filter}
+	mmListChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChatMembers.expectations {
+		if minimock.Equal(e.params, mmListChatMembers.defaultExpectation.params) {
+			mmListChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChatMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmListChatMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListChatMembers
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListChatMembers {
+	if mmListChatMembers.mock.funcListChatMembers != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by Set")
+	}
+
+	if mmListChatMembers.defaultExpectation == nil {
+		mmListChatMembers.defaultExpectation = &ChatRepositoryMockListChatMembersExpectation{}
+	}
+
+	if mmListChatMembers.defaultExpectation.params != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by Expect")
+	}
+
+	if mmListChatMembers.defaultExpectation.paramPtrs == nil {
+		mmListChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatMembersParamPtrs{}
+	}
+	mmListChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChatMembers
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatRepository.ListChatMembers
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) ExpectFilterParam2(filter *model.MemberListFilter) *mChatRepositoryMockListChatMembers {
+	if mmListChatMembers.mock.funcListChatMembers != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by Set")
+	}
+
+	if mmListChatMembers.defaultExpectation == nil {
+		mmListChatMembers.defaultExpectation = &ChatRepositoryMockListChatMembersExpectation{}
+	}
+
+	if mmListChatMembers.defaultExpectation.params != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by Expect")
+	}
+
+	if mmListChatMembers.defaultExpectation.paramPtrs == nil {
+		mmListChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatMembersParamPtrs{}
+	}
+	mmListChatMembers.defaultExpectation.paramPtrs.filter = &filter
+	mmListChatMembers.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListChatMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListChatMembers
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) Inspect(f func(ctx context.Context, filter *model.MemberListFilter)) *mChatRepositoryMockListChatMembers {
+	if mmListChatMembers.mock.inspectFuncListChatMembers != nil {
+		mmListChatMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListChatMembers")
+	}
+
+	mmListChatMembers.mock.inspectFuncListChatMembers = f
+
+	return mmListChatMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.ListChatMembers
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) Return(mpa1 []*model.Member, err error) *ChatRepositoryMock {
+	if mmListChatMembers.mock.funcListChatMembers != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by Set")
+	}
+
+	if mmListChatMembers.defaultExpectation == nil {
+		mmListChatMembers.defaultExpectation = &ChatRepositoryMockListChatMembersExpectation{mock: mmListChatMembers.mock}
+	}
+	mmListChatMembers.defaultExpectation.results = &ChatRepositoryMockListChatMembersResults{mpa1, err}
+	mmListChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChatMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListChatMembers method
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) Set(f func(ctx context.Context, filter *model.MemberListFilter) (mpa1 []*model.Member, err error)) *ChatRepositoryMock {
+	if mmListChatMembers.defaultExpectation != nil {
+		mmListChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListChatMembers method")
+	}
+
+	if len(mmListChatMembers.expectations) > 0 {
+		mmListChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListChatMembers method")
+	}
+
+	mmListChatMembers.mock.funcListChatMembers = f
+	mmListChatMembers.mock.funcListChatMembersOrigin = minimock.CallerInfo(1)
+	return mmListChatMembers.mock
+}
+
+// When sets expectation for the ChatRepository.ListChatMembers which will trigger the result defined by the following
+// Then helper
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) When(ctx context.Context, filter *model.MemberListFilter) *ChatRepositoryMockListChatMembersExpectation {
+	if mmListChatMembers.mock.funcListChatMembers != nil {
+		mmListChatMembers.mock.t.Fatalf("ChatRepositoryMock.ListChatMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListChatMembersExpectation{
+		mock:               mmListChatMembers.mock,
+		params:             &ChatRepositoryMockListChatMembersParams{ctx, filter},
+		expectationOrigins: ChatRepositoryMockListChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChatMembers.expectations = append(mmListChatMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListChatMembersExpectation) Then(mpa1 []*model.Member, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListChatMembersResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListChatMembers should be invoked
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) Times(n uint64) *mChatRepositoryMockListChatMembers {
+	if n == 0 {
+		mmListChatMembers.mock.t.Fatalf("Times of ChatRepositoryMock.ListChatMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChatMembers.expectedInvocations, n)
+	mmListChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChatMembers
+}
+
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) invocationsDone() bool {
+	if len(mmListChatMembers.expectations) == 0 && mmListChatMembers.defaultExpectation == nil && mmListChatMembers.mock.funcListChatMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChatMembers.mock.afterListChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChatMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChatMembers implements mm_repository.ChatRepository
+func (mmListChatMembers *ChatRepositoryMock) ListChatMembers(ctx context.Context, filter *model.MemberListFilter) (mpa1 []*model.Member, err error) {
+	mm_atomic.AddUint64(&mmListChatMembers.beforeListChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChatMembers.afterListChatMembersCounter, 1)
+
+	mmListChatMembers.t.Helper()
+
+	if mmListChatMembers.inspectFuncListChatMembers != nil {
+		mmListChatMembers.inspectFuncListChatMembers(ctx, filter)
+	}
+
+	mm_params := ChatRepositoryMockListChatMembersParams{ctx, filter}
+
+	// Record call args
+	mmListChatMembers.ListChatMembersMock.mutex.Lock()
+	mmListChatMembers.ListChatMembersMock.callArgs = append(mmListChatMembers.ListChatMembersMock.callArgs, &mm_params)
+	mmListChatMembers.ListChatMembersMock.mutex.Unlock()
+
+	for _, e := range mmListChatMembers.ListChatMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListChatMembers.ListChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChatMembers.ListChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChatMembers.ListChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmListChatMembers.ListChatMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListChatMembersParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChatMembers.t.Errorf("ChatRepositoryMock.ListChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChatMembers.ListChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListChatMembers.t.Errorf("ChatRepositoryMock.ListChatMembers got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChatMembers.ListChatMembersMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChatMembers.t.Errorf("ChatRepositoryMock.ListChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChatMembers.ListChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChatMembers.ListChatMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChatMembers.t.Fatal("No results are set for the ChatRepositoryMock.ListChatMembers")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListChatMembers.funcListChatMembers != nil {
+		return mmListChatMembers.funcListChatMembers(ctx, filter)
+	}
+	mmListChatMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.ListChatMembers. %v %v", ctx, filter)
+	return
+}
+
+// ListChatMembersAfterCounter returns a count of finished ChatRepositoryMock.ListChatMembers invocations
+func (mmListChatMembers *ChatRepositoryMock) ListChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChatMembers.afterListChatMembersCounter)
+}
+
+// ListChatMembersBeforeCounter returns a count of ChatRepositoryMock.ListChatMembers invocations
+func (mmListChatMembers *ChatRepositoryMock) ListChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChatMembers.beforeListChatMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListChatMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChatMembers *mChatRepositoryMockListChatMembers) Calls() []*ChatRepositoryMockListChatMembersParams {
+	mmListChatMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListChatMembersParams, len(mmListChatMembers.callArgs))
+	copy(argCopy, mmListChatMembers.callArgs)
+
+	mmListChatMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChatMembersDone returns true if the count of the ListChatMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListChatMembersDone() bool {
+	if m.ListChatMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChatMembersMock.invocationsDone()
+}
+
+// MinimockListChatMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListChatMembersInspect() {
+	for _, e := range m.ListChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChatMembersCounter := mm_atomic.LoadUint64(&m.afterListChatMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChatMembersMock.defaultExpectation != nil && afterListChatMembersCounter < 1 {
+		if m.ListChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChatMembers at\n%s", m.ListChatMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChatMembers at\n%s with params: %#v", m.ListChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.ListChatMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChatMembers != nil && afterListChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListChatMembers at\n%s", m.funcListChatMembersOrigin)
+	}
+
+	if !m.ListChatMembersMock.invocationsDone() && afterListChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatMembersMock.expectedInvocations), m.ListChatMembersMock.expectedInvocationsOrigin, afterListChatMembersCounter)
+	}
+}
+
+type mChatRepositoryMockListMessages struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListMessagesExpectation
+	expectations       []*ChatRepositoryMockListMessagesExpectation
+
+	callArgs []*ChatRepositoryMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListMessagesExpectation specifies expectation struct of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListMessagesParams
+	paramPtrs          *ChatRepositoryMockListMessagesParamPtrs
+	expectationOrigins ChatRepositoryMockListMessagesExpectationOrigins
+	results            *ChatRepositoryMockListMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListMessagesParams contains parameters of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesParams struct {
+	ctx    context.Context
+	filter *model.MessageListFilter
+}
+
+// ChatRepositoryMockListMessagesParamPtrs contains pointers to parameters of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesParamPtrs struct {
+	ctx    *context.Context
+	filter **model.MessageListFilter
+}
+
+// ChatRepositoryMockListMessagesResults contains results of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatRepositoryMockListMessagesOrigins contains origins of expectations of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mChatRepositoryMockListMessages) Optional() *mChatRepositoryMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) Expect(ctx context.Context, filter *model.MessageListFilter) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &ChatRepositoryMockListMessagesParams{ctx, filter}
+	mmListMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) ExpectFilterParam2(filter *model.MessageListFilter) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.filter = &filter
+	mmListMessages.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) Inspect(f func(ctx context.Context, filter *model.MessageListFilter)) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) Return(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &ChatRepositoryMockListMessagesResults{mpa1, err}
+	mmListMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListMessages method
+func (mmListMessages *mChatRepositoryMockListMessages) Set(f func(ctx context.Context, filter *model.MessageListFilter) (mpa1 []*model.Message, err error)) *ChatRepositoryMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	mmListMessages.mock.funcListMessagesOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// When sets expectation for the ChatRepository.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mChatRepositoryMockListMessages) When(ctx context.Context, filter *model.MessageListFilter) *ChatRepositoryMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListMessagesExpectation{
+		mock:               mmListMessages.mock,
+		params:             &ChatRepositoryMockListMessagesParams{ctx, filter},
+		expectationOrigins: ChatRepositoryMockListMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListMessages return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListMessagesExpectation) Then(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListMessagesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListMessages should be invoked
+func (mmListMessages *mChatRepositoryMockListMessages) Times(n uint64) *mChatRepositoryMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of ChatRepositoryMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	mmListMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessages
+}
+
+func (mmListMessages *mChatRepositoryMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements mm_repository.ChatRepository
+func (mmListMessages *ChatRepositoryMock) ListMessages(ctx context.Context, filter *model.MessageListFilter) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	mmListMessages.t.Helper()
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, filter)
+	}
+
+	mm_params := ChatRepositoryMockListMessagesParams{ctx, filter}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListMessagesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("ChatRepositoryMock.ListMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListMessages.t.Errorf("ChatRepositoryMock.ListMessages got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("ChatRepositoryMock.ListMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the ChatRepositoryMock.ListMessages")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, filter)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to ChatRepositoryMock.ListMessages. %v %v", ctx, filter)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished ChatRepositoryMock.ListMessages invocations
+func (mmListMessages *ChatRepositoryMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of ChatRepositoryMock.ListMessages invocations
+func (mmListMessages *ChatRepositoryMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mChatRepositoryMockListMessages) Calls() []*ChatRepositoryMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListMessages at\n%s", m.ListMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListMessages at\n%s with params: %#v", m.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListMessages at\n%s", m.funcListMessagesOrigin)
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), m.ListMessagesMock.expectedInvocationsOrigin, afterListMessagesCounter)
+	}
+}
+
+type mChatRepositoryMockListReadReceipts struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListReadReceiptsExpectation
+	expectations       []*ChatRepositoryMockListReadReceiptsExpectation
+
+	callArgs []*ChatRepositoryMockListReadReceiptsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListReadReceiptsExpectation specifies expectation struct of the ChatRepository.ListReadReceipts
+type ChatRepositoryMockListReadReceiptsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListReadReceiptsParams
+	paramPtrs          *ChatRepositoryMockListReadReceiptsParamPtrs
+	expectationOrigins ChatRepositoryMockListReadReceiptsExpectationOrigins
+	results            *ChatRepositoryMockListReadReceiptsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListReadReceiptsParams contains parameters of the ChatRepository.ListReadReceipts
+type ChatRepositoryMockListReadReceiptsParams struct {
+	ctx     context.Context
+	message *model.Message
+}
+
+// ChatRepositoryMockListReadReceiptsParamPtrs contains pointers to parameters of the ChatRepository.ListReadReceipts
+type ChatRepositoryMockListReadReceiptsParamPtrs struct {
+	ctx     *context.Context
+	message **model.Message
+}
+
+// ChatRepositoryMockListReadReceiptsResults contains results of the ChatRepository.ListReadReceipts
+type ChatRepositoryMockListReadReceiptsResults struct {
+	rpa1 []*model.ReadReceipt
+	err  error
+}
+
+// ChatRepositoryMockListReadReceiptsOrigins contains origins of expectations of the ChatRepository.ListReadReceipts
+type ChatRepositoryMockListReadReceiptsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) Optional() *mChatRepositoryMockListReadReceipts {
+	mmListReadReceipts.optional = true
+	return mmListReadReceipts
+}
+
+// Expect sets up expected params for ChatRepository.ListReadReceipts
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) Expect(ctx context.Context, message *model.Message) *mChatRepositoryMockListReadReceipts {
+	if mmListReadReceipts.mock.funcListReadReceipts != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by Set")
+	}
+
+	if mmListReadReceipts.defaultExpectation == nil {
+		mmListReadReceipts.defaultExpectation = &ChatRepositoryMockListReadReceiptsExpectation{}
+	}
+
+	if mmListReadReceipts.defaultExpectation.paramPtrs != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by ExpectParams functions")
+	}
+
+	mmListReadReceipts.defaultExpectation.params = &ChatRepositoryMockListReadReceiptsParams{ctx, message}
+	mmListReadReceipts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReadReceipts.expectations {
+		if minimock.Equal(e.params, mmListReadReceipts.defaultExpectation.params) {
+			mmListReadReceipts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReadReceipts.defaultExpectation.params)
+		}
+	}
+
+	return mmListReadReceipts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListReadReceipts
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListReadReceipts {
+	if mmListReadReceipts.mock.funcListReadReceipts != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by Set")
+	}
+
+	if mmListReadReceipts.defaultExpectation == nil {
+		mmListReadReceipts.defaultExpectation = &ChatRepositoryMockListReadReceiptsExpectation{}
+	}
+
+	if mmListReadReceipts.defaultExpectation.params != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by Expect")
+	}
+
+	if mmListReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmListReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockListReadReceiptsParamPtrs{}
+	}
+	mmListReadReceipts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReadReceipts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReadReceipts
+}
+
+// ExpectMessageParam2 sets up expected param message for ChatRepository.ListReadReceipts
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) ExpectMessageParam2(message *model.Message) *mChatRepositoryMockListReadReceipts {
+	if mmListReadReceipts.mock.funcListReadReceipts != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by Set")
+	}
+
+	if mmListReadReceipts.defaultExpectation == nil {
+		mmListReadReceipts.defaultExpectation = &ChatRepositoryMockListReadReceiptsExpectation{}
+	}
+
+	if mmListReadReceipts.defaultExpectation.params != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by Expect")
+	}
+
+	if mmListReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmListReadReceipts.defaultExpectation.paramPtrs = &ChatRepositoryMockListReadReceiptsParamPtrs{}
+	}
+	mmListReadReceipts.defaultExpectation.paramPtrs.message = &message
+	mmListReadReceipts.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmListReadReceipts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListReadReceipts
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) Inspect(f func(ctx context.Context, message *model.Message)) *mChatRepositoryMockListReadReceipts {
+	if mmListReadReceipts.mock.inspectFuncListReadReceipts != nil {
+		mmListReadReceipts.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListReadReceipts")
+	}
+
+	mmListReadReceipts.mock.inspectFuncListReadReceipts = f
+
+	return mmListReadReceipts
+}
+
+// Return sets up results that will be returned by ChatRepository.ListReadReceipts
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) Return(rpa1 []*model.ReadReceipt, err error) *ChatRepositoryMock {
+	if mmListReadReceipts.mock.funcListReadReceipts != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by Set")
+	}
+
+	if mmListReadReceipts.defaultExpectation == nil {
+		mmListReadReceipts.defaultExpectation = &ChatRepositoryMockListReadReceiptsExpectation{mock: mmListReadReceipts.mock}
+	}
+	mmListReadReceipts.defaultExpectation.results = &ChatRepositoryMockListReadReceiptsResults{rpa1, err}
+	mmListReadReceipts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReadReceipts.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListReadReceipts method
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) Set(f func(ctx context.Context, message *model.Message) (rpa1 []*model.ReadReceipt, err error)) *ChatRepositoryMock {
+	if mmListReadReceipts.defaultExpectation != nil {
+		mmListReadReceipts.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListReadReceipts method")
+	}
+
+	if len(mmListReadReceipts.expectations) > 0 {
+		mmListReadReceipts.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListReadReceipts method")
+	}
+
+	mmListReadReceipts.mock.funcListReadReceipts = f
+	mmListReadReceipts.mock.funcListReadReceiptsOrigin = minimock.CallerInfo(1)
+	return mmListReadReceipts.mock
+}
+
+// When sets expectation for the ChatRepository.ListReadReceipts which will trigger the result defined by the following
+// Then helper
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) When(ctx context.Context, message *model.Message) *ChatRepositoryMockListReadReceiptsExpectation {
+	if mmListReadReceipts.mock.funcListReadReceipts != nil {
+		mmListReadReceipts.mock.t.Fatalf("ChatRepositoryMock.ListReadReceipts mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListReadReceiptsExpectation{
+		mock:               mmListReadReceipts.mock,
+		params:             &ChatRepositoryMockListReadReceiptsParams{ctx, message},
+		expectationOrigins: ChatRepositoryMockListReadReceiptsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReadReceipts.expectations = append(mmListReadReceipts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListReadReceipts return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListReadReceiptsExpectation) Then(rpa1 []*model.ReadReceipt, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListReadReceiptsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListReadReceipts should be invoked
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) Times(n uint64) *mChatRepositoryMockListReadReceipts {
+	if n == 0 {
+		mmListReadReceipts.mock.t.Fatalf("Times of ChatRepositoryMock.ListReadReceipts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReadReceipts.expectedInvocations, n)
+	mmListReadReceipts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReadReceipts
+}
+
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) invocationsDone() bool {
+	if len(mmListReadReceipts.expectations) == 0 && mmListReadReceipts.defaultExpectation == nil && mmListReadReceipts.mock.funcListReadReceipts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReadReceipts.mock.afterListReadReceiptsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReadReceipts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReadReceipts implements mm_repository.ChatRepository
+func (mmListReadReceipts *ChatRepositoryMock) ListReadReceipts(ctx context.Context, message *model.Message) (rpa1 []*model.ReadReceipt, err error) {
+	mm_atomic.AddUint64(&mmListReadReceipts.beforeListReadReceiptsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReadReceipts.afterListReadReceiptsCounter, 1)
+
+	mmListReadReceipts.t.Helper()
+
+	if mmListReadReceipts.inspectFuncListReadReceipts != nil {
+		mmListReadReceipts.inspectFuncListReadReceipts(ctx, message)
+	}
+
+	mm_params := ChatRepositoryMockListReadReceiptsParams{ctx, message}
+
+	// Record call args
+	mmListReadReceipts.ListReadReceiptsMock.mutex.Lock()
+	mmListReadReceipts.ListReadReceiptsMock.callArgs = append(mmListReadReceipts.ListReadReceiptsMock.callArgs, &mm_params)
+	mmListReadReceipts.ListReadReceiptsMock.mutex.Unlock()
+
+	for _, e := range mmListReadReceipts.ListReadReceiptsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmListReadReceipts.ListReadReceiptsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReadReceipts.ListReadReceiptsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReadReceipts.ListReadReceiptsMock.defaultExpectation.params
+		mm_want_ptrs := mmListReadReceipts.ListReadReceiptsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListReadReceiptsParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReadReceipts.t.Errorf("ChatRepositoryMock.ListReadReceipts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReadReceipts.ListReadReceiptsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmListReadReceipts.t.Errorf("ChatRepositoryMock.ListReadReceipts got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReadReceipts.ListReadReceiptsMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReadReceipts.t.Errorf("ChatRepositoryMock.ListReadReceipts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReadReceipts.ListReadReceiptsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReadReceipts.ListReadReceiptsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReadReceipts.t.Fatal("No results are set for the ChatRepositoryMock.ListReadReceipts")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmListReadReceipts.funcListReadReceipts != nil {
+		return mmListReadReceipts.funcListReadReceipts(ctx, message)
+	}
+	mmListReadReceipts.t.Fatalf("Unexpected call to ChatRepositoryMock.ListReadReceipts. %v %v", ctx, message)
+	return
+}
+
+// ListReadReceiptsAfterCounter returns a count of finished ChatRepositoryMock.ListReadReceipts invocations
+func (mmListReadReceipts *ChatRepositoryMock) ListReadReceiptsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReadReceipts.afterListReadReceiptsCounter)
+}
+
+// ListReadReceiptsBeforeCounter returns a count of ChatRepositoryMock.ListReadReceipts invocations
+func (mmListReadReceipts *ChatRepositoryMock) ListReadReceiptsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReadReceipts.beforeListReadReceiptsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListReadReceipts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReadReceipts *mChatRepositoryMockListReadReceipts) Calls() []*ChatRepositoryMockListReadReceiptsParams {
+	mmListReadReceipts.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListReadReceiptsParams, len(mmListReadReceipts.callArgs))
+	copy(argCopy, mmListReadReceipts.callArgs)
+
+	mmListReadReceipts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListReadReceiptsDone returns true if the count of the ListReadReceipts invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListReadReceiptsDone() bool {
+	if m.ListReadReceiptsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListReadReceiptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListReadReceiptsMock.invocationsDone()
+}
+
+// MinimockListReadReceiptsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListReadReceiptsInspect() {
+	for _, e := range m.ListReadReceiptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListReadReceipts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListReadReceiptsCounter := mm_atomic.LoadUint64(&m.afterListReadReceiptsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListReadReceiptsMock.defaultExpectation != nil && afterListReadReceiptsCounter < 1 {
+		if m.ListReadReceiptsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListReadReceipts at\n%s", m.ListReadReceiptsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListReadReceipts at\n%s with params: %#v", m.ListReadReceiptsMock.defaultExpectation.expectationOrigins.origin, *m.ListReadReceiptsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReadReceipts != nil && afterListReadReceiptsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListReadReceipts at\n%s", m.funcListReadReceiptsOrigin)
+	}
+
+	if !m.ListReadReceiptsMock.invocationsDone() && afterListReadReceiptsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListReadReceipts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListReadReceiptsMock.expectedInvocations), m.ListReadReceiptsMock.expectedInvocationsOrigin, afterListReadReceiptsCounter)
+	}
+}
+
+type mChatRepositoryMockListThreadReplies struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListThreadRepliesExpectation
+	expectations       []*ChatRepositoryMockListThreadRepliesExpectation
+
+	callArgs []*ChatRepositoryMockListThreadRepliesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListThreadRepliesExpectation specifies expectation struct of the ChatRepository.ListThreadReplies
+type ChatRepositoryMockListThreadRepliesExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListThreadRepliesParams
+	paramPtrs          *ChatRepositoryMockListThreadRepliesParamPtrs
+	expectationOrigins ChatRepositoryMockListThreadRepliesExpectationOrigins
+	results            *ChatRepositoryMockListThreadRepliesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListThreadRepliesParams contains parameters of the ChatRepository.ListThreadReplies
+type ChatRepositoryMockListThreadRepliesParams struct {
+	ctx    context.Context
+	filter *model.ThreadFilter
+}
+
+// ChatRepositoryMockListThreadRepliesParamPtrs contains pointers to parameters of the ChatRepository.ListThreadReplies
+type ChatRepositoryMockListThreadRepliesParamPtrs struct {
+	ctx    *context.Context
+	filter **model.ThreadFilter
+}
+
+// ChatRepositoryMockListThreadRepliesResults contains results of the ChatRepository.ListThreadReplies
+type ChatRepositoryMockListThreadRepliesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatRepositoryMockListThreadRepliesOrigins contains origins of expectations of the ChatRepository.ListThreadReplies
+type ChatRepositoryMockListThreadRepliesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) Optional() *mChatRepositoryMockListThreadReplies {
+	mmListThreadReplies.optional = true
+	return mmListThreadReplies
+}
+
+// Expect sets up expected params for ChatRepository.ListThreadReplies
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) Expect(ctx context.Context, filter *model.ThreadFilter) *mChatRepositoryMockListThreadReplies {
+	if mmListThreadReplies.mock.funcListThreadReplies != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by Set")
+	}
+
+	if mmListThreadReplies.defaultExpectation == nil {
+		mmListThreadReplies.defaultExpectation = &ChatRepositoryMockListThreadRepliesExpectation{}
+	}
+
+	if mmListThreadReplies.defaultExpectation.paramPtrs != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by ExpectParams functions")
+	}
+
+	mmListThreadReplies.defaultExpectation.params = &ChatRepositoryMockListThreadRepliesParams{ctx, filter}
+	mmListThreadReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListThreadReplies.expectations {
+		if minimock.Equal(e.params, mmListThreadReplies.defaultExpectation.params) {
+			mmListThreadReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListThreadReplies.defaultExpectation.params)
+		}
+	}
+
+	return mmListThreadReplies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListThreadReplies
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListThreadReplies {
+	if mmListThreadReplies.mock.funcListThreadReplies != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by Set")
+	}
+
+	if mmListThreadReplies.defaultExpectation == nil {
+		mmListThreadReplies.defaultExpectation = &ChatRepositoryMockListThreadRepliesExpectation{}
+	}
+
+	if mmListThreadReplies.defaultExpectation.params != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by Expect")
+	}
+
+	if mmListThreadReplies.defaultExpectation.paramPtrs == nil {
+		mmListThreadReplies.defaultExpectation.paramPtrs = &ChatRepositoryMockListThreadRepliesParamPtrs{}
+	}
+	mmListThreadReplies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListThreadReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListThreadReplies
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatRepository.ListThreadReplies
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) ExpectFilterParam2(filter *model.ThreadFilter) *mChatRepositoryMockListThreadReplies {
+	if mmListThreadReplies.mock.funcListThreadReplies != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by Set")
+	}
+
+	if mmListThreadReplies.defaultExpectation == nil {
+		mmListThreadReplies.defaultExpectation = &ChatRepositoryMockListThreadRepliesExpectation{}
+	}
+
+	if mmListThreadReplies.defaultExpectation.params != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by Expect")
+	}
+
+	if mmListThreadReplies.defaultExpectation.paramPtrs == nil {
+		mmListThreadReplies.defaultExpectation.paramPtrs = &ChatRepositoryMockListThreadRepliesParamPtrs{}
+	}
+	mmListThreadReplies.defaultExpectation.paramPtrs.filter = &filter
+	mmListThreadReplies.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListThreadReplies
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListThreadReplies
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) Inspect(f func(ctx context.Context, filter *model.ThreadFilter)) *mChatRepositoryMockListThreadReplies {
+	if mmListThreadReplies.mock.inspectFuncListThreadReplies != nil {
+		mmListThreadReplies.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListThreadReplies")
+	}
+
+	mmListThreadReplies.mock.inspectFuncListThreadReplies = f
+
+	return mmListThreadReplies
+}
+
+// Return sets up results that will be returned by ChatRepository.ListThreadReplies
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) Return(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	if mmListThreadReplies.mock.funcListThreadReplies != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by Set")
+	}
+
+	if mmListThreadReplies.defaultExpectation == nil {
+		mmListThreadReplies.defaultExpectation = &ChatRepositoryMockListThreadRepliesExpectation{mock: mmListThreadReplies.mock}
+	}
+	mmListThreadReplies.defaultExpectation.results = &ChatRepositoryMockListThreadRepliesResults{mpa1, err}
+	mmListThreadReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListThreadReplies.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListThreadReplies method
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) Set(f func(ctx context.Context, filter *model.ThreadFilter) (mpa1 []*model.Message, err error)) *ChatRepositoryMock {
+	if mmListThreadReplies.defaultExpectation != nil {
+		mmListThreadReplies.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListThreadReplies method")
+	}
+
+	if len(mmListThreadReplies.expectations) > 0 {
+		mmListThreadReplies.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListThreadReplies method")
+	}
+
+	mmListThreadReplies.mock.funcListThreadReplies = f
+	mmListThreadReplies.mock.funcListThreadRepliesOrigin = minimock.CallerInfo(1)
+	return mmListThreadReplies.mock
+}
+
+// When sets expectation for the ChatRepository.ListThreadReplies which will trigger the result defined by the following
+// Then helper
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) When(ctx context.Context, filter *model.ThreadFilter) *ChatRepositoryMockListThreadRepliesExpectation {
+	if mmListThreadReplies.mock.funcListThreadReplies != nil {
+		mmListThreadReplies.mock.t.Fatalf("ChatRepositoryMock.ListThreadReplies mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListThreadRepliesExpectation{
+		mock:               mmListThreadReplies.mock,
+		params:             &ChatRepositoryMockListThreadRepliesParams{ctx, filter},
+		expectationOrigins: ChatRepositoryMockListThreadRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListThreadReplies.expectations = append(mmListThreadReplies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListThreadReplies return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListThreadRepliesExpectation) Then(mpa1 []*model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListThreadRepliesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListThreadReplies should be invoked
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) Times(n uint64) *mChatRepositoryMockListThreadReplies {
+	if n == 0 {
+		mmListThreadReplies.mock.t.Fatalf("Times of ChatRepositoryMock.ListThreadReplies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListThreadReplies.expectedInvocations, n)
+	mmListThreadReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListThreadReplies
+}
+
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) invocationsDone() bool {
+	if len(mmListThreadReplies.expectations) == 0 && mmListThreadReplies.defaultExpectation == nil && mmListThreadReplies.mock.funcListThreadReplies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListThreadReplies.mock.afterListThreadRepliesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListThreadReplies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListThreadReplies implements mm_repository.ChatRepository
+func (mmListThreadReplies *ChatRepositoryMock) ListThreadReplies(ctx context.Context, filter *model.ThreadFilter) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListThreadReplies.beforeListThreadRepliesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListThreadReplies.afterListThreadRepliesCounter, 1)
+
+	mmListThreadReplies.t.Helper()
+
+	if mmListThreadReplies.inspectFuncListThreadReplies != nil {
+		mmListThreadReplies.inspectFuncListThreadReplies(ctx, filter)
+	}
+
+	mm_params := ChatRepositoryMockListThreadRepliesParams{ctx, filter}
+
+	// Record call args
+	mmListThreadReplies.ListThreadRepliesMock.mutex.Lock()
+	mmListThreadReplies.ListThreadRepliesMock.callArgs = append(mmListThreadReplies.ListThreadRepliesMock.callArgs, &mm_params)
+	mmListThreadReplies.ListThreadRepliesMock.mutex.Unlock()
+
+	for _, e := range mmListThreadReplies.ListThreadRepliesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListThreadReplies.ListThreadRepliesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListThreadReplies.ListThreadRepliesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListThreadReplies.ListThreadRepliesMock.defaultExpectation.params
+		mm_want_ptrs := mmListThreadReplies.ListThreadRepliesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListThreadRepliesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListThreadReplies.t.Errorf("ChatRepositoryMock.ListThreadReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThreadReplies.ListThreadRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListThreadReplies.t.Errorf("ChatRepositoryMock.ListThreadReplies got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThreadReplies.ListThreadRepliesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListThreadReplies.t.Errorf("ChatRepositoryMock.ListThreadReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListThreadReplies.ListThreadRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListThreadReplies.ListThreadRepliesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListThreadReplies.t.Fatal("No results are set for the ChatRepositoryMock.ListThreadReplies")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListThreadReplies.funcListThreadReplies != nil {
+		return mmListThreadReplies.funcListThreadReplies(ctx, filter)
+	}
+	mmListThreadReplies.t.Fatalf("Unexpected call to ChatRepositoryMock.ListThreadReplies. %v %v", ctx, filter)
+	return
+}
+
+// ListThreadRepliesAfterCounter returns a count of finished ChatRepositoryMock.ListThreadReplies invocations
+func (mmListThreadReplies *ChatRepositoryMock) ListThreadRepliesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThreadReplies.afterListThreadRepliesCounter)
+}
+
+// ListThreadRepliesBeforeCounter returns a count of ChatRepositoryMock.ListThreadReplies invocations
+func (mmListThreadReplies *ChatRepositoryMock) ListThreadRepliesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThreadReplies.beforeListThreadRepliesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListThreadReplies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListThreadReplies *mChatRepositoryMockListThreadReplies) Calls() []*ChatRepositoryMockListThreadRepliesParams {
+	mmListThreadReplies.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListThreadRepliesParams, len(mmListThreadReplies.callArgs))
+	copy(argCopy, mmListThreadReplies.callArgs)
+
+	mmListThreadReplies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListThreadRepliesDone returns true if the count of the ListThreadReplies invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListThreadRepliesDone() bool {
+	if m.ListThreadRepliesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListThreadRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListThreadRepliesMock.invocationsDone()
+}
+
+// MinimockListThreadRepliesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListThreadRepliesInspect() {
+	for _, e := range m.ListThreadRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListThreadReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListThreadRepliesCounter := mm_atomic.LoadUint64(&m.afterListThreadRepliesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListThreadRepliesMock.defaultExpectation != nil && afterListThreadRepliesCounter < 1 {
+		if m.ListThreadRepliesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListThreadReplies at\n%s", m.ListThreadRepliesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListThreadReplies at\n%s with params: %#v", m.ListThreadRepliesMock.defaultExpectation.expectationOrigins.origin, *m.ListThreadRepliesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListThreadReplies != nil && afterListThreadRepliesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListThreadReplies at\n%s", m.funcListThreadRepliesOrigin)
+	}
+
+	if !m.ListThreadRepliesMock.invocationsDone() && afterListThreadRepliesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListThreadReplies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListThreadRepliesMock.expectedInvocations), m.ListThreadRepliesMock.expectedInvocationsOrigin, afterListThreadRepliesCounter)
+	}
+}
+
+type mChatRepositoryMockListUserChats struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListUserChatsExpectation
+	expectations       []*ChatRepositoryMockListUserChatsExpectation
+
+	callArgs []*ChatRepositoryMockListUserChatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListUserChatsExpectation specifies expectation struct of the ChatRepository.ListUserChats
+type ChatRepositoryMockListUserChatsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListUserChatsParams
+	paramPtrs          *ChatRepositoryMockListUserChatsParamPtrs
+	expectationOrigins ChatRepositoryMockListUserChatsExpectationOrigins
+	results            *ChatRepositoryMockListUserChatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListUserChatsParams contains parameters of the ChatRepository.ListUserChats
+type ChatRepositoryMockListUserChatsParams struct {
+	ctx    context.Context
+	filter *model.ChatListFilter
+}
+
+// ChatRepositoryMockListUserChatsParamPtrs contains pointers to parameters of the ChatRepository.ListUserChats
+type ChatRepositoryMockListUserChatsParamPtrs struct {
+	ctx    *context.Context
+	filter **model.ChatListFilter
+}
+
+// ChatRepositoryMockListUserChatsResults contains results of the ChatRepository.ListUserChats
+type ChatRepositoryMockListUserChatsResults struct {
+	cpa1 []*model.Chat
+	err  error
+}
+
+// ChatRepositoryMockListUserChatsOrigins contains origins of expectations of the ChatRepository.ListUserChats
+type ChatRepositoryMockListUserChatsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUserChats *mChatRepositoryMockListUserChats) Optional() *mChatRepositoryMockListUserChats {
+	mmListUserChats.optional = true
+	return mmListUserChats
+}
+
+// Expect sets up expected params for ChatRepository.ListUserChats
+func (mmListUserChats *mChatRepositoryMockListUserChats) Expect(ctx context.Context, filter *model.ChatListFilter) *mChatRepositoryMockListUserChats {
+	if mmListUserChats.mock.funcListUserChats != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by Set")
+	}
+
+	if mmListUserChats.defaultExpectation == nil {
+		mmListUserChats.defaultExpectation = &ChatRepositoryMockListUserChatsExpectation{}
+	}
+
+	if mmListUserChats.defaultExpectation.paramPtrs != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by ExpectParams functions")
+	}
+
+	mmListUserChats.defaultExpectation.params = &ChatRepositoryMockListUserChatsParams{ctx, filter}
+	mmListUserChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUserChats.expectations {
+		if minimock.Equal(e.params, mmListUserChats.defaultExpectation.params) {
+			mmListUserChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserChats.defaultExpectation.params)
+		}
+	}
+
+	return mmListUserChats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListUserChats
+func (mmListUserChats *mChatRepositoryMockListUserChats) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListUserChats {
+	if mmListUserChats.mock.funcListUserChats != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by Set")
+	}
+
+	if mmListUserChats.defaultExpectation == nil {
+		mmListUserChats.defaultExpectation = &ChatRepositoryMockListUserChatsExpectation{}
+	}
+
+	if mmListUserChats.defaultExpectation.params != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by Expect")
+	}
+
+	if mmListUserChats.defaultExpectation.paramPtrs == nil {
+		mmListUserChats.defaultExpectation.paramPtrs = &ChatRepositoryMockListUserChatsParamPtrs{}
+	}
+	mmListUserChats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUserChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUserChats
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatRepository.ListUserChats
+func (mmListUserChats *mChatRepositoryMockListUserChats) ExpectFilterParam2(filter *model.ChatListFilter) *mChatRepositoryMockListUserChats {
+	if mmListUserChats.mock.funcListUserChats != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by Set")
+	}
+
+	if mmListUserChats.defaultExpectation == nil {
+		mmListUserChats.defaultExpectation = &ChatRepositoryMockListUserChatsExpectation{}
+	}
+
+	if mmListUserChats.defaultExpectation.params != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by Expect")
+	}
+
+	if mmListUserChats.defaultExpectation.paramPtrs == nil {
+		mmListUserChats.defaultExpectation.paramPtrs = &ChatRepositoryMockListUserChatsParamPtrs{}
+	}
+	mmListUserChats.defaultExpectation.paramPtrs.filter = &filter
+	mmListUserChats.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListUserChats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListUserChats
+func (mmListUserChats *mChatRepositoryMockListUserChats) Inspect(f func(ctx context.Context, filter *model.ChatListFilter)) *mChatRepositoryMockListUserChats {
+	if mmListUserChats.mock.inspectFuncListUserChats != nil {
+		mmListUserChats.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListUserChats")
+	}
+
+	mmListUserChats.mock.inspectFuncListUserChats = f
+
+	return mmListUserChats
+}
+
+// Return sets up results that will be returned by ChatRepository.ListUserChats
+func (mmListUserChats *mChatRepositoryMockListUserChats) Return(cpa1 []*model.Chat, err error) *ChatRepositoryMock {
+	if mmListUserChats.mock.funcListUserChats != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by Set")
+	}
+
+	if mmListUserChats.defaultExpectation == nil {
+		mmListUserChats.defaultExpectation = &ChatRepositoryMockListUserChatsExpectation{mock: mmListUserChats.mock}
+	}
+	mmListUserChats.defaultExpectation.results = &ChatRepositoryMockListUserChatsResults{cpa1, err}
+	mmListUserChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUserChats.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListUserChats method
+func (mmListUserChats *mChatRepositoryMockListUserChats) Set(f func(ctx context.Context, filter *model.ChatListFilter) (cpa1 []*model.Chat, err error)) *ChatRepositoryMock {
+	if mmListUserChats.defaultExpectation != nil {
+		mmListUserChats.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListUserChats method")
+	}
+
+	if len(mmListUserChats.expectations) > 0 {
+		mmListUserChats.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListUserChats method")
+	}
+
+	mmListUserChats.mock.funcListUserChats = f
+	mmListUserChats.mock.funcListUserChatsOrigin = minimock.CallerInfo(1)
+	return mmListUserChats.mock
+}
+
+// When sets expectation for the ChatRepository.ListUserChats which will trigger the result defined by the following
+// Then helper
+func (mmListUserChats *mChatRepositoryMockListUserChats) When(ctx context.Context, filter *model.ChatListFilter) *ChatRepositoryMockListUserChatsExpectation {
+	if mmListUserChats.mock.funcListUserChats != nil {
+		mmListUserChats.mock.t.Fatalf("ChatRepositoryMock.ListUserChats mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListUserChatsExpectation{
+		mock:               mmListUserChats.mock,
+		params:             &ChatRepositoryMockListUserChatsParams{ctx, filter},
+		expectationOrigins: ChatRepositoryMockListUserChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUserChats.expectations = append(mmListUserChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListUserChats return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListUserChatsExpectation) Then(cpa1 []*model.Chat, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListUserChatsResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListUserChats should be invoked
+func (mmListUserChats *mChatRepositoryMockListUserChats) Times(n uint64) *mChatRepositoryMockListUserChats {
+	if n == 0 {
+		mmListUserChats.mock.t.Fatalf("Times of ChatRepositoryMock.ListUserChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUserChats.expectedInvocations, n)
+	mmListUserChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUserChats
+}
+
+func (mmListUserChats *mChatRepositoryMockListUserChats) invocationsDone() bool {
+	if len(mmListUserChats.expectations) == 0 && mmListUserChats.defaultExpectation == nil && mmListUserChats.mock.funcListUserChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUserChats.mock.afterListUserChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUserChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUserChats implements mm_repository.ChatRepository
+func (mmListUserChats *ChatRepositoryMock) ListUserChats(ctx context.Context, filter *model.ChatListFilter) (cpa1 []*model.Chat, err error) {
+	mm_atomic.AddUint64(&mmListUserChats.beforeListUserChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUserChats.afterListUserChatsCounter, 1)
+
+	mmListUserChats.t.Helper()
+
+	if mmListUserChats.inspectFuncListUserChats != nil {
+		mmListUserChats.inspectFuncListUserChats(ctx, filter)
+	}
+
+	mm_params := ChatRepositoryMockListUserChatsParams{ctx, filter}
+
+	// Record call args
+	mmListUserChats.ListUserChatsMock.mutex.Lock()
+	mmListUserChats.ListUserChatsMock.callArgs = append(mmListUserChats.ListUserChatsMock.callArgs, &mm_params)
+	mmListUserChats.ListUserChatsMock.mutex.Unlock()
+
+	for _, e := range mmListUserChats.ListUserChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmListUserChats.ListUserChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUserChats.ListUserChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUserChats.ListUserChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmListUserChats.ListUserChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListUserChatsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUserChats.t.Errorf("ChatRepositoryMock.ListUserChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserChats.ListUserChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListUserChats.t.Errorf("ChatRepositoryMock.ListUserChats got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserChats.ListUserChatsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUserChats.t.Errorf("ChatRepositoryMock.ListUserChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUserChats.ListUserChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUserChats.ListUserChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUserChats.t.Fatal("No results are set for the ChatRepositoryMock.ListUserChats")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListUserChats.funcListUserChats != nil {
+		return mmListUserChats.funcListUserChats(ctx, filter)
+	}
+	mmListUserChats.t.Fatalf("Unexpected call to ChatRepositoryMock.ListUserChats. %v %v", ctx, filter)
+	return
+}
+
+// ListUserChatsAfterCounter returns a count of finished ChatRepositoryMock.ListUserChats invocations
+func (mmListUserChats *ChatRepositoryMock) ListUserChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserChats.afterListUserChatsCounter)
+}
+
+// ListUserChatsBeforeCounter returns a count of ChatRepositoryMock.ListUserChats invocations
+func (mmListUserChats *ChatRepositoryMock) ListUserChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserChats.beforeListUserChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListUserChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUserChats *mChatRepositoryMockListUserChats) Calls() []*ChatRepositoryMockListUserChatsParams {
+	mmListUserChats.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListUserChatsParams, len(mmListUserChats.callArgs))
+	copy(argCopy, mmListUserChats.callArgs)
+
+	mmListUserChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUserChatsDone returns true if the count of the ListUserChats invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListUserChatsDone() bool {
+	if m.ListUserChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUserChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUserChatsMock.invocationsDone()
+}
+
+// MinimockListUserChatsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListUserChatsInspect() {
+	for _, e := range m.ListUserChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListUserChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUserChatsCounter := mm_atomic.LoadUint64(&m.afterListUserChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserChatsMock.defaultExpectation != nil && afterListUserChatsCounter < 1 {
+		if m.ListUserChatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListUserChats at\n%s", m.ListUserChatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListUserChats at\n%s with params: %#v", m.ListUserChatsMock.defaultExpectation.expectationOrigins.origin, *m.ListUserChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserChats != nil && afterListUserChatsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListUserChats at\n%s", m.funcListUserChatsOrigin)
+	}
+
+	if !m.ListUserChatsMock.invocationsDone() && afterListUserChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListUserChats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUserChatsMock.expectedInvocations), m.ListUserChatsMock.expectedInvocationsOrigin, afterListUserChatsCounter)
+	}
+}
+
+type mChatRepositoryMockListenMessages struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListenMessagesExpectation
+	expectations       []*ChatRepositoryMockListenMessagesExpectation
+
+	callArgs []*ChatRepositoryMockListenMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListenMessagesExpectation specifies expectation struct of the ChatRepository.ListenMessages
+type ChatRepositoryMockListenMessagesExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListenMessagesParams
+	paramPtrs          *ChatRepositoryMockListenMessagesParamPtrs
+	expectationOrigins ChatRepositoryMockListenMessagesExpectationOrigins
+	results            *ChatRepositoryMockListenMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListenMessagesParams contains parameters of the ChatRepository.ListenMessages
+type ChatRepositoryMockListenMessagesParams struct {
+	ctx     context.Context
+	handler func(message *model.Message)
+}
+
+// ChatRepositoryMockListenMessagesParamPtrs contains pointers to parameters of the ChatRepository.ListenMessages
+type ChatRepositoryMockListenMessagesParamPtrs struct {
+	ctx     *context.Context
+	handler *func(message *model.Message)
+}
+
+// ChatRepositoryMockListenMessagesResults contains results of the ChatRepository.ListenMessages
+type ChatRepositoryMockListenMessagesResults struct {
+	err error
+}
+
+// ChatRepositoryMockListenMessagesOrigins contains origins of expectations of the ChatRepository.ListenMessages
+type ChatRepositoryMockListenMessagesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originHandler string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListenMessages *mChatRepositoryMockListenMessages) Optional() *mChatRepositoryMockListenMessages {
+	mmListenMessages.optional = true
+	return mmListenMessages
+}
+
+// Expect sets up expected params for ChatRepository.ListenMessages
+func (mmListenMessages *mChatRepositoryMockListenMessages) Expect(ctx context.Context, handler func(message *model.Message)) *mChatRepositoryMockListenMessages {
+	if mmListenMessages.mock.funcListenMessages != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by Set")
+	}
+
+	if mmListenMessages.defaultExpectation == nil {
+		mmListenMessages.defaultExpectation = &ChatRepositoryMockListenMessagesExpectation{}
+	}
+
+	if mmListenMessages.defaultExpectation.paramPtrs != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListenMessages.defaultExpectation.params = &ChatRepositoryMockListenMessagesParams{ctx, handler}
+	mmListenMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListenMessages.expectations {
+		if minimock.Equal(e.params, mmListenMessages.defaultExpectation.params) {
+			mmListenMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListenMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListenMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListenMessages
+func (mmListenMessages *mChatRepositoryMockListenMessages) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListenMessages {
+	if mmListenMessages.mock.funcListenMessages != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by Set")
+	}
+
+	if mmListenMessages.defaultExpectation == nil {
+		mmListenMessages.defaultExpectation = &ChatRepositoryMockListenMessagesExpectation{}
+	}
+
+	if mmListenMessages.defaultExpectation.params != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by Expect")
+	}
+
+	if mmListenMessages.defaultExpectation.paramPtrs == nil {
+		mmListenMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockListenMessagesParamPtrs{}
+	}
+	mmListenMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListenMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListenMessages
+}
+
+// ExpectHandlerParam2 sets up expected param handler for ChatRepository.ListenMessages
+func (mmListenMessages *mChatRepositoryMockListenMessages) ExpectHandlerParam2(handler func(message *model.Message)) *mChatRepositoryMockListenMessages {
+	if mmListenMessages.mock.funcListenMessages != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by Set")
+	}
+
+	if mmListenMessages.defaultExpectation == nil {
+		mmListenMessages.defaultExpectation = &ChatRepositoryMockListenMessagesExpectation{}
+	}
+
+	if mmListenMessages.defaultExpectation.params != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by Expect")
+	}
+
+	if mmListenMessages.defaultExpectation.paramPtrs == nil {
+		mmListenMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockListenMessagesParamPtrs{}
+	}
+	mmListenMessages.defaultExpectation.paramPtrs.handler = &handler
+	mmListenMessages.defaultExpectation.expectationOrigins.originHandler = minimock.CallerInfo(1)
+
+	return mmListenMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListenMessages
+func (mmListenMessages *mChatRepositoryMockListenMessages) Inspect(f func(ctx context.Context, handler func(message *model.Message))) *mChatRepositoryMockListenMessages {
+	if mmListenMessages.mock.inspectFuncListenMessages != nil {
+		mmListenMessages.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListenMessages")
+	}
+
+	mmListenMessages.mock.inspectFuncListenMessages = f
+
+	return mmListenMessages
+}
+
+// Return sets up results that will be returned by ChatRepository.ListenMessages
+func (mmListenMessages *mChatRepositoryMockListenMessages) Return(err error) *ChatRepositoryMock {
+	if mmListenMessages.mock.funcListenMessages != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by Set")
+	}
+
+	if mmListenMessages.defaultExpectation == nil {
+		mmListenMessages.defaultExpectation = &ChatRepositoryMockListenMessagesExpectation{mock: mmListenMessages.mock}
+	}
+	mmListenMessages.defaultExpectation.results = &ChatRepositoryMockListenMessagesResults{err}
+	mmListenMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListenMessages.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListenMessages method
+func (mmListenMessages *mChatRepositoryMockListenMessages) Set(f func(ctx context.Context, handler func(message *model.Message)) (err error)) *ChatRepositoryMock {
+	if mmListenMessages.defaultExpectation != nil {
+		mmListenMessages.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListenMessages method")
+	}
+
+	if len(mmListenMessages.expectations) > 0 {
+		mmListenMessages.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListenMessages method")
+	}
+
+	mmListenMessages.mock.funcListenMessages = f
+	mmListenMessages.mock.funcListenMessagesOrigin = minimock.CallerInfo(1)
+	return mmListenMessages.mock
+}
+
+// When sets expectation for the ChatRepository.ListenMessages which will trigger the result defined by the following
+// Then helper
+func (mmListenMessages *mChatRepositoryMockListenMessages) When(ctx context.Context, handler func(message *model.Message)) *ChatRepositoryMockListenMessagesExpectation {
+	if mmListenMessages.mock.funcListenMessages != nil {
+		mmListenMessages.mock.t.Fatalf("ChatRepositoryMock.ListenMessages mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListenMessagesExpectation{
+		mock:               mmListenMessages.mock,
+		params:             &ChatRepositoryMockListenMessagesParams{ctx, handler},
+		expectationOrigins: ChatRepositoryMockListenMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListenMessages.expectations = append(mmListenMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListenMessages return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListenMessagesExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListenMessagesResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListenMessages should be invoked
+func (mmListenMessages *mChatRepositoryMockListenMessages) Times(n uint64) *mChatRepositoryMockListenMessages {
+	if n == 0 {
+		mmListenMessages.mock.t.Fatalf("Times of ChatRepositoryMock.ListenMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListenMessages.expectedInvocations, n)
+	mmListenMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListenMessages
+}
+
+func (mmListenMessages *mChatRepositoryMockListenMessages) invocationsDone() bool {
+	if len(mmListenMessages.expectations) == 0 && mmListenMessages.defaultExpectation == nil && mmListenMessages.mock.funcListenMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListenMessages.mock.afterListenMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListenMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListenMessages implements mm_repository.ChatRepository
+func (mmListenMessages *ChatRepositoryMock) ListenMessages(ctx context.Context, handler func(message *model.Message)) (err error) {
+	mm_atomic.AddUint64(&mmListenMessages.beforeListenMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListenMessages.afterListenMessagesCounter, 1)
+
+	mmListenMessages.t.Helper()
+
+	if mmListenMessages.inspectFuncListenMessages != nil {
+		mmListenMessages.inspectFuncListenMessages(ctx, handler)
+	}
+
+	mm_params := ChatRepositoryMockListenMessagesParams{ctx, handler}
+
+	// Record call args
+	mmListenMessages.ListenMessagesMock.mutex.Lock()
+	mmListenMessages.ListenMessagesMock.callArgs = append(mmListenMessages.ListenMessagesMock.callArgs, &mm_params)
+	mmListenMessages.ListenMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListenMessages.ListenMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmListenMessages.ListenMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListenMessages.ListenMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListenMessages.ListenMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListenMessages.ListenMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListenMessagesParams{ctx, handler}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListenMessages.t.Errorf("ChatRepositoryMock.ListenMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListenMessages.ListenMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.handler != nil && !minimock.Equal(*mm_want_ptrs.handler, mm_got.handler) {
+				mmListenMessages.t.Errorf("ChatRepositoryMock.ListenMessages got unexpected parameter handler, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListenMessages.ListenMessagesMock.defaultExpectation.expectationOrigins.originHandler, *mm_want_ptrs.handler, mm_got.handler, minimock.Diff(*mm_want_ptrs.handler, mm_got.handler))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListenMessages.t.Errorf("ChatRepositoryMock.ListenMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListenMessages.ListenMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListenMessages.ListenMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListenMessages.t.Fatal("No results are set for the ChatRepositoryMock.ListenMessages")
+		}
+		return (*mm_results).err
+	}
+	if mmListenMessages.funcListenMessages != nil {
+		return mmListenMessages.funcListenMessages(ctx, handler)
+	}
+	mmListenMessages.t.Fatalf("Unexpected call to ChatRepositoryMock.ListenMessages. %v %v", ctx, handler)
+	return
+}
+
+// ListenMessagesAfterCounter returns a count of finished ChatRepositoryMock.ListenMessages invocations
+func (mmListenMessages *ChatRepositoryMock) ListenMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListenMessages.afterListenMessagesCounter)
+}
+
+// ListenMessagesBeforeCounter returns a count of ChatRepositoryMock.ListenMessages invocations
+func (mmListenMessages *ChatRepositoryMock) ListenMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListenMessages.beforeListenMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListenMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListenMessages *mChatRepositoryMockListenMessages) Calls() []*ChatRepositoryMockListenMessagesParams {
+	mmListenMessages.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListenMessagesParams, len(mmListenMessages.callArgs))
+	copy(argCopy, mmListenMessages.callArgs)
+
+	mmListenMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListenMessagesDone returns true if the count of the ListenMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListenMessagesDone() bool {
+	if m.ListenMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListenMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListenMessagesMock.invocationsDone()
+}
+
+// MinimockListenMessagesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListenMessagesInspect() {
+	for _, e := range m.ListenMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListenMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListenMessagesCounter := mm_atomic.LoadUint64(&m.afterListenMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListenMessagesMock.defaultExpectation != nil && afterListenMessagesCounter < 1 {
+		if m.ListenMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListenMessages at\n%s", m.ListenMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListenMessages at\n%s with params: %#v", m.ListenMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListenMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListenMessages != nil && afterListenMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListenMessages at\n%s", m.funcListenMessagesOrigin)
+	}
+
+	if !m.ListenMessagesMock.invocationsDone() && afterListenMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListenMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListenMessagesMock.expectedInvocations), m.ListenMessagesMock.expectedInvocationsOrigin, afterListenMessagesCounter)
+	}
+}
+
+type mChatRepositoryMockLockMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockLockMessageExpectation
+	expectations       []*ChatRepositoryMockLockMessageExpectation
+
+	callArgs []*ChatRepositoryMockLockMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockLockMessageExpectation specifies expectation struct of the ChatRepository.LockMessage
+type ChatRepositoryMockLockMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockLockMessageParams
+	paramPtrs          *ChatRepositoryMockLockMessageParamPtrs
+	expectationOrigins ChatRepositoryMockLockMessageExpectationOrigins
+	results            *ChatRepositoryMockLockMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockLockMessageParams contains parameters of the ChatRepository.LockMessage
+type ChatRepositoryMockLockMessageParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockLockMessageParamPtrs contains pointers to parameters of the ChatRepository.LockMessage
+type ChatRepositoryMockLockMessageParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockLockMessageResults contains results of the ChatRepository.LockMessage
+type ChatRepositoryMockLockMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatRepositoryMockLockMessageOrigins contains origins of expectations of the ChatRepository.LockMessage
+type ChatRepositoryMockLockMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockMessage *mChatRepositoryMockLockMessage) Optional() *mChatRepositoryMockLockMessage {
+	mmLockMessage.optional = true
+	return mmLockMessage
+}
+
+// Expect sets up expected params for ChatRepository.LockMessage
+func (mmLockMessage *mChatRepositoryMockLockMessage) Expect(ctx context.Context, id int64) *mChatRepositoryMockLockMessage {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &ChatRepositoryMockLockMessageExpectation{}
+	}
+
+	if mmLockMessage.defaultExpectation.paramPtrs != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by ExpectParams functions")
+	}
+
+	mmLockMessage.defaultExpectation.params = &ChatRepositoryMockLockMessageParams{ctx, id}
+	mmLockMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockMessage.expectations {
+		if minimock.Equal(e.params, mmLockMessage.defaultExpectation.params) {
+			mmLockMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmLockMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.LockMessage
+func (mmLockMessage *mChatRepositoryMockLockMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockLockMessage {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &ChatRepositoryMockLockMessageExpectation{}
+	}
+
+	if mmLockMessage.defaultExpectation.params != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by Expect")
+	}
+
+	if mmLockMessage.defaultExpectation.paramPtrs == nil {
+		mmLockMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockLockMessageParamPtrs{}
+	}
+	mmLockMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockMessage
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.LockMessage
+func (mmLockMessage *mChatRepositoryMockLockMessage) ExpectIdParam2(id int64) *mChatRepositoryMockLockMessage {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &ChatRepositoryMockLockMessageExpectation{}
+	}
+
+	if mmLockMessage.defaultExpectation.params != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by Expect")
+	}
+
+	if mmLockMessage.defaultExpectation.paramPtrs == nil {
+		mmLockMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockLockMessageParamPtrs{}
+	}
+	mmLockMessage.defaultExpectation.paramPtrs.id = &id
+	mmLockMessage.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmLockMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.LockMessage
+func (mmLockMessage *mChatRepositoryMockLockMessage) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockLockMessage {
+	if mmLockMessage.mock.inspectFuncLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.LockMessage")
+	}
+
+	mmLockMessage.mock.inspectFuncLockMessage = f
+
+	return mmLockMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.LockMessage
+func (mmLockMessage *mChatRepositoryMockLockMessage) Return(mp1 *model.Message, err error) *ChatRepositoryMock {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &ChatRepositoryMockLockMessageExpectation{mock: mmLockMessage.mock}
+	}
+	mmLockMessage.defaultExpectation.results = &ChatRepositoryMockLockMessageResults{mp1, err}
+	mmLockMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.LockMessage method
+func (mmLockMessage *mChatRepositoryMockLockMessage) Set(f func(ctx context.Context, id int64) (mp1 *model.Message, err error)) *ChatRepositoryMock {
+	if mmLockMessage.defaultExpectation != nil {
+		mmLockMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.LockMessage method")
+	}
+
+	if len(mmLockMessage.expectations) > 0 {
+		mmLockMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.LockMessage method")
+	}
+
+	mmLockMessage.mock.funcLockMessage = f
+	mmLockMessage.mock.funcLockMessageOrigin = minimock.CallerInfo(1)
+	return mmLockMessage.mock
+}
+
+// When sets expectation for the ChatRepository.LockMessage which will trigger the result defined by the following
+// Then helper
+func (mmLockMessage *mChatRepositoryMockLockMessage) When(ctx context.Context, id int64) *ChatRepositoryMockLockMessageExpectation {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("ChatRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockLockMessageExpectation{
+		mock:               mmLockMessage.mock,
+		params:             &ChatRepositoryMockLockMessageParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockLockMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockMessage.expectations = append(mmLockMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.LockMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockLockMessageExpectation) Then(mp1 *model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockLockMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.LockMessage should be invoked
+func (mmLockMessage *mChatRepositoryMockLockMessage) Times(n uint64) *mChatRepositoryMockLockMessage {
+	if n == 0 {
+		mmLockMessage.mock.t.Fatalf("Times of ChatRepositoryMock.LockMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockMessage.expectedInvocations, n)
+	mmLockMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockMessage
+}
+
+func (mmLockMessage *mChatRepositoryMockLockMessage) invocationsDone() bool {
+	if len(mmLockMessage.expectations) == 0 && mmLockMessage.defaultExpectation == nil && mmLockMessage.mock.funcLockMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockMessage.mock.afterLockMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockMessage implements mm_repository.ChatRepository
+func (mmLockMessage *ChatRepositoryMock) LockMessage(ctx context.Context, id int64) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmLockMessage.beforeLockMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockMessage.afterLockMessageCounter, 1)
+
+	mmLockMessage.t.Helper()
+
+	if mmLockMessage.inspectFuncLockMessage != nil {
+		mmLockMessage.inspectFuncLockMessage(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockLockMessageParams{ctx, id}
+
+	// Record call args
+	mmLockMessage.LockMessageMock.mutex.Lock()
+	mmLockMessage.LockMessageMock.callArgs = append(mmLockMessage.LockMessageMock.callArgs, &mm_params)
+	mmLockMessage.LockMessageMock.mutex.Unlock()
+
+	for _, e := range mmLockMessage.LockMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmLockMessage.LockMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockMessage.LockMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockMessage.LockMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmLockMessage.LockMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockLockMessageParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockMessage.t.Errorf("ChatRepositoryMock.LockMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockMessage.LockMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmLockMessage.t.Errorf("ChatRepositoryMock.LockMessage got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockMessage.LockMessageMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockMessage.t.Errorf("ChatRepositoryMock.LockMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockMessage.LockMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockMessage.LockMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockMessage.t.Fatal("No results are set for the ChatRepositoryMock.LockMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmLockMessage.funcLockMessage != nil {
+		return mmLockMessage.funcLockMessage(ctx, id)
+	}
+	mmLockMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.LockMessage. %v %v", ctx, id)
+	return
+}
+
+// LockMessageAfterCounter returns a count of finished ChatRepositoryMock.LockMessage invocations
+func (mmLockMessage *ChatRepositoryMock) LockMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockMessage.afterLockMessageCounter)
+}
+
+// LockMessageBeforeCounter returns a count of ChatRepositoryMock.LockMessage invocations
+func (mmLockMessage *ChatRepositoryMock) LockMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockMessage.beforeLockMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.LockMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockMessage *mChatRepositoryMockLockMessage) Calls() []*ChatRepositoryMockLockMessageParams {
+	mmLockMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockLockMessageParams, len(mmLockMessage.callArgs))
+	copy(argCopy, mmLockMessage.callArgs)
+
+	mmLockMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockMessageDone returns true if the count of the LockMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockLockMessageDone() bool {
+	if m.LockMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockMessageMock.invocationsDone()
+}
+
+// MinimockLockMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockLockMessageInspect() {
+	for _, e := range m.LockMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockMessageCounter := mm_atomic.LoadUint64(&m.afterLockMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockMessageMock.defaultExpectation != nil && afterLockMessageCounter < 1 {
+		if m.LockMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockMessage at\n%s", m.LockMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockMessage at\n%s with params: %#v", m.LockMessageMock.defaultExpectation.expectationOrigins.origin, *m.LockMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockMessage != nil && afterLockMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.LockMessage at\n%s", m.funcLockMessageOrigin)
+	}
+
+	if !m.LockMessageMock.invocationsDone() && afterLockMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.LockMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockMessageMock.expectedInvocations), m.LockMessageMock.expectedInvocationsOrigin, afterLockMessageCounter)
+	}
+}
+
+type mChatRepositoryMockMarkRead struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockMarkReadExpectation
+	expectations       []*ChatRepositoryMockMarkReadExpectation
+
+	callArgs []*ChatRepositoryMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockMarkReadExpectation specifies expectation struct of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockMarkReadParams
+	paramPtrs          *ChatRepositoryMockMarkReadParamPtrs
+	expectationOrigins ChatRepositoryMockMarkReadExpectationOrigins
+	results            *ChatRepositoryMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockMarkReadParams contains parameters of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadParams struct {
+	ctx     context.Context
+	chatID  int64
+	userID  string
+	message *model.Message
+}
+
+// ChatRepositoryMockMarkReadParamPtrs contains pointers to parameters of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	userID  *string
+	message **model.Message
+}
+
+// ChatRepositoryMockMarkReadResults contains results of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadResults struct {
+	err error
+}
+
+// ChatRepositoryMockMarkReadOrigins contains origins of expectations of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChatID  string
+	originUserID  string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatRepositoryMockMarkRead) Optional() *mChatRepositoryMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Expect(ctx context.Context, chatID int64, userID string, message *model.Message) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatRepositoryMockMarkReadParams{ctx, chatID, userID, message}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.chatID = &chatID
+	mmMarkRead.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectUserIDParam3(userID string) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.userID = &userID
+	mmMarkRead.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectMessageParam4 sets up expected param message for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectMessageParam4(message *model.Message) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.message = &message
+	mmMarkRead.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Inspect(f func(ctx context.Context, chatID int64, userID string, message *model.Message)) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Return(err error) *ChatRepositoryMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatRepositoryMockMarkReadResults{err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatRepository.MarkRead method
+func (mmMarkRead *mChatRepositoryMockMarkRead) Set(f func(ctx context.Context, chatID int64, userID string, message *model.Message) (err error)) *ChatRepositoryMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatRepository.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatRepository.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatRepository.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatRepositoryMockMarkRead) When(ctx context.Context, chatID int64, userID string, message *model.Message) *ChatRepositoryMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &ChatRepositoryMockMarkReadParams{ctx, chatID, userID, message},
+		expectationOrigins: ChatRepositoryMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockMarkReadExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockMarkReadResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.MarkRead should be invoked
+func (mmMarkRead *mChatRepositoryMockMarkRead) Times(n uint64) *mChatRepositoryMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatRepositoryMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatRepositoryMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_repository.ChatRepository
+func (mmMarkRead *ChatRepositoryMock) MarkRead(ctx context.Context, chatID int64, userID string, message *model.Message) (err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, chatID, userID, message)
+	}
+
+	mm_params := ChatRepositoryMockMarkReadParams{ctx, chatID, userID, message}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockMarkReadParams{ctx, chatID, userID, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatRepositoryMock.MarkRead")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, chatID, userID, message)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatRepositoryMock.MarkRead. %v %v %v %v", ctx, chatID, userID, message)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatRepositoryMock.MarkRead invocations
+func (mmMarkRead *ChatRepositoryMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatRepositoryMock.MarkRead invocations
+func (mmMarkRead *ChatRepositoryMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatRepositoryMockMarkRead) Calls() []*ChatRepositoryMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+type mChatRepositoryMockPinMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockPinMessageExpectation
+	expectations       []*ChatRepositoryMockPinMessageExpectation
+
+	callArgs []*ChatRepositoryMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockPinMessageExpectation specifies expectation struct of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockPinMessageParams
+	paramPtrs          *ChatRepositoryMockPinMessageParamPtrs
+	expectationOrigins ChatRepositoryMockPinMessageExpectationOrigins
+	results            *ChatRepositoryMockPinMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockPinMessageParams contains parameters of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageParams struct {
+	ctx    context.Context
+	id     int64
+	pinned bool
+}
+
+// ChatRepositoryMockPinMessageParamPtrs contains pointers to parameters of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageParamPtrs struct {
+	ctx    *context.Context
+	id     *int64
+	pinned *bool
+}
+
+// ChatRepositoryMockPinMessageResults contains results of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageResults struct {
+	err error
+}
+
+// ChatRepositoryMockPinMessageOrigins contains origins of expectations of the ChatRepository.PinMessage
+type ChatRepositoryMockPinMessageExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originPinned string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mChatRepositoryMockPinMessage) Optional() *mChatRepositoryMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) Expect(ctx context.Context, id int64, pinned bool) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &ChatRepositoryMockPinMessageParams{ctx, id, pinned}
+	mmPinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) ExpectIdParam2(id int64) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.id = &id
+	mmPinMessage.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectPinnedParam3 sets up expected param pinned for ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) ExpectPinnedParam3(pinned bool) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.pinned = &pinned
+	mmPinMessage.defaultExpectation.expectationOrigins.originPinned = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) Inspect(f func(ctx context.Context, id int64, pinned bool)) *mChatRepositoryMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.PinMessage
+func (mmPinMessage *mChatRepositoryMockPinMessage) Return(err error) *ChatRepositoryMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatRepositoryMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &ChatRepositoryMockPinMessageResults{err}
+	mmPinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.PinMessage method
+func (mmPinMessage *mChatRepositoryMockPinMessage) Set(f func(ctx context.Context, id int64, pinned bool) (err error)) *ChatRepositoryMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	mmPinMessage.mock.funcPinMessageOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the ChatRepository.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mChatRepositoryMockPinMessage) When(ctx context.Context, id int64, pinned bool) *ChatRepositoryMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockPinMessageExpectation{
+		mock:               mmPinMessage.mock,
+		params:             &ChatRepositoryMockPinMessageParams{ctx, id, pinned},
+		expectationOrigins: ChatRepositoryMockPinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.PinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockPinMessageExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockPinMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.PinMessage should be invoked
+func (mmPinMessage *mChatRepositoryMockPinMessage) Times(n uint64) *mChatRepositoryMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of ChatRepositoryMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	mmPinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mChatRepositoryMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements mm_repository.ChatRepository
+func (mmPinMessage *ChatRepositoryMock) PinMessage(ctx context.Context, id int64, pinned bool) (err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	mmPinMessage.t.Helper()
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, id, pinned)
+	}
+
+	mm_params := ChatRepositoryMockPinMessageParams{ctx, id, pinned}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockPinMessageParams{ctx, id, pinned}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.pinned != nil && !minimock.Equal(*mm_want_ptrs.pinned, mm_got.pinned) {
+				mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameter pinned, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originPinned, *mm_want_ptrs.pinned, mm_got.pinned, minimock.Diff(*mm_want_ptrs.pinned, mm_got.pinned))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("ChatRepositoryMock.PinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the ChatRepositoryMock.PinMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, id, pinned)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.PinMessage. %v %v %v", ctx, id, pinned)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished ChatRepositoryMock.PinMessage invocations
+func (mmPinMessage *ChatRepositoryMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of ChatRepositoryMock.PinMessage invocations
+func (mmPinMessage *ChatRepositoryMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mChatRepositoryMockPinMessage) Calls() []*ChatRepositoryMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s", m.PinMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s with params: %#v", m.PinMessageMock.defaultExpectation.expectationOrigins.origin, *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.PinMessage at\n%s", m.funcPinMessageOrigin)
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.PinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), m.PinMessageMock.expectedInvocationsOrigin, afterPinMessageCounter)
+	}
+}
+
+type mChatRepositoryMockRemoveChatMembers struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRemoveChatMembersExpectation
+	expectations       []*ChatRepositoryMockRemoveChatMembersExpectation
+
+	callArgs []*ChatRepositoryMockRemoveChatMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockRemoveChatMembersExpectation specifies expectation struct of the ChatRepository.RemoveChatMembers
+type ChatRepositoryMockRemoveChatMembersExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockRemoveChatMembersParams
+	paramPtrs          *ChatRepositoryMockRemoveChatMembersParamPtrs
+	expectationOrigins ChatRepositoryMockRemoveChatMembersExpectationOrigins
+	results            *ChatRepositoryMockRemoveChatMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockRemoveChatMembersParams contains parameters of the ChatRepository.RemoveChatMembers
+type ChatRepositoryMockRemoveChatMembersParams struct {
+	ctx     context.Context
+	chatID  int64
+	usersID []string
+}
+
+// ChatRepositoryMockRemoveChatMembersParamPtrs contains pointers to parameters of the ChatRepository.RemoveChatMembers
+type ChatRepositoryMockRemoveChatMembersParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	usersID *[]string
+}
+
+// ChatRepositoryMockRemoveChatMembersResults contains results of the ChatRepository.RemoveChatMembers
+type ChatRepositoryMockRemoveChatMembersResults struct {
+	err error
+}
+
+// ChatRepositoryMockRemoveChatMembersOrigins contains origins of expectations of the ChatRepository.RemoveChatMembers
+type ChatRepositoryMockRemoveChatMembersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChatID  string
+	originUsersID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) Optional() *mChatRepositoryMockRemoveChatMembers {
+	mmRemoveChatMembers.optional = true
+	return mmRemoveChatMembers
+}
+
+// Expect sets up expected params for ChatRepository.RemoveChatMembers
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) Expect(ctx context.Context, chatID int64, usersID []string) *mChatRepositoryMockRemoveChatMembers {
+	if mmRemoveChatMembers.mock.funcRemoveChatMembers != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Set")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation == nil {
+		mmRemoveChatMembers.defaultExpectation = &ChatRepositoryMockRemoveChatMembersExpectation{}
+	}
+
+	if mmRemoveChatMembers.defaultExpectation.paramPtrs != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveChatMembers.defaultExpectation.params = &ChatRepositoryMockRemoveChatMembersParams{ctx, chatID, usersID}
+	mmRemoveChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveChatMembers.expectations {
+		if minimock.Equal(e.params, mmRemoveChatMembers.defaultExpectation.params) {
+			mmRemoveChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveChatMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveChatMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.RemoveChatMembers
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRemoveChatMembers {
+	if mmRemoveChatMembers.mock.funcRemoveChatMembers != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Set")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation == nil {
+		mmRemoveChatMembers.defaultExpectation = &ChatRepositoryMockRemoveChatMembersExpectation{}
+	}
+
+	if mmRemoveChatMembers.defaultExpectation.params != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Expect")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveChatMembersParamPtrs{}
+	}
+	mmRemoveChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveChatMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.RemoveChatMembers
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockRemoveChatMembers {
+	if mmRemoveChatMembers.mock.funcRemoveChatMembers != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Set")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation == nil {
+		mmRemoveChatMembers.defaultExpectation = &ChatRepositoryMockRemoveChatMembersExpectation{}
+	}
+
+	if mmRemoveChatMembers.defaultExpectation.params != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Expect")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveChatMembersParamPtrs{}
+	}
+	mmRemoveChatMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRemoveChatMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRemoveChatMembers
+}
+
+// ExpectUsersIDParam3 sets up expected param usersID for ChatRepository.RemoveChatMembers
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) ExpectUsersIDParam3(usersID []string) *mChatRepositoryMockRemoveChatMembers {
+	if mmRemoveChatMembers.mock.funcRemoveChatMembers != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Set")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation == nil {
+		mmRemoveChatMembers.defaultExpectation = &ChatRepositoryMockRemoveChatMembersExpectation{}
+	}
+
+	if mmRemoveChatMembers.defaultExpectation.params != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Expect")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveChatMembersParamPtrs{}
+	}
+	mmRemoveChatMembers.defaultExpectation.paramPtrs.usersID = &usersID
+	mmRemoveChatMembers.defaultExpectation.expectationOrigins.originUsersID = minimock.CallerInfo(1)
+
+	return mmRemoveChatMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.RemoveChatMembers
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) Inspect(f func(ctx context.Context, chatID int64, usersID []string)) *mChatRepositoryMockRemoveChatMembers {
+	if mmRemoveChatMembers.mock.inspectFuncRemoveChatMembers != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.RemoveChatMembers")
+	}
+
+	mmRemoveChatMembers.mock.inspectFuncRemoveChatMembers = f
+
+	return mmRemoveChatMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.RemoveChatMembers
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) Return(err error) *ChatRepositoryMock {
+	if mmRemoveChatMembers.mock.funcRemoveChatMembers != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Set")
+	}
+
+	if mmRemoveChatMembers.defaultExpectation == nil {
+		mmRemoveChatMembers.defaultExpectation = &ChatRepositoryMockRemoveChatMembersExpectation{mock: mmRemoveChatMembers.mock}
+	}
+	mmRemoveChatMembers.defaultExpectation.results = &ChatRepositoryMockRemoveChatMembersResults{err}
+	mmRemoveChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.RemoveChatMembers method
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) Set(f func(ctx context.Context, chatID int64, usersID []string) (err error)) *ChatRepositoryMock {
+	if mmRemoveChatMembers.defaultExpectation != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.RemoveChatMembers method")
+	}
+
+	if len(mmRemoveChatMembers.expectations) > 0 {
+		mmRemoveChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.RemoveChatMembers method")
+	}
+
+	mmRemoveChatMembers.mock.funcRemoveChatMembers = f
+	mmRemoveChatMembers.mock.funcRemoveChatMembersOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMembers.mock
+}
+
+// When sets expectation for the ChatRepository.RemoveChatMembers which will trigger the result defined by the following
+// Then helper
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) When(ctx context.Context, chatID int64, usersID []string) *ChatRepositoryMockRemoveChatMembersExpectation {
+	if mmRemoveChatMembers.mock.funcRemoveChatMembers != nil {
+		mmRemoveChatMembers.mock.t.Fatalf("ChatRepositoryMock.RemoveChatMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRemoveChatMembersExpectation{
+		mock:               mmRemoveChatMembers.mock,
+		params:             &ChatRepositoryMockRemoveChatMembersParams{ctx, chatID, usersID},
+		expectationOrigins: ChatRepositoryMockRemoveChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveChatMembers.expectations = append(mmRemoveChatMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.RemoveChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRemoveChatMembersExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRemoveChatMembersResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.RemoveChatMembers should be invoked
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) Times(n uint64) *mChatRepositoryMockRemoveChatMembers {
+	if n == 0 {
+		mmRemoveChatMembers.mock.t.Fatalf("Times of ChatRepositoryMock.RemoveChatMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveChatMembers.expectedInvocations, n)
+	mmRemoveChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveChatMembers
+}
+
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) invocationsDone() bool {
+	if len(mmRemoveChatMembers.expectations) == 0 && mmRemoveChatMembers.defaultExpectation == nil && mmRemoveChatMembers.mock.funcRemoveChatMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveChatMembers.mock.afterRemoveChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveChatMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveChatMembers implements mm_repository.ChatRepository
+func (mmRemoveChatMembers *ChatRepositoryMock) RemoveChatMembers(ctx context.Context, chatID int64, usersID []string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveChatMembers.beforeRemoveChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveChatMembers.afterRemoveChatMembersCounter, 1)
+
+	mmRemoveChatMembers.t.Helper()
+
+	if mmRemoveChatMembers.inspectFuncRemoveChatMembers != nil {
+		mmRemoveChatMembers.inspectFuncRemoveChatMembers(ctx, chatID, usersID)
+	}
+
+	mm_params := ChatRepositoryMockRemoveChatMembersParams{ctx, chatID, usersID}
+
+	// Record call args
+	mmRemoveChatMembers.RemoveChatMembersMock.mutex.Lock()
+	mmRemoveChatMembers.RemoveChatMembersMock.callArgs = append(mmRemoveChatMembers.RemoveChatMembersMock.callArgs, &mm_params)
+	mmRemoveChatMembers.RemoveChatMembersMock.mutex.Unlock()
+
+	for _, e := range mmRemoveChatMembers.RemoveChatMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRemoveChatMembersParams{ctx, chatID, usersID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveChatMembers.t.Errorf("ChatRepositoryMock.RemoveChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveChatMembers.t.Errorf("ChatRepositoryMock.RemoveChatMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.usersID != nil && !minimock.Equal(*mm_want_ptrs.usersID, mm_got.usersID) {
+				mmRemoveChatMembers.t.Errorf("ChatRepositoryMock.RemoveChatMembers got unexpected parameter usersID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.expectationOrigins.originUsersID, *mm_want_ptrs.usersID, mm_got.usersID, minimock.Diff(*mm_want_ptrs.usersID, mm_got.usersID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveChatMembers.t.Errorf("ChatRepositoryMock.RemoveChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveChatMembers.RemoveChatMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveChatMembers.t.Fatal("No results are set for the ChatRepositoryMock.RemoveChatMembers")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveChatMembers.funcRemoveChatMembers != nil {
+		return mmRemoveChatMembers.funcRemoveChatMembers(ctx, chatID, usersID)
+	}
+	mmRemoveChatMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.RemoveChatMembers. %v %v %v", ctx, chatID, usersID)
+	return
+}
+
+// RemoveChatMembersAfterCounter returns a count of finished ChatRepositoryMock.RemoveChatMembers invocations
+func (mmRemoveChatMembers *ChatRepositoryMock) RemoveChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveChatMembers.afterRemoveChatMembersCounter)
+}
+
+// RemoveChatMembersBeforeCounter returns a count of ChatRepositoryMock.RemoveChatMembers invocations
+func (mmRemoveChatMembers *ChatRepositoryMock) RemoveChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveChatMembers.beforeRemoveChatMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.RemoveChatMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveChatMembers *mChatRepositoryMockRemoveChatMembers) Calls() []*ChatRepositoryMockRemoveChatMembersParams {
+	mmRemoveChatMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRemoveChatMembersParams, len(mmRemoveChatMembers.callArgs))
+	copy(argCopy, mmRemoveChatMembers.callArgs)
+
+	mmRemoveChatMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveChatMembersDone returns true if the count of the RemoveChatMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRemoveChatMembersDone() bool {
+	if m.RemoveChatMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveChatMembersMock.invocationsDone()
+}
+
+// MinimockRemoveChatMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRemoveChatMembersInspect() {
+	for _, e := range m.RemoveChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveChatMembersCounter := mm_atomic.LoadUint64(&m.afterRemoveChatMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveChatMembersMock.defaultExpectation != nil && afterRemoveChatMembersCounter < 1 {
+		if m.RemoveChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMembers at\n%s", m.RemoveChatMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMembers at\n%s with params: %#v", m.RemoveChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.RemoveChatMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveChatMembers != nil && afterRemoveChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.RemoveChatMembers at\n%s", m.funcRemoveChatMembersOrigin)
+	}
+
+	if !m.RemoveChatMembersMock.invocationsDone() && afterRemoveChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.RemoveChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveChatMembersMock.expectedInvocations), m.RemoveChatMembersMock.expectedInvocationsOrigin, afterRemoveChatMembersCounter)
+	}
+}
+
+type mChatRepositoryMockReserveIdempotencyKey struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockReserveIdempotencyKeyExpectation
+	expectations       []*ChatRepositoryMockReserveIdempotencyKeyExpectation
+
+	callArgs []*ChatRepositoryMockReserveIdempotencyKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockReserveIdempotencyKeyExpectation specifies expectation struct of the ChatRepository.ReserveIdempotencyKey
+type ChatRepositoryMockReserveIdempotencyKeyExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockReserveIdempotencyKeyParams
+	paramPtrs          *ChatRepositoryMockReserveIdempotencyKeyParamPtrs
+	expectationOrigins ChatRepositoryMockReserveIdempotencyKeyExpectationOrigins
+	results            *ChatRepositoryMockReserveIdempotencyKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockReserveIdempotencyKeyParams contains parameters of the ChatRepository.ReserveIdempotencyKey
+type ChatRepositoryMockReserveIdempotencyKeyParams struct {
+	ctx context.Context
+	key *model.IdempotencyKey
+}
+
+// ChatRepositoryMockReserveIdempotencyKeyParamPtrs contains pointers to parameters of the ChatRepository.ReserveIdempotencyKey
+type ChatRepositoryMockReserveIdempotencyKeyParamPtrs struct {
+	ctx *context.Context
+	key **model.IdempotencyKey
+}
+
+// ChatRepositoryMockReserveIdempotencyKeyResults contains results of the ChatRepository.ReserveIdempotencyKey
+type ChatRepositoryMockReserveIdempotencyKeyResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockReserveIdempotencyKeyOrigins contains origins of expectations of the ChatRepository.ReserveIdempotencyKey
+type ChatRepositoryMockReserveIdempotencyKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) Optional() *mChatRepositoryMockReserveIdempotencyKey {
+	mmReserveIdempotencyKey.optional = true
+	return mmReserveIdempotencyKey
+}
+
+// Expect sets up expected params for ChatRepository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) Expect(ctx context.Context, key *model.IdempotencyKey) *mChatRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &ChatRepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by ExpectParams functions")
+	}
+
+	mmReserveIdempotencyKey.defaultExpectation.params = &ChatRepositoryMockReserveIdempotencyKeyParams{ctx, key}
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReserveIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmReserveIdempotencyKey.defaultExpectation.params) {
+			mmReserveIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveIdempotencyKey.defaultExpectation.params)
+		}
+	}
+
+	return mmReserveIdempotencyKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &ChatRepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.params != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReserveIdempotencyKey.defaultExpectation.paramPtrs = &ChatRepositoryMockReserveIdempotencyKeyParamPtrs{}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReserveIdempotencyKey
+}
+
+// ExpectKeyParam2 sets up expected param key for ChatRepository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) ExpectKeyParam2(key *model.IdempotencyKey) *mChatRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &ChatRepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.params != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReserveIdempotencyKey.defaultExpectation.paramPtrs = &ChatRepositoryMockReserveIdempotencyKeyParamPtrs{}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReserveIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) Inspect(f func(ctx context.Context, key *model.IdempotencyKey)) *mChatRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.inspectFuncReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ReserveIdempotencyKey")
+	}
+
+	mmReserveIdempotencyKey.mock.inspectFuncReserveIdempotencyKey = f
+
+	return mmReserveIdempotencyKey
+}
+
+// Return sets up results that will be returned by ChatRepository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &ChatRepositoryMockReserveIdempotencyKeyExpectation{mock: mmReserveIdempotencyKey.mock}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.results = &ChatRepositoryMockReserveIdempotencyKeyResults{b1, err}
+	mmReserveIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReserveIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ReserveIdempotencyKey method
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) Set(f func(ctx context.Context, key *model.IdempotencyKey) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmReserveIdempotencyKey.defaultExpectation != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ReserveIdempotencyKey method")
+	}
+
+	if len(mmReserveIdempotencyKey.expectations) > 0 {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ReserveIdempotencyKey method")
+	}
+
+	mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey = f
+	mmReserveIdempotencyKey.mock.funcReserveIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmReserveIdempotencyKey.mock
+}
+
+// When sets expectation for the ChatRepository.ReserveIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) When(ctx context.Context, key *model.IdempotencyKey) *ChatRepositoryMockReserveIdempotencyKeyExpectation {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("ChatRepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockReserveIdempotencyKeyExpectation{
+		mock:               mmReserveIdempotencyKey.mock,
+		params:             &ChatRepositoryMockReserveIdempotencyKeyParams{ctx, key},
+		expectationOrigins: ChatRepositoryMockReserveIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReserveIdempotencyKey.expectations = append(mmReserveIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ReserveIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockReserveIdempotencyKeyExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockReserveIdempotencyKeyResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ReserveIdempotencyKey should be invoked
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) Times(n uint64) *mChatRepositoryMockReserveIdempotencyKey {
+	if n == 0 {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Times of ChatRepositoryMock.ReserveIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReserveIdempotencyKey.expectedInvocations, n)
+	mmReserveIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReserveIdempotencyKey
+}
+
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) invocationsDone() bool {
+	if len(mmReserveIdempotencyKey.expectations) == 0 && mmReserveIdempotencyKey.defaultExpectation == nil && mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReserveIdempotencyKey.mock.afterReserveIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReserveIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReserveIdempotencyKey implements mm_repository.ChatRepository
+func (mmReserveIdempotencyKey *ChatRepositoryMock) ReserveIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmReserveIdempotencyKey.beforeReserveIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserveIdempotencyKey.afterReserveIdempotencyKeyCounter, 1)
+
+	mmReserveIdempotencyKey.t.Helper()
+
+	if mmReserveIdempotencyKey.inspectFuncReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.inspectFuncReserveIdempotencyKey(ctx, key)
+	}
+
+	mm_params := ChatRepositoryMockReserveIdempotencyKeyParams{ctx, key}
+
+	// Record call args
+	mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.mutex.Lock()
+	mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.callArgs = append(mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.callArgs, &mm_params)
+	mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockReserveIdempotencyKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReserveIdempotencyKey.t.Errorf("ChatRepositoryMock.ReserveIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReserveIdempotencyKey.t.Errorf("ChatRepositoryMock.ReserveIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserveIdempotencyKey.t.Errorf("ChatRepositoryMock.ReserveIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserveIdempotencyKey.t.Fatal("No results are set for the ChatRepositoryMock.ReserveIdempotencyKey")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmReserveIdempotencyKey.funcReserveIdempotencyKey != nil {
+		return mmReserveIdempotencyKey.funcReserveIdempotencyKey(ctx, key)
+	}
+	mmReserveIdempotencyKey.t.Fatalf("Unexpected call to ChatRepositoryMock.ReserveIdempotencyKey. %v %v", ctx, key)
+	return
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// ReserveIdempotencyKeyAfterCounter returns a count of finished ChatRepositoryMock.ReserveIdempotencyKey invocations
+func (mmReserveIdempotencyKey *ChatRepositoryMock) ReserveIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveIdempotencyKey.afterReserveIdempotencyKeyCounter)
+}
+
+// ReserveIdempotencyKeyBeforeCounter returns a count of ChatRepositoryMock.ReserveIdempotencyKey invocations
+func (mmReserveIdempotencyKey *ChatRepositoryMock) ReserveIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveIdempotencyKey.beforeReserveIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ReserveIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserveIdempotencyKey *mChatRepositoryMockReserveIdempotencyKey) Calls() []*ChatRepositoryMockReserveIdempotencyKeyParams {
+	mmReserveIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockReserveIdempotencyKeyParams, len(mmReserveIdempotencyKey.callArgs))
+	copy(argCopy, mmReserveIdempotencyKey.callArgs)
+
+	mmReserveIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveIdempotencyKeyDone returns true if the count of the ReserveIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockReserveIdempotencyKeyDone() bool {
+	if m.ReserveIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	for _, e := range m.ReserveIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	return m.ReserveIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockReserveIdempotencyKeyInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockReserveIdempotencyKeyInspect() {
+	for _, e := range m.ReserveIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ReserveIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	afterReserveIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterReserveIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveIdempotencyKeyMock.defaultExpectation != nil && afterReserveIdempotencyKeyCounter < 1 {
+		if m.ReserveIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ReserveIdempotencyKey at\n%s", m.ReserveIdempotencyKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ReserveIdempotencyKey at\n%s with params: %#v", m.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.ReserveIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserveIdempotencyKey != nil && afterReserveIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ReserveIdempotencyKey at\n%s", m.funcReserveIdempotencyKeyOrigin)
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	if !m.ReserveIdempotencyKeyMock.invocationsDone() && afterReserveIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ReserveIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReserveIdempotencyKeyMock.expectedInvocations), m.ReserveIdempotencyKeyMock.expectedInvocationsOrigin, afterReserveIdempotencyKeyCounter)
+	}
 }
 
-// ExpectIdParam2 sets up expected param id for ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) ExpectIdParam2(id int64) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+type mChatRepositoryMockSaveIdempotencyResult struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSaveIdempotencyResultExpectation
+	expectations       []*ChatRepositoryMockSaveIdempotencyResultExpectation
+
+	callArgs []*ChatRepositoryMockSaveIdempotencyResultParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSaveIdempotencyResultExpectation specifies expectation struct of the ChatRepository.SaveIdempotencyResult
+type ChatRepositoryMockSaveIdempotencyResultExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSaveIdempotencyResultParams
+	paramPtrs          *ChatRepositoryMockSaveIdempotencyResultParamPtrs
+	expectationOrigins ChatRepositoryMockSaveIdempotencyResultExpectationOrigins
+	results            *ChatRepositoryMockSaveIdempotencyResultResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSaveIdempotencyResultParams contains parameters of the ChatRepository.SaveIdempotencyResult
+type ChatRepositoryMockSaveIdempotencyResultParams struct {
+	ctx      context.Context
+	key      *model.IdempotencyKey
+	resultID int64
+}
+
+// ChatRepositoryMockSaveIdempotencyResultParamPtrs contains pointers to parameters of the ChatRepository.SaveIdempotencyResult
+type ChatRepositoryMockSaveIdempotencyResultParamPtrs struct {
+	ctx      *context.Context
+	key      **model.IdempotencyKey
+	resultID *int64
+}
+
+// ChatRepositoryMockSaveIdempotencyResultResults contains results of the ChatRepository.SaveIdempotencyResult
+type ChatRepositoryMockSaveIdempotencyResultResults struct {
+	err error
+}
+
+// ChatRepositoryMockSaveIdempotencyResultOrigins contains origins of expectations of the ChatRepository.SaveIdempotencyResult
+type ChatRepositoryMockSaveIdempotencyResultExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originKey      string
+	originResultID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) Optional() *mChatRepositoryMockSaveIdempotencyResult {
+	mmSaveIdempotencyResult.optional = true
+	return mmSaveIdempotencyResult
+}
+
+// Expect sets up expected params for ChatRepository.SaveIdempotencyResult
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) Expect(ctx context.Context, key *model.IdempotencyKey, resultID int64) *mChatRepositoryMockSaveIdempotencyResult {
+	if mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{}
+	if mmSaveIdempotencyResult.defaultExpectation == nil {
+		mmSaveIdempotencyResult.defaultExpectation = &ChatRepositoryMockSaveIdempotencyResultExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Expect")
+	if mmSaveIdempotencyResult.defaultExpectation.paramPtrs != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by ExpectParams functions")
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteChatParamPtrs{}
+	mmSaveIdempotencyResult.defaultExpectation.params = &ChatRepositoryMockSaveIdempotencyResultParams{ctx, key, resultID}
+	mmSaveIdempotencyResult.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveIdempotencyResult.expectations {
+		if minimock.Equal(e.params, mmSaveIdempotencyResult.defaultExpectation.params) {
+			mmSaveIdempotencyResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveIdempotencyResult.defaultExpectation.params)
+		}
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.id = &id
-	mmDeleteChat.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	return mmSaveIdempotencyResult
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockDeleteChat {
-	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.DeleteChat")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SaveIdempotencyResult
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSaveIdempotencyResult {
+	if mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Set")
 	}
 
-	mmDeleteChat.mock.inspectFuncDeleteChat = f
+	if mmSaveIdempotencyResult.defaultExpectation == nil {
+		mmSaveIdempotencyResult.defaultExpectation = &ChatRepositoryMockSaveIdempotencyResultExpectation{}
+	}
 
-	return mmDeleteChat
+	if mmSaveIdempotencyResult.defaultExpectation.params != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Expect")
+	}
+
+	if mmSaveIdempotencyResult.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotencyResult.defaultExpectation.paramPtrs = &ChatRepositoryMockSaveIdempotencyResultParamPtrs{}
+	}
+	mmSaveIdempotencyResult.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveIdempotencyResult.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveIdempotencyResult
 }
 
-// Return sets up results that will be returned by ChatRepository.DeleteChat
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Return(err error) *ChatRepositoryMock {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+// ExpectKeyParam2 sets up expected param key for ChatRepository.SaveIdempotencyResult
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) ExpectKeyParam2(key *model.IdempotencyKey) *mChatRepositoryMockSaveIdempotencyResult {
+	if mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatRepositoryMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	if mmSaveIdempotencyResult.defaultExpectation == nil {
+		mmSaveIdempotencyResult.defaultExpectation = &ChatRepositoryMockSaveIdempotencyResultExpectation{}
 	}
-	mmDeleteChat.defaultExpectation.results = &ChatRepositoryMockDeleteChatResults{err}
-	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
+
+	if mmSaveIdempotencyResult.defaultExpectation.params != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Expect")
+	}
+
+	if mmSaveIdempotencyResult.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotencyResult.defaultExpectation.paramPtrs = &ChatRepositoryMockSaveIdempotencyResultParamPtrs{}
+	}
+	mmSaveIdempotencyResult.defaultExpectation.paramPtrs.key = &key
+	mmSaveIdempotencyResult.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSaveIdempotencyResult
 }
 
-// Set uses given function f to mock the ChatRepository.DeleteChat method
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Set(f func(ctx context.Context, id int64) (err error)) *ChatRepositoryMock {
-	if mmDeleteChat.defaultExpectation != nil {
-		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.DeleteChat method")
+// ExpectResultIDParam3 sets up expected param resultID for ChatRepository.SaveIdempotencyResult
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) ExpectResultIDParam3(resultID int64) *mChatRepositoryMockSaveIdempotencyResult {
+	if mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Set")
 	}
 
-	if len(mmDeleteChat.expectations) > 0 {
-		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.DeleteChat method")
+	if mmSaveIdempotencyResult.defaultExpectation == nil {
+		mmSaveIdempotencyResult.defaultExpectation = &ChatRepositoryMockSaveIdempotencyResultExpectation{}
 	}
 
-	mmDeleteChat.mock.funcDeleteChat = f
-	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
+	if mmSaveIdempotencyResult.defaultExpectation.params != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Expect")
+	}
+
+	if mmSaveIdempotencyResult.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotencyResult.defaultExpectation.paramPtrs = &ChatRepositoryMockSaveIdempotencyResultParamPtrs{}
+	}
+	mmSaveIdempotencyResult.defaultExpectation.paramPtrs.resultID = &resultID
+	mmSaveIdempotencyResult.defaultExpectation.expectationOrigins.originResultID = minimock.CallerInfo(1)
+
+	return mmSaveIdempotencyResult
 }
 
-// When sets expectation for the ChatRepository.DeleteChat which will trigger the result defined by the following
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SaveIdempotencyResult
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) Inspect(f func(ctx context.Context, key *model.IdempotencyKey, resultID int64)) *mChatRepositoryMockSaveIdempotencyResult {
+	if mmSaveIdempotencyResult.mock.inspectFuncSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SaveIdempotencyResult")
+	}
+
+	mmSaveIdempotencyResult.mock.inspectFuncSaveIdempotencyResult = f
+
+	return mmSaveIdempotencyResult
+}
+
+// Return sets up results that will be returned by ChatRepository.SaveIdempotencyResult
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) Return(err error) *ChatRepositoryMock {
+	if mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Set")
+	}
+
+	if mmSaveIdempotencyResult.defaultExpectation == nil {
+		mmSaveIdempotencyResult.defaultExpectation = &ChatRepositoryMockSaveIdempotencyResultExpectation{mock: mmSaveIdempotencyResult.mock}
+	}
+	mmSaveIdempotencyResult.defaultExpectation.results = &ChatRepositoryMockSaveIdempotencyResultResults{err}
+	mmSaveIdempotencyResult.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveIdempotencyResult.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SaveIdempotencyResult method
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) Set(f func(ctx context.Context, key *model.IdempotencyKey, resultID int64) (err error)) *ChatRepositoryMock {
+	if mmSaveIdempotencyResult.defaultExpectation != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SaveIdempotencyResult method")
+	}
+
+	if len(mmSaveIdempotencyResult.expectations) > 0 {
+		mmSaveIdempotencyResult.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SaveIdempotencyResult method")
+	}
+
+	mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult = f
+	mmSaveIdempotencyResult.mock.funcSaveIdempotencyResultOrigin = minimock.CallerInfo(1)
+	return mmSaveIdempotencyResult.mock
+}
+
+// When sets expectation for the ChatRepository.SaveIdempotencyResult which will trigger the result defined by the following
 // Then helper
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) When(ctx context.Context, id int64) *ChatRepositoryMockDeleteChatExpectation {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatRepositoryMock.DeleteChat mock is already set by Set")
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) When(ctx context.Context, key *model.IdempotencyKey, resultID int64) *ChatRepositoryMockSaveIdempotencyResultExpectation {
+	if mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.mock.t.Fatalf("ChatRepositoryMock.SaveIdempotencyResult mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockDeleteChatExpectation{
-		mock:               mmDeleteChat.mock,
-		params:             &ChatRepositoryMockDeleteChatParams{ctx, id},
-		expectationOrigins: ChatRepositoryMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockSaveIdempotencyResultExpectation{
+		mock:               mmSaveIdempotencyResult.mock,
+		params:             &ChatRepositoryMockSaveIdempotencyResultParams{ctx, key, resultID},
+		expectationOrigins: ChatRepositoryMockSaveIdempotencyResultExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	mmSaveIdempotencyResult.expectations = append(mmSaveIdempotencyResult.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.DeleteChat return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockDeleteChatExpectation) Then(err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockDeleteChatResults{err}
+// Then sets up ChatRepository.SaveIdempotencyResult return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSaveIdempotencyResultExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSaveIdempotencyResultResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.DeleteChat should be invoked
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Times(n uint64) *mChatRepositoryMockDeleteChat {
+// Times sets number of times ChatRepository.SaveIdempotencyResult should be invoked
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) Times(n uint64) *mChatRepositoryMockSaveIdempotencyResult {
 	if n == 0 {
-		mmDeleteChat.mock.t.Fatalf("Times of ChatRepositoryMock.DeleteChat mock can not be zero")
+		mmSaveIdempotencyResult.mock.t.Fatalf("Times of ChatRepositoryMock.SaveIdempotencyResult mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
-	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat
+	mm_atomic.StoreUint64(&mmSaveIdempotencyResult.expectedInvocations, n)
+	mmSaveIdempotencyResult.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveIdempotencyResult
 }
 
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) invocationsDone() bool {
-	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) invocationsDone() bool {
+	if len(mmSaveIdempotencyResult.expectations) == 0 && mmSaveIdempotencyResult.defaultExpectation == nil && mmSaveIdempotencyResult.mock.funcSaveIdempotencyResult == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveIdempotencyResult.mock.afterSaveIdempotencyResultCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveIdempotencyResult.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteChat implements mm_repository.ChatRepository
-func (mmDeleteChat *ChatRepositoryMock) DeleteChat(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+// SaveIdempotencyResult implements mm_repository.ChatRepository
+func (mmSaveIdempotencyResult *ChatRepositoryMock) SaveIdempotencyResult(ctx context.Context, key *model.IdempotencyKey, resultID int64) (err error) {
+	mm_atomic.AddUint64(&mmSaveIdempotencyResult.beforeSaveIdempotencyResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveIdempotencyResult.afterSaveIdempotencyResultCounter, 1)
 
-	mmDeleteChat.t.Helper()
+	mmSaveIdempotencyResult.t.Helper()
 
-	if mmDeleteChat.inspectFuncDeleteChat != nil {
-		mmDeleteChat.inspectFuncDeleteChat(ctx, id)
+	if mmSaveIdempotencyResult.inspectFuncSaveIdempotencyResult != nil {
+		mmSaveIdempotencyResult.inspectFuncSaveIdempotencyResult(ctx, key, resultID)
 	}
 
-	mm_params := ChatRepositoryMockDeleteChatParams{ctx, id}
+	mm_params := ChatRepositoryMockSaveIdempotencyResultParams{ctx, key, resultID}
 
 	// Record call args
-	mmDeleteChat.DeleteChatMock.mutex.Lock()
-	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
-	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+	mmSaveIdempotencyResult.SaveIdempotencyResultMock.mutex.Lock()
+	mmSaveIdempotencyResult.SaveIdempotencyResultMock.callArgs = append(mmSaveIdempotencyResult.SaveIdempotencyResultMock.callArgs, &mm_params)
+	mmSaveIdempotencyResult.SaveIdempotencyResultMock.mutex.Unlock()
 
-	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+	for _, e := range mmSaveIdempotencyResult.SaveIdempotencyResultMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+	if mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockDeleteChatParams{ctx, id}
+		mm_got := ChatRepositoryMockSaveIdempotencyResultParams{ctx, key, resultID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSaveIdempotencyResult.t.Errorf("ChatRepositoryMock.SaveIdempotencyResult got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSaveIdempotencyResult.t.Errorf("ChatRepositoryMock.SaveIdempotencyResult got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.resultID != nil && !minimock.Equal(*mm_want_ptrs.resultID, mm_got.resultID) {
+				mmSaveIdempotencyResult.t.Errorf("ChatRepositoryMock.SaveIdempotencyResult got unexpected parameter resultID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.expectationOrigins.originResultID, *mm_want_ptrs.resultID, mm_got.resultID, minimock.Diff(*mm_want_ptrs.resultID, mm_got.resultID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteChat.t.Errorf("ChatRepositoryMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveIdempotencyResult.t.Errorf("ChatRepositoryMock.SaveIdempotencyResult got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		mm_results := mmSaveIdempotencyResult.SaveIdempotencyResultMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteChat.t.Fatal("No results are set for the ChatRepositoryMock.DeleteChat")
+			mmSaveIdempotencyResult.t.Fatal("No results are set for the ChatRepositoryMock.SaveIdempotencyResult")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteChat.funcDeleteChat != nil {
-		return mmDeleteChat.funcDeleteChat(ctx, id)
+	if mmSaveIdempotencyResult.funcSaveIdempotencyResult != nil {
+		return mmSaveIdempotencyResult.funcSaveIdempotencyResult(ctx, key, resultID)
 	}
-	mmDeleteChat.t.Fatalf("Unexpected call to ChatRepositoryMock.DeleteChat. %v %v", ctx, id)
+	mmSaveIdempotencyResult.t.Fatalf("Unexpected call to ChatRepositoryMock.SaveIdempotencyResult. %v %v %v", ctx, key, resultID)
 	return
 }
 
-// DeleteChatAfterCounter returns a count of finished ChatRepositoryMock.DeleteChat invocations
-func (mmDeleteChat *ChatRepositoryMock) DeleteChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+// SaveIdempotencyResultAfterCounter returns a count of finished ChatRepositoryMock.SaveIdempotencyResult invocations
+func (mmSaveIdempotencyResult *ChatRepositoryMock) SaveIdempotencyResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveIdempotencyResult.afterSaveIdempotencyResultCounter)
 }
 
-// DeleteChatBeforeCounter returns a count of ChatRepositoryMock.DeleteChat invocations
-func (mmDeleteChat *ChatRepositoryMock) DeleteChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+// SaveIdempotencyResultBeforeCounter returns a count of ChatRepositoryMock.SaveIdempotencyResult invocations
+func (mmSaveIdempotencyResult *ChatRepositoryMock) SaveIdempotencyResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveIdempotencyResult.beforeSaveIdempotencyResultCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.DeleteChat.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SaveIdempotencyResult.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteChat *mChatRepositoryMockDeleteChat) Calls() []*ChatRepositoryMockDeleteChatParams {
-	mmDeleteChat.mutex.RLock()
+func (mmSaveIdempotencyResult *mChatRepositoryMockSaveIdempotencyResult) Calls() []*ChatRepositoryMockSaveIdempotencyResultParams {
+	mmSaveIdempotencyResult.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockDeleteChatParams, len(mmDeleteChat.callArgs))
-	copy(argCopy, mmDeleteChat.callArgs)
+	argCopy := make([]*ChatRepositoryMockSaveIdempotencyResultParams, len(mmSaveIdempotencyResult.callArgs))
+	copy(argCopy, mmSaveIdempotencyResult.callArgs)
 
-	mmDeleteChat.mutex.RUnlock()
+	mmSaveIdempotencyResult.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// MinimockSaveIdempotencyResultDone returns true if the count of the SaveIdempotencyResult invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockDeleteChatDone() bool {
-	if m.DeleteChatMock.optional {
+func (m *ChatRepositoryMock) MinimockSaveIdempotencyResultDone() bool {
+	if m.SaveIdempotencyResultMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteChatMock.expectations {
+	for _, e := range m.SaveIdempotencyResultMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteChatMock.invocationsDone()
+	return m.SaveIdempotencyResultMock.invocationsDone()
 }
 
-// MinimockDeleteChatInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockDeleteChatInspect() {
-	for _, e := range m.DeleteChatMock.expectations {
+// MinimockSaveIdempotencyResultInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSaveIdempotencyResultInspect() {
+	for _, e := range m.SaveIdempotencyResultMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SaveIdempotencyResult at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	afterSaveIdempotencyResultCounter := mm_atomic.LoadUint64(&m.afterSaveIdempotencyResultCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
-		if m.DeleteChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+	if m.SaveIdempotencyResultMock.defaultExpectation != nil && afterSaveIdempotencyResultCounter < 1 {
+		if m.SaveIdempotencyResultMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SaveIdempotencyResult at\n%s", m.SaveIdempotencyResultMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SaveIdempotencyResult at\n%s with params: %#v", m.SaveIdempotencyResultMock.defaultExpectation.expectationOrigins.origin, *m.SaveIdempotencyResultMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	if m.funcSaveIdempotencyResult != nil && afterSaveIdempotencyResultCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SaveIdempotencyResult at\n%s", m.funcSaveIdempotencyResultOrigin)
 	}
 
-	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.DeleteChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	if !m.SaveIdempotencyResultMock.invocationsDone() && afterSaveIdempotencyResultCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SaveIdempotencyResult at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveIdempotencyResultMock.expectedInvocations), m.SaveIdempotencyResultMock.expectedInvocationsOrigin, afterSaveIdempotencyResultCounter)
 	}
 }
 
@@ -786,6 +9186,7 @@ type ChatRepositoryMockSendMessageParamPtrs struct {
 
 // ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
 type ChatRepositoryMockSendMessageResults struct {
+	mp1 *model.Message
 	err error
 }
 
@@ -889,7 +9290,7 @@ func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.
 }
 
 // Return sets up results that will be returned by ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Return(err error) *ChatRepositoryMock {
+func (mmSendMessage *mChatRepositoryMockSendMessage) Return(mp1 *model.Message, err error) *ChatRepositoryMock {
 	if mmSendMessage.mock.funcSendMessage != nil {
 		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
 	}
@@ -897,13 +9298,13 @@ func (mmSendMessage *mChatRepositoryMockSendMessage) Return(err error) *ChatRepo
 	if mmSendMessage.defaultExpectation == nil {
 		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{err}
+	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{mp1, err}
 	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmSendMessage.mock
 }
 
 // Set uses given function f to mock the ChatRepository.SendMessage method
-func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, chat *model.ChatSendMessage) (err error)) *ChatRepositoryMock {
+func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, chat *model.ChatSendMessage) (mp1 *model.Message, err error)) *ChatRepositoryMock {
 	if mmSendMessage.defaultExpectation != nil {
 		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
 	}
@@ -934,8 +9335,8 @@ func (mmSendMessage *mChatRepositoryMockSendMessage) When(ctx context.Context, c
 }
 
 // Then sets up ChatRepository.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockSendMessageExpectation) Then(err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockSendMessageResults{err}
+func (e *ChatRepositoryMockSendMessageExpectation) Then(mp1 *model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSendMessageResults{mp1, err}
 	return e.mock
 }
 
@@ -961,7 +9362,7 @@ func (mmSendMessage *mChatRepositoryMockSendMessage) invocationsDone() bool {
 }
 
 // SendMessage implements mm_repository.ChatRepository
-func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, chat *model.ChatSendMessage) (err error) {
+func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, chat *model.ChatSendMessage) (mp1 *model.Message, err error) {
 	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
 	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
 
@@ -981,7 +9382,7 @@ func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, chat *
 	for _, e := range mmSendMessage.SendMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.mp1, e.results.err
 		}
 	}
 
@@ -1013,7 +9414,7 @@ func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, chat *
 		if mm_results == nil {
 			mmSendMessage.t.Fatal("No results are set for the ChatRepositoryMock.SendMessage")
 		}
-		return (*mm_results).err
+		return (*mm_results).mp1, (*mm_results).err
 	}
 	if mmSendMessage.funcSendMessage != nil {
 		return mmSendMessage.funcSendMessage(ctx, chat)
@@ -1090,15 +9491,467 @@ func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
 	}
 }
 
+type mChatRepositoryMockSetMemberRole struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSetMemberRoleExpectation
+	expectations       []*ChatRepositoryMockSetMemberRoleExpectation
+
+	callArgs []*ChatRepositoryMockSetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSetMemberRoleExpectation specifies expectation struct of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSetMemberRoleParams
+	paramPtrs          *ChatRepositoryMockSetMemberRoleParamPtrs
+	expectationOrigins ChatRepositoryMockSetMemberRoleExpectationOrigins
+	results            *ChatRepositoryMockSetMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSetMemberRoleParams contains parameters of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleParams struct {
+	ctx    context.Context
+	chatID int64
+	userID string
+	role   model.Role
+}
+
+// ChatRepositoryMockSetMemberRoleParamPtrs contains pointers to parameters of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *string
+	role   *model.Role
+}
+
+// ChatRepositoryMockSetMemberRoleResults contains results of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleResults struct {
+	err error
+}
+
+// ChatRepositoryMockSetMemberRoleOrigins contains origins of expectations of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originUserID string
+	originRole   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Optional() *mChatRepositoryMockSetMemberRole {
+	mmSetMemberRole.optional = true
+	return mmSetMemberRole
+}
+
+// Expect sets up expected params for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Expect(ctx context.Context, chatID int64, userID string, role model.Role) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmSetMemberRole.defaultExpectation.params = &ChatRepositoryMockSetMemberRoleParams{ctx, chatID, userID, role}
+	mmSetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMemberRole.expectations {
+		if minimock.Equal(e.params, mmSetMemberRole.defaultExpectation.params) {
+			mmSetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectUserIDParam3(userID string) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.userID = &userID
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectRoleParam4 sets up expected param role for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectRoleParam4(role model.Role) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.role = &role
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Inspect(f func(ctx context.Context, chatID int64, userID string, role model.Role)) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SetMemberRole")
+	}
+
+	mmSetMemberRole.mock.inspectFuncSetMemberRole = f
+
+	return mmSetMemberRole
+}
+
+// Return sets up results that will be returned by ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Return(err error) *ChatRepositoryMock {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{mock: mmSetMemberRole.mock}
+	}
+	mmSetMemberRole.defaultExpectation.results = &ChatRepositoryMockSetMemberRoleResults{err}
+	mmSetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SetMemberRole method
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Set(f func(ctx context.Context, chatID int64, userID string, role model.Role) (err error)) *ChatRepositoryMock {
+	if mmSetMemberRole.defaultExpectation != nil {
+		mmSetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SetMemberRole method")
+	}
+
+	if len(mmSetMemberRole.expectations) > 0 {
+		mmSetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SetMemberRole method")
+	}
+
+	mmSetMemberRole.mock.funcSetMemberRole = f
+	mmSetMemberRole.mock.funcSetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// When sets expectation for the ChatRepository.SetMemberRole which will trigger the result defined by the following
+// Then helper
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) When(ctx context.Context, chatID int64, userID string, role model.Role) *ChatRepositoryMockSetMemberRoleExpectation {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockSetMemberRoleExpectation{
+		mock:               mmSetMemberRole.mock,
+		params:             &ChatRepositoryMockSetMemberRoleParams{ctx, chatID, userID, role},
+		expectationOrigins: ChatRepositoryMockSetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetMemberRole.expectations = append(mmSetMemberRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.SetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSetMemberRoleExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSetMemberRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.SetMemberRole should be invoked
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Times(n uint64) *mChatRepositoryMockSetMemberRole {
+	if n == 0 {
+		mmSetMemberRole.mock.t.Fatalf("Times of ChatRepositoryMock.SetMemberRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetMemberRole.expectedInvocations, n)
+	mmSetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole
+}
+
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) invocationsDone() bool {
+	if len(mmSetMemberRole.expectations) == 0 && mmSetMemberRole.defaultExpectation == nil && mmSetMemberRole.mock.funcSetMemberRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.mock.afterSetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetMemberRole implements mm_repository.ChatRepository
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRole(ctx context.Context, chatID int64, userID string, role model.Role) (err error) {
+	mm_atomic.AddUint64(&mmSetMemberRole.beforeSetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMemberRole.afterSetMemberRoleCounter, 1)
+
+	mmSetMemberRole.t.Helper()
+
+	if mmSetMemberRole.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.inspectFuncSetMemberRole(ctx, chatID, userID, role)
+	}
+
+	mm_params := ChatRepositoryMockSetMemberRoleParams{ctx, chatID, userID, role}
+
+	// Record call args
+	mmSetMemberRole.SetMemberRoleMock.mutex.Lock()
+	mmSetMemberRole.SetMemberRoleMock.callArgs = append(mmSetMemberRole.SetMemberRoleMock.callArgs, &mm_params)
+	mmSetMemberRole.SetMemberRoleMock.mutex.Unlock()
+
+	for _, e := range mmSetMemberRole.SetMemberRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetMemberRole.SetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMemberRole.SetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockSetMemberRoleParams{ctx, chatID, userID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMemberRole.t.Fatal("No results are set for the ChatRepositoryMock.SetMemberRole")
+		}
+		return (*mm_results).err
+	}
+	if mmSetMemberRole.funcSetMemberRole != nil {
+		return mmSetMemberRole.funcSetMemberRole(ctx, chatID, userID, role)
+	}
+	mmSetMemberRole.t.Fatalf("Unexpected call to ChatRepositoryMock.SetMemberRole. %v %v %v %v", ctx, chatID, userID, role)
+	return
+}
+
+// SetMemberRoleAfterCounter returns a count of finished ChatRepositoryMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.afterSetMemberRoleCounter)
+}
+
+// SetMemberRoleBeforeCounter returns a count of ChatRepositoryMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.beforeSetMemberRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SetMemberRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Calls() []*ChatRepositoryMockSetMemberRoleParams {
+	mmSetMemberRole.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockSetMemberRoleParams, len(mmSetMemberRole.callArgs))
+	copy(argCopy, mmSetMemberRole.callArgs)
+
+	mmSetMemberRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMemberRoleDone returns true if the count of the SetMemberRole invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockSetMemberRoleDone() bool {
+	if m.SetMemberRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMemberRoleMock.invocationsDone()
+}
+
+// MinimockSetMemberRoleInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSetMemberRoleInspect() {
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterSetMemberRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMemberRoleMock.defaultExpectation != nil && afterSetMemberRoleCounter < 1 {
+		if m.SetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s", m.SetMemberRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s with params: %#v", m.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.SetMemberRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMemberRole != nil && afterSetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s", m.funcSetMemberRoleOrigin)
+	}
+
+	if !m.SetMemberRoleMock.invocationsDone() && afterSetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberRoleMock.expectedInvocations), m.SetMemberRoleMock.expectedInvocationsOrigin, afterSetMemberRoleCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *ChatRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddChatMembersInspect()
+
+			m.MinimockCountChatMembersInspect()
+
 			m.MinimockCreateChatInspect()
 
 			m.MinimockDeleteChatInspect()
 
+			m.MinimockDeleteExpiredIdempotencyKeysInspect()
+
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockGetChatInspect()
+
+			m.MinimockGetIdempotencyResultInspect()
+
+			m.MinimockGetMemberRoleInspect()
+
+			m.MinimockGetMessageInspect()
+
+			m.MinimockIsChatExistsInspect()
+
+			m.MinimockIsChatMemberInspect()
+
+			m.MinimockListChatMembersInspect()
+
+			m.MinimockListMessagesInspect()
+
+			m.MinimockListReadReceiptsInspect()
+
+			m.MinimockListThreadRepliesInspect()
+
+			m.MinimockListUserChatsInspect()
+
+			m.MinimockListenMessagesInspect()
+
+			m.MinimockLockMessageInspect()
+
+			m.MinimockMarkReadInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockRemoveChatMembersInspect()
+
+			m.MinimockReserveIdempotencyKeyInspect()
+
+			m.MinimockSaveIdempotencyResultInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSetMemberRoleInspect()
 		}
 	})
 }
@@ -1122,7 +9975,31 @@ func (m *ChatRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddChatMembersDone() &&
+		m.MinimockCountChatMembersDone() &&
 		m.MinimockCreateChatDone() &&
 		m.MinimockDeleteChatDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockDeleteExpiredIdempotencyKeysDone() &&
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockGetChatDone() &&
+		m.MinimockGetIdempotencyResultDone() &&
+		m.MinimockGetMemberRoleDone() &&
+		m.MinimockGetMessageDone() &&
+		m.MinimockIsChatExistsDone() &&
+		m.MinimockIsChatMemberDone() &&
+		m.MinimockListChatMembersDone() &&
+		m.MinimockListMessagesDone() &&
+		m.MinimockListReadReceiptsDone() &&
+		m.MinimockListThreadRepliesDone() &&
+		m.MinimockListUserChatsDone() &&
+		m.MinimockListenMessagesDone() &&
+		m.MinimockLockMessageDone() &&
+		m.MinimockMarkReadDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockRemoveChatMembersDone() &&
+		m.MinimockReserveIdempotencyKeyDone() &&
+		m.MinimockSaveIdempotencyResultDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSetMemberRoleDone()
 }
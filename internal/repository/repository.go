@@ -10,5 +10,29 @@ import (
 type ChatRepository interface {
 	CreateChat(ctx context.Context, chat *model.ChatCreate) (int64, error)
 	DeleteChat(ctx context.Context, id int64) error
-	SendMessage(ctx context.Context, chat *model.ChatSendMessage) error
+	SendMessage(ctx context.Context, chat *model.ChatSendMessage) (*model.Message, error)
+	IsChatExists(ctx context.Context, chatID int64) (bool, error)
+	IsChatMember(ctx context.Context, chatID int64, userID string) (bool, error)
+	GetMessage(ctx context.Context, id int64) (*model.Message, error)
+	LockMessage(ctx context.Context, id int64) (*model.Message, error)
+	EditMessage(ctx context.Context, id int64, text string) error
+	DeleteMessage(ctx context.Context, message *model.Message) error
+	PinMessage(ctx context.Context, id int64, pinned bool) error
+	ListThreadReplies(ctx context.Context, filter *model.ThreadFilter) ([]*model.Message, error)
+	MarkRead(ctx context.Context, chatID int64, userID string, message *model.Message) error
+	ListReadReceipts(ctx context.Context, message *model.Message) ([]*model.ReadReceipt, error)
+	ReserveIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) (bool, error)
+	GetIdempotencyResult(ctx context.Context, key *model.IdempotencyKey) (int64, error)
+	SaveIdempotencyResult(ctx context.Context, key *model.IdempotencyKey, resultID int64) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, batchSize uint64) (int64, error)
+	ListMessages(ctx context.Context, filter *model.MessageListFilter) ([]*model.Message, error)
+	ListenMessages(ctx context.Context, handler func(message *model.Message)) error
+	AddChatMembers(ctx context.Context, chatID int64, usersID []string) error
+	RemoveChatMembers(ctx context.Context, chatID int64, usersID []string) error
+	ListChatMembers(ctx context.Context, filter *model.MemberListFilter) ([]*model.Member, error)
+	CountChatMembers(ctx context.Context, chatID int64) (int64, error)
+	GetMemberRole(ctx context.Context, chatID int64, userID string) (model.Role, error)
+	SetMemberRole(ctx context.Context, chatID int64, userID string, role model.Role) error
+	GetChat(ctx context.Context, id int64) (*model.Chat, error)
+	ListUserChats(ctx context.Context, filter *model.ChatListFilter) ([]*model.Chat, error)
 }
@@ -0,0 +1,32 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Header метаданные gRPC, в которых передается ID запроса
+const Header = "x-request-id"
+
+type ctxKey struct{}
+
+// NewContext возвращает контекст с ID запроса
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext возвращает ID запроса из контекста или пустую строку, если его нет
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// New генерирует случайный ID запроса
+func New() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read не возвращает ошибок на поддерживаемых платформах
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
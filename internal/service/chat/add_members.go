@@ -0,0 +1,40 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// AddMembers добавляет пользователей в чат от имени владельца или администратора,
+// повторное добавление участника ничего не меняет
+func (s *service) AddMembers(ctx context.Context, members *model.ChatMembers) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	members.UserID = userID
+
+	// Проверка прав и изменение состава выполняются в serializable: при read committed параллельное
+	// понижение роли или передача владения не видны проверке, и изменение прошло бы по уже отозванным правам
+	err = s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, members.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		errTx = s.checkPermission(ctx, members.ChatID, members.UserID, permManageMembers)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.chatRepository.AddChatMembers(ctx, members.ChatID, members.UsersID)
+	})
+
+	return err
+}
@@ -0,0 +1,32 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/auth"
+)
+
+// callerID возвращает ID аутентифицированного пользователя, от имени которого выполняется запрос.
+// Идентификаторы пользователя из тела запроса не используются: им нельзя доверять.
+func callerID(ctx context.Context) (string, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return "", ErrUnauthenticated
+	}
+
+	return userID, nil
+}
+
+// checkMember проверяет, что пользователь состоит в чате
+func (s *service) checkMember(ctx context.Context, chatID int64, userID string) error {
+	isMember, err := s.chatRepository.IsChatMember(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !isMember {
+		return ErrNotChatMember
+	}
+
+	return nil
+}
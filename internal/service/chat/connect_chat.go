@@ -0,0 +1,63 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ipv02/chat-server/internal/hub"
+	"github.com/ipv02/chat-server/internal/metric"
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// ConnectChat передает в send новые сообщения чата, пока клиент не отключится
+func (s *service) ConnectChat(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	chat.UserID = userID
+
+	exists, err := s.chatRepository.IsChatExists(ctx, chat.ChatID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrChatNotFound
+	}
+
+	err = s.checkMember(ctx, chat.ChatID, chat.UserID)
+	if err != nil {
+		return err
+	}
+
+	sub := s.hub.Subscribe(chat.ChatID)
+	defer sub.Close()
+
+	metric.StreamOpened()
+	defer metric.StreamClosed()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-sub.Messages():
+			if !ok {
+				if errors.Is(sub.Err(), hub.ErrSlowSubscriber) {
+					return ErrSlowSubscriber
+				}
+
+				if errors.Is(sub.Err(), hub.ErrClosed) {
+					return ErrShuttingDown
+				}
+
+				return sub.Err()
+			}
+
+			if err = send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -3,15 +3,29 @@ package chat
 import (
 	"context"
 
+	"github.com/ipv02/chat-server/internal/metric"
 	"github.com/ipv02/chat-server/internal/model"
 )
 
 // CreateChat выполняет создание нового чата в сервисном слое
 func (s *service) CreateChat(ctx context.Context, chat *model.ChatCreate) (int64, error) {
-	var id int64
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	chat.CreatorID = userID
+
+	var (
+		id       int64
+		replayed bool
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
-		id, errTx = s.chatRepository.CreateChat(ctx, chat)
+		key := s.newIdempotencyKey(model.IdempotencyScopeCreateChat, chat.CreatorID, chat.IdempotencyKey)
+		id, replayed, errTx = s.runIdempotent(ctx, key, func(ctx context.Context) (int64, error) {
+			return s.chatRepository.CreateChat(ctx, chat)
+		})
 		if errTx != nil {
 			return errTx
 		}
@@ -23,5 +37,9 @@ func (s *service) CreateChat(ctx context.Context, chat *model.ChatCreate) (int64
 		return 0, err
 	}
 
+	if !replayed {
+		metric.IncChatsCreated()
+	}
+
 	return id, nil
 }
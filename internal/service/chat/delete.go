@@ -1,16 +1,36 @@
 package chat
 
-import "context"
+import (
+	"context"
 
-func (s *service) DeleteChat(ctx context.Context, id int64) error {
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		var errTx error
-		errTx = s.chatRepository.DeleteChat(ctx, id)
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// DeleteChat удаляет чат, удалить его может только владелец
+func (s *service) DeleteChat(ctx context.Context, chat *model.ChatDelete) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	chat.UserID = userID
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, chat.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		errTx = s.checkPermission(ctx, chat.ID, chat.UserID, permDeleteChat)
 		if errTx != nil {
 			return errTx
 		}
 
-		return nil
+		return s.chatRepository.DeleteChat(ctx, chat.ID)
 	})
 
 	return err
@@ -0,0 +1,42 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// DeleteMessage удаляет сообщение вместе с историей правок, оставляя в истории чата заглушку без текста.
+// Удалить может автор или администратор чата, повторное удаление ничего не меняет.
+func (s *service) DeleteMessage(ctx context.Context, del *model.MessageDelete) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	del.UserID = userID
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		message, errTx := s.chatRepository.LockMessage(ctx, del.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message == nil {
+			return ErrMessageNotFound
+		}
+
+		errTx = s.checkMessageAccess(ctx, message, del.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message.DeletedAt != nil {
+			return nil
+		}
+
+		return s.chatRepository.DeleteMessage(ctx, message)
+	})
+
+	return err
+}
@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// EditMessage заменяет текст сообщения, сохраняя предыдущую версию в истории правок.
+// Редактировать может автор или администратор чата, пока не истекло время на редактирование.
+func (s *service) EditMessage(ctx context.Context, edit *model.MessageEdit) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	edit.UserID = userID
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		// Блокируем сообщение, чтобы параллельные правки не потеряли промежуточную версию
+		message, errTx := s.chatRepository.LockMessage(ctx, edit.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message == nil {
+			return ErrMessageNotFound
+		}
+
+		if message.DeletedAt != nil {
+			return ErrMessageDeleted
+		}
+
+		errTx = s.checkMessageAccess(ctx, message, edit.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		// Время сообщения выставляет сервер, клиент не может продлить окно, указав время из будущего
+		if s.editWindow > 0 && time.Since(message.CreatedAt) > s.editWindow {
+			return ErrEditWindowExpired
+		}
+
+		return s.chatRepository.EditMessage(ctx, edit.MessageID, edit.Text)
+	})
+
+	return err
+}
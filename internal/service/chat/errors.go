@@ -0,0 +1,47 @@
+package chat
+
+import (
+	"github.com/ipv02/chat-server/internal/apperr"
+)
+
+var (
+	// ErrUnauthenticated запрос выполняется без аутентифицированного пользователя
+	ErrUnauthenticated = apperr.Unauthenticated("authentication required")
+	// ErrChatNotFound чат с указанным ID не существует
+	ErrChatNotFound = apperr.NotFound("chat not found")
+	// ErrNotChatMember пользователь не состоит в чате
+	ErrNotChatMember = apperr.PermissionDenied("user is not a member of the chat")
+	// ErrPermissionDenied роль пользователя не позволяет выполнить действие
+	ErrPermissionDenied = apperr.PermissionDenied("user role does not allow this action")
+	// ErrTargetNotMember пользователь, над которым выполняется действие, не состоит в чате
+	ErrTargetNotMember = apperr.Conflict("target user is not a member of the chat")
+	// ErrOwnerCannotLeave владелец не может покинуть чат, пока в нем есть другие участники
+	ErrOwnerCannotLeave = apperr.Conflict("owner must transfer ownership before leaving the chat")
+	// ErrInvalidRole роль не может быть назначена напрямую
+	ErrInvalidRole = apperr.InvalidInput("invalid role", apperr.FieldViolation{
+		Field:       "role",
+		Description: "owner role can only be assigned by transferring ownership",
+	})
+	// ErrMessageNotFound сообщение с указанным ID не существует
+	ErrMessageNotFound = apperr.NotFound("message not found")
+	// ErrMessageDeleted сообщение уже удалено и не может быть изменено
+	ErrMessageDeleted = apperr.Conflict("message has been deleted")
+	// ErrEditWindowExpired время, отведенное на редактирование сообщения, истекло
+	ErrEditWindowExpired = apperr.Conflict("message can no longer be edited")
+	// ErrReplyTargetNotFound сообщение, на которое отвечает новое, не существует
+	ErrReplyTargetNotFound = apperr.NotFound("reply target message not found")
+	// ErrReplyToOtherChat ответить можно только на сообщение того же чата
+	ErrReplyToOtherChat = apperr.InvalidInput("invalid reply target", apperr.FieldViolation{
+		Field:       "reply_to_message_id",
+		Description: "reply target message belongs to another chat",
+	})
+	// ErrMessageNotInChat сообщение относится к другому чату
+	ErrMessageNotInChat = apperr.InvalidInput("invalid message", apperr.FieldViolation{
+		Field:       "message_id",
+		Description: "message belongs to another chat",
+	})
+	// ErrSlowSubscriber клиент не успевал вычитывать поток сообщений и был отключен
+	ErrSlowSubscriber = apperr.ResourceExhausted("stream was closed because the client is too slow")
+	// ErrShuttingDown поток закрыт из-за остановки сервера, клиенту следует переподключиться
+	ErrShuttingDown = apperr.Unavailable("stream was closed because the server is shutting down")
+)
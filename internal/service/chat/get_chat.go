@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// GetChat возвращает чат со списком участников и превью последнего сообщения
+func (s *service) GetChat(ctx context.Context, id int64) (*model.Chat, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var chat *model.Chat
+	// Чат и его участники читаются из одного снимка
+	err = s.txManager.ReadOnly(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		errTx = s.checkMember(ctx, id, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		chat, errTx = s.chatRepository.GetChat(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		chat.Members, errTx = s.chatRepository.ListChatMembers(ctx, &model.MemberListFilter{ChatID: id})
+
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	toPreview(chat)
+
+	return chat, nil
+}
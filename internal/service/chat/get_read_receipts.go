@@ -0,0 +1,31 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// GetReadReceipts возвращает участников чата, прочитавших сообщение
+func (s *service) GetReadReceipts(ctx context.Context, messageID int64) ([]*model.ReadReceipt, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.chatRepository.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message == nil {
+		return nil, ErrMessageNotFound
+	}
+
+	err = s.checkMember(ctx, message.ChatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.chatRepository.ListReadReceipts(ctx, message)
+}
@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// newIdempotencyKey возвращает ключ идемпотентности запроса или nil, если клиент его не передал
+func (s *service) newIdempotencyKey(scope, userID, key string) *model.IdempotencyKey {
+	if key == "" {
+		return nil
+	}
+
+	return &model.IdempotencyKey{
+		Scope:  scope,
+		UserID: userID,
+		Key:    key,
+		TTL:    s.idempotencyTTL,
+	}
+}
+
+// runIdempotent выполняет запись не более одного раза для ключа идемпотентности и возвращает ее результат.
+// Вызывается внутри транзакции: ключ занимается в той же транзакции, что и запись, поэтому конкурентный
+// дубликат дожидается ее завершения и получает сохраненный результат, а при откате ключ освобождается.
+// Второе значение сообщает, что результат взят из ранее обработанного запроса.
+func (s *service) runIdempotent(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+	write func(ctx context.Context) (int64, error),
+) (int64, bool, error) {
+	if key == nil {
+		id, err := write(ctx)
+		return id, false, err
+	}
+
+	reserved, err := s.chatRepository.ReserveIdempotencyKey(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if !reserved {
+		id, errResult := s.chatRepository.GetIdempotencyResult(ctx, key)
+		if errResult != nil {
+			return 0, false, errResult
+		}
+
+		return id, true, nil
+	}
+
+	id, err := write(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = s.chatRepository.SaveIdempotencyResult(ctx, key, id)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return id, false, nil
+}
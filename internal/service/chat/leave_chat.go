@@ -0,0 +1,65 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// LeaveChat исключает пользователя из чата, чат без участников удаляется.
+// Владелец может покинуть чат, только если остался в нем один.
+func (s *service) LeaveChat(ctx context.Context, leave *model.ChatLeave) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	leave.UserID = userID
+
+	// Выход и удаление опустевшего чата зависят от числа участников, поэтому выполняются в serializable,
+	// иначе параллельное добавление участников не будет учтено и чат удалится вместе с ними
+	err = s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, leave.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		role, errTx := s.chatRepository.GetMemberRole(ctx, leave.ChatID, leave.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if role == "" {
+			return nil
+		}
+
+		if role != model.RoleOwner {
+			errTx = s.chatRepository.RemoveChatMembers(ctx, leave.ChatID, []string{leave.UserID})
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		count, errTx := s.chatRepository.CountChatMembers(ctx, leave.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		// Владелец не удаляется из чата, поэтому он остается единственным учтенным участником
+		if role == model.RoleOwner && count > 1 {
+			return ErrOwnerCannotLeave
+		}
+
+		if role != model.RoleOwner && count > 0 {
+			return nil
+		}
+
+		return s.chatRepository.DeleteChat(ctx, leave.ChatID)
+	})
+
+	return err
+}
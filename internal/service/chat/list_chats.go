@@ -0,0 +1,44 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// ListChats возвращает страницу чатов пользователя, упорядоченных по последней активности
+func (s *service) ListChats(ctx context.Context, filter *model.ChatListFilter) (*model.ChatList, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.UserID = userID
+
+	limit := pageSize(filter.Limit)
+
+	// Запрашиваем на один чат больше, чтобы понять, есть ли следующая страница
+	repoFilter := *filter
+	repoFilter.Limit = limit + 1
+
+	chats, err := s.chatRepository.ListUserChats(ctx, &repoFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &model.ChatList{Chats: chats}
+	if uint64(len(chats)) > limit {
+		list.Chats = chats[:limit]
+		last := list.Chats[limit-1]
+		list.NextCursor = &model.ChatCursor{
+			LastActivityAt: last.LastActivityAt(),
+			ID:             last.ID,
+		}
+	}
+
+	for _, chat := range list.Chats {
+		toPreview(chat)
+	}
+
+	return list, nil
+}
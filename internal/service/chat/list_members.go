@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// ListMembers возвращает страницу участников чата
+func (s *service) ListMembers(ctx context.Context, filter *model.MemberListFilter) (*model.MemberList, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(filter.Limit)
+
+	var list *model.MemberList
+	// Проверка членства и страница участников читаются из одного снимка
+	err = s.txManager.ReadOnly(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, filter.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		errTx = s.checkMember(ctx, filter.ChatID, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		// Запрашиваем на одного участника больше, чтобы понять, есть ли следующая страница
+		repoFilter := *filter
+		repoFilter.Limit = limit + 1
+
+		members, errTx := s.chatRepository.ListChatMembers(ctx, &repoFilter)
+		if errTx != nil {
+			return errTx
+		}
+
+		list = &model.MemberList{Members: members}
+		if uint64(len(members)) > limit {
+			list.Members = members[:limit]
+			list.NextCursor = &model.MemberCursor{
+				UserID: list.Members[limit-1].UserID,
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
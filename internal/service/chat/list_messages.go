@@ -0,0 +1,53 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// ListMessages возвращает страницу истории чата от новых сообщений к старым
+func (s *service) ListMessages(ctx context.Context, filter *model.MessageListFilter) (*model.MessageList, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.chatRepository.IsChatExists(ctx, filter.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrChatNotFound
+	}
+
+	err = s.checkMember(ctx, filter.ChatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(filter.Limit)
+
+	// Запрашиваем на одно сообщение больше, чтобы понять, есть ли следующая страница
+	repoFilter := *filter
+	repoFilter.Limit = limit + 1
+
+	messages, err := s.chatRepository.ListMessages(ctx, &repoFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &model.MessageList{Messages: messages}
+	if uint64(len(messages)) > limit {
+		list.Messages = messages[:limit]
+
+		last := list.Messages[limit-1]
+		list.NextCursor = &model.MessageCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+
+	return list, nil
+}
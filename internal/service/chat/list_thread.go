@@ -0,0 +1,64 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// ListThread возвращает корневое сообщение треда и страницу ответов от старых к новым.
+// Для ответа возвращается тред, в котором он находится.
+func (s *service) ListThread(ctx context.Context, filter *model.ThreadFilter) (*model.Thread, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := s.chatRepository.GetMessage(ctx, filter.RootID)
+	if err != nil {
+		return nil, err
+	}
+
+	if root != nil && root.ReplyToMessageID != 0 {
+		root, err = s.chatRepository.GetMessage(ctx, root.ReplyToMessageID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if root == nil {
+		return nil, ErrMessageNotFound
+	}
+
+	err = s.checkMember(ctx, root.ChatID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(filter.Limit)
+
+	// Запрашиваем на один ответ больше, чтобы понять, есть ли следующая страница
+	repoFilter := *filter
+	repoFilter.RootID = root.ID
+	repoFilter.Limit = limit + 1
+
+	replies, err := s.chatRepository.ListThreadReplies(ctx, &repoFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	thread := &model.Thread{
+		Root:    root,
+		Replies: replies,
+	}
+	if uint64(len(replies)) > limit {
+		thread.Replies = replies[:limit]
+		last := thread.Replies[limit-1]
+		thread.NextCursor = &model.MessageCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+
+	return thread, nil
+}
@@ -0,0 +1,47 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// MarkRead отмечает сообщения чата прочитанными до указанного включительно
+func (s *service) MarkRead(ctx context.Context, read *model.ChatRead) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	read.UserID = userID
+
+	// Счетчик непрочитанных пересчитывается по снимку сообщений, repeatable read не даст перезаписать
+	// инкремент параллельной отправки: конфликтующая транзакция откатится и будет повторена
+	err = s.txManager.RepeatableRead(ctx, func(ctx context.Context) error {
+		message, errTx := s.chatRepository.GetMessage(ctx, read.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message == nil {
+			return ErrMessageNotFound
+		}
+
+		if message.ChatID != read.ChatID {
+			return ErrMessageNotInChat
+		}
+
+		role, errTx := s.chatRepository.GetMemberRole(ctx, read.ChatID, read.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if role == "" {
+			return ErrNotChatMember
+		}
+
+		return s.chatRepository.MarkRead(ctx, read.ChatID, read.UserID, message)
+	})
+
+	return err
+}
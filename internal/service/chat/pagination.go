@@ -0,0 +1,19 @@
+package chat
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// pageSize подставляет размер страницы по умолчанию и ограничивает запрошенный сверху
+func pageSize(limit uint64) uint64 {
+	if limit == 0 {
+		return defaultPageSize
+	}
+
+	if limit > maxPageSize {
+		return maxPageSize
+	}
+
+	return limit
+}
@@ -0,0 +1,79 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// permission действие внутри чата, требующее прав
+type permission int
+
+const (
+	// permPostMessages отправка сообщений
+	permPostMessages permission = iota
+	// permModerateMessages редактирование и удаление чужих сообщений
+	permModerateMessages
+	// permPinMessages закрепление сообщений
+	permPinMessages
+	// permManageMembers добавление и исключение участников
+	permManageMembers
+	// permManageRoles изменение ролей участников
+	permManageRoles
+	// permTransferOwnership передача владения чатом
+	permTransferOwnership
+	// permDeleteChat удаление чата
+	permDeleteChat
+)
+
+// rolePermissions набор разрешенных действий для каждой роли
+var rolePermissions = map[model.Role]map[permission]struct{}{
+	model.RoleOwner: {
+		permPostMessages:      {},
+		permModerateMessages:  {},
+		permPinMessages:       {},
+		permManageMembers:     {},
+		permManageRoles:       {},
+		permTransferOwnership: {},
+		permDeleteChat:        {},
+	},
+	model.RoleAdmin: {
+		permPostMessages:     {},
+		permModerateMessages: {},
+		permPinMessages:      {},
+		permManageMembers:    {},
+	},
+	model.RoleMember: {
+		permPostMessages: {},
+	},
+	model.RoleReadOnly: {},
+}
+
+// checkPermission проверяет, что пользователь состоит в чате и его роль разрешает действие
+func (s *service) checkPermission(ctx context.Context, chatID int64, userID string, perm permission) error {
+	role, err := s.chatRepository.GetMemberRole(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role == "" {
+		return ErrNotChatMember
+	}
+
+	if _, ok := rolePermissions[role][perm]; !ok {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// checkMessageAccess проверяет, что пользователь может изменять сообщение: автор сообщения с правом
+// отправки сообщений или участник с правом модерации, в обоих случаях он должен состоять в чате.
+// Автор, которого перевели в read_only, теряет возможность менять и свои сообщения.
+func (s *service) checkMessageAccess(ctx context.Context, message *model.Message, userID string) error {
+	if message.From != userID {
+		return s.checkPermission(ctx, message.ChatID, userID, permModerateMessages)
+	}
+
+	return s.checkPermission(ctx, message.ChatID, userID, permPostMessages)
+}
@@ -0,0 +1,42 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// PinMessage закрепляет или открепляет сообщение. Это доступно только ролям с правом закрепления,
+// авторство сообщения роли не играет. Удаленное сообщение уже откреплено и не меняется.
+func (s *service) PinMessage(ctx context.Context, pin *model.MessagePin) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	pin.UserID = userID
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		message, errTx := s.chatRepository.LockMessage(ctx, pin.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message == nil {
+			return ErrMessageNotFound
+		}
+
+		errTx = s.checkPermission(ctx, message.ChatID, pin.UserID, permPinMessages)
+		if errTx != nil {
+			return errTx
+		}
+
+		if message.DeletedAt != nil {
+			return ErrMessageDeleted
+		}
+
+		return s.chatRepository.PinMessage(ctx, pin.MessageID, pin.Pinned)
+	})
+
+	return err
+}
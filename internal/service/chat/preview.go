@@ -0,0 +1,18 @@
+package chat
+
+import "github.com/ipv02/chat-server/internal/model"
+
+// previewLength максимальная длина текста последнего сообщения в символах
+const previewLength = 100
+
+// toPreview обрезает текст последнего сообщения чата до длины превью
+func toPreview(chat *model.Chat) {
+	if chat.LastMessage == nil {
+		return
+	}
+
+	text := []rune(chat.LastMessage.Text)
+	if len(text) > previewLength {
+		chat.LastMessage.Text = string(text[:previewLength])
+	}
+}
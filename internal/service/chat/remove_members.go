@@ -0,0 +1,40 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// RemoveMembers удаляет пользователей из чата от имени владельца или администратора,
+// удаление не участника или владельца ничего не меняет
+func (s *service) RemoveMembers(ctx context.Context, members *model.ChatMembers) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	members.UserID = userID
+
+	// Состав чата меняется в serializable, чтобы проверка прав и удаление не расходились
+	// с параллельными изменениями ролей; при конфликте транзакция повторяется
+	err = s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, members.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		errTx = s.checkPermission(ctx, members.ChatID, members.UserID, permManageMembers)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.chatRepository.RemoveChatMembers(ctx, members.ChatID, members.UsersID)
+	})
+
+	return err
+}
@@ -3,11 +3,107 @@ package chat
 import (
 	"context"
 
+	"github.com/ipv02/chat-server/internal/metric"
 	"github.com/ipv02/chat-server/internal/model"
 )
 
 func (s *service) SendMessage(ctx context.Context, chat *model.ChatSendMessage) error {
-	err := s.chatRepository.SendMessage(ctx, chat)
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
 
-	return err
+	chat.From = userID
+
+	var (
+		message  *model.Message
+		replayed bool
+	)
+	// Ключ идемпотентности и сообщение пишутся в одной транзакции read committed: конкурентный дубликат
+	// дожидается ее завершения и получает сохраненный результат. Счетчики получателей растут атомарным
+	// инкрементом, а отправки в один чат упорядочены блокировкой строки чата, поэтому пересчет счетчика
+	// отправителя видит все ранее зафиксированные сообщения
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		key := s.newIdempotencyKey(model.IdempotencyScopeSendMessage, chat.From, chat.IdempotencyKey)
+		_, replayed, errTx = s.runIdempotent(ctx, key, func(ctx context.Context) (int64, error) {
+			var errSend error
+			message, errSend = s.sendMessage(ctx, chat)
+			if errSend != nil {
+				return 0, errSend
+			}
+
+			return message.ID, nil
+		})
+
+		return errTx
+	})
+	if err != nil {
+		return err
+	}
+
+	// Повтор уже обработанного запроса: сообщение было разослано при первой отправке
+	if replayed {
+		return nil
+	}
+
+	metric.IncMessagesSent()
+
+	// Рассылаем подписчикам только после коммита, чтобы не доставить сообщение, которого нет в истории
+	s.hub.Publish(message)
+
+	return nil
+}
+
+// sendMessage проверяет права отправителя и сохраняет сообщение в текущей транзакции
+func (s *service) sendMessage(ctx context.Context, chat *model.ChatSendMessage) (*model.Message, error) {
+	exists, err := s.chatRepository.IsChatExists(ctx, chat.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrChatNotFound
+	}
+
+	err = s.checkPermission(ctx, chat.ChatID, chat.From, permPostMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.ReplyToMessageID != 0 {
+		err = s.resolveThreadRoot(ctx, chat)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.chatRepository.SendMessage(ctx, chat)
+}
+
+// resolveThreadRoot проверяет сообщение, на которое отвечает новое, и заменяет его на корень треда:
+// треды одноуровневые, ответ на ответ попадает в тот же тред
+func (s *service) resolveThreadRoot(ctx context.Context, chat *model.ChatSendMessage) error {
+	target, err := s.chatRepository.GetMessage(ctx, chat.ReplyToMessageID)
+	if err != nil {
+		return err
+	}
+
+	if target == nil {
+		return ErrReplyTargetNotFound
+	}
+
+	if target.ChatID != chat.ChatID {
+		return ErrReplyToOtherChat
+	}
+
+	if target.DeletedAt != nil {
+		return ErrMessageDeleted
+	}
+
+	if target.ReplyToMessageID != 0 {
+		chat.ReplyToMessageID = target.ReplyToMessageID
+	}
+
+	return nil
 }
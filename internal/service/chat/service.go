@@ -1,7 +1,10 @@
 package chat
 
 import (
+	"time"
+
 	"github.com/ipv02/chat-server/internal/client/db"
+	"github.com/ipv02/chat-server/internal/hub"
 	"github.com/ipv02/chat-server/internal/repository"
 	chatService "github.com/ipv02/chat-server/internal/service"
 )
@@ -9,16 +12,36 @@ import (
 type service struct {
 	chatRepository repository.ChatRepository
 	txManager      db.TxManager
+	hub            *hub.Hub
+	// editWindow время после отправки, в течение которого сообщение можно редактировать, 0 снимает ограничение
+	editWindow time.Duration
+	// idempotencyTTL время, в течение которого повтор запроса с тем же ключом возвращает исходный результат
+	idempotencyTTL time.Duration
 }
 
 // NewService конструктор для создания связи между сервисным слоем и репо слоем
-func NewService(chatRepository repository.ChatRepository, txManager db.TxManager) chatService.ChatService {
+func NewService(
+	chatRepository repository.ChatRepository,
+	txManager db.TxManager,
+	hub *hub.Hub,
+	editWindow time.Duration,
+	idempotencyTTL time.Duration,
+) chatService.ChatService {
 	return &service{
 		chatRepository: chatRepository,
 		txManager:      txManager,
+		hub:            hub,
+		editWindow:     editWindow,
+		idempotencyTTL: idempotencyTTL,
 	}
 }
 
+// EditWindow время на редактирование сообщения для NewMockService
+type EditWindow time.Duration
+
+// IdempotencyTTL время жизни ключа идемпотентности для NewMockService
+type IdempotencyTTL time.Duration
+
 // NewMockService мок конструктор для создания связи между сервисным слоем и репо слоем
 func NewMockService(deps ...interface{}) chatService.ChatService {
 	service := service{}
@@ -29,6 +52,12 @@ func NewMockService(deps ...interface{}) chatService.ChatService {
 			service.chatRepository = s
 		case db.TxManager:
 			service.txManager = s
+		case *hub.Hub:
+			service.hub = s
+		case EditWindow:
+			service.editWindow = time.Duration(s)
+		case IdempotencyTTL:
+			service.idempotencyTTL = time.Duration(s)
 		}
 	}
 
@@ -40,5 +69,9 @@ func NewMockService(deps ...interface{}) chatService.ChatService {
 		panic("txManager должен быть инициализирован")
 	}
 
+	if service.hub == nil {
+		service.hub = hub.New(hub.DefaultBufferSize)
+	}
+
 	return &service
 }
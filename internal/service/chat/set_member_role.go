@@ -0,0 +1,57 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// SetMemberRole изменяет роль участника чата, доступно только владельцу
+func (s *service) SetMemberRole(ctx context.Context, memberRole *model.ChatMemberRole) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	memberRole.UserID = userID
+
+	if memberRole.Role == model.RoleOwner {
+		return ErrInvalidRole
+	}
+
+	// Роли меняются в serializable: параллельные изменения ролей и состава не должны
+	// нарушить инвариант единственного владельца и проверки прав
+	err = s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, memberRole.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		errTx = s.checkPermission(ctx, memberRole.ChatID, memberRole.UserID, permManageRoles)
+		if errTx != nil {
+			return errTx
+		}
+
+		role, errTx := s.chatRepository.GetMemberRole(ctx, memberRole.ChatID, memberRole.MemberID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if role == "" {
+			return ErrTargetNotMember
+		}
+
+		// Роль владельца меняется только через передачу владения
+		if role == model.RoleOwner {
+			return ErrInvalidRole
+		}
+
+		return s.chatRepository.SetMemberRole(ctx, memberRole.ChatID, memberRole.MemberID, memberRole.Role)
+	})
+
+	return err
+}
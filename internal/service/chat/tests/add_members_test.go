@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestAddMembers(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.ChatMembers
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID  = int64(gofakeit.Number(1, 1000))
+		usersID = []string{
+			strconv.FormatInt(gofakeit.Int64(), 10),
+			strconv.FormatInt(gofakeit.Int64(), 10),
+		}
+
+		userID = strconv.FormatInt(gofakeit.Int64(), 10)
+
+		repoErr = fmt.Errorf("repo error")
+
+		req = &model.ChatMembers{
+			ChatID:  chatID,
+			UsersID: usersID,
+			UserID:  userID,
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleAdmin, nil)
+				mock.AddChatMembersMock.Expect(ctx, chatID, usersID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "permission denied case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleMember, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleAdmin, nil)
+				mock.AddChatMembersMock.Expect(ctx, chatID, usersID).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.SerializableMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock)
+
+			err := service.AddMembers(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
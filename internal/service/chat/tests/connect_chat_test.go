@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/hub"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestConnectChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID = int64(gofakeit.Number(1, 1000))
+		userID = gofakeit.Name()
+
+		req = &model.ChatConnect{
+			ChatID: chatID,
+			UserID: userID,
+		}
+
+		message = &model.Message{
+			ID:        int64(gofakeit.Number(1, 1000)),
+			ChatID:    chatID,
+			From:      gofakeit.Name(),
+			Text:      gofakeit.City(),
+			CreatedAt: gofakeit.Date(),
+		}
+
+		nextMessage = &model.Message{
+			ID:     message.ID + 1,
+			ChatID: chatID,
+		}
+
+		lastMessage = &model.Message{
+			ID:     message.ID + 2,
+			ChatID: chatID,
+		}
+
+		memberRepoMock = func(mc *minimock.Controller) repository.ChatRepository {
+			mock := repoMocks.NewChatRepositoryMock(mc)
+			mock.IsChatExistsMock.Return(true, nil)
+			mock.IsChatMemberMock.Return(true, nil)
+			return mock
+		}
+	)
+
+	tests := []struct {
+		name               string
+		bufferSize         int
+		publish            bool
+		onMessage          func(chatHub *hub.Hub, cancel context.CancelFunc)
+		want               []*model.Message
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:       "success case",
+			bufferSize: hub.DefaultBufferSize,
+			publish:    true,
+			onMessage: func(_ *hub.Hub, cancel context.CancelFunc) {
+				cancel()
+			},
+			want:               []*model.Message{message},
+			err:                nil,
+			chatRepositoryMock: memberRepoMock,
+		},
+		{
+			name:       "slow subscriber case",
+			bufferSize: 1,
+			publish:    true,
+			onMessage: func(chatHub *hub.Hub, _ context.CancelFunc) {
+				// Пока клиент обрабатывает первое сообщение, второе заполняет буфер, а третье его переполняет
+				if chatHub.SubscribersCount(chatID) > 0 {
+					chatHub.Publish(nextMessage)
+					chatHub.Publish(lastMessage)
+				}
+			},
+			want:               []*model.Message{message, nextMessage},
+			err:                chat.ErrSlowSubscriber,
+			chatRepositoryMock: memberRepoMock,
+		},
+		{
+			name:       "server shutting down case",
+			bufferSize: hub.DefaultBufferSize,
+			publish:    true,
+			onMessage: func(chatHub *hub.Hub, _ context.CancelFunc) {
+				chatHub.Close()
+			},
+			want:               []*model.Message{message},
+			err:                chat.ErrShuttingDown,
+			chatRepositoryMock: memberRepoMock,
+		},
+		{
+			name: "chat not found case",
+			err:  chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "user is not a member case",
+			err:  chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Return(true, nil)
+				mock.IsChatMemberMock.Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(auth.NewContext(context.Background(), userID))
+			defer cancel()
+
+			chatHub := hub.New(tt.bufferSize)
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), dbMocks.NewTxManagerMock(mc), chatHub)
+
+			if tt.publish {
+				go func() {
+					require.Eventually(t, func() bool {
+						return chatHub.SubscribersCount(chatID) == 1
+					}, time.Second, time.Millisecond)
+
+					chatHub.Publish(message)
+				}()
+			}
+
+			var received []*model.Message
+			err := service.ConnectChat(ctx, req, func(message *model.Message) error {
+				received = append(received, message)
+				tt.onMessage(chatHub, cancel)
+				return nil
+			})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, received)
+			require.Zero(t, chatHub.SubscribersCount(chatID))
+		})
+	}
+}
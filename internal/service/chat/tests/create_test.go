@@ -10,6 +10,7 @@ import (
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ipv02/chat-server/internal/auth"
 	"github.com/ipv02/chat-server/internal/client/db"
 	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
 	"github.com/ipv02/chat-server/internal/model"
@@ -36,16 +37,41 @@ func TestCreate(t *testing.T) {
 		usersID = []string{
 			strconv.FormatInt(gofakeit.Int64(), 10),
 		}
-		chatName = gofakeit.Name()
+		chatName  = gofakeit.Name()
+		creatorID = strconv.FormatInt(gofakeit.Int64(), 10)
 
 		repoErr = fmt.Errorf("repo error")
 
 		req = &model.ChatCreate{
-			UsersID:  usersID,
-			ChatName: chatName,
+			UsersID:   usersID,
+			ChatName:  chatName,
+			CreatorID: creatorID,
+		}
+
+		idempotentReq = &model.ChatCreate{
+			UsersID:        usersID,
+			ChatName:       chatName,
+			CreatorID:      creatorID,
+			IdempotencyKey: gofakeit.UUID(),
+		}
+
+		idempotencyKey = &model.IdempotencyKey{
+			Scope:  model.IdempotencyScopeCreateChat,
+			UserID: creatorID,
+			Key:    idempotentReq.IdempotencyKey,
 		}
 	)
 
+	ctx = auth.NewContext(ctx, creatorID)
+
+	txManagerMock := func(mc *minimock.Controller) db.TxManager {
+		mock := dbMocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+			return f(ctx)
+		})
+		return mock
+	}
+
 	tests := []struct {
 		name               string
 		args               args
@@ -64,7 +90,7 @@ func TestCreate(t *testing.T) {
 			err:  nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx, req).Return(id, nil)
+				mock.CreateChatMock.Optional().Expect(ctx, req).Return(id, nil)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -73,6 +99,39 @@ func TestCreate(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "success case with idempotency key",
+			args: args{
+				ctx: ctx,
+				req: idempotentReq,
+			},
+			want: id,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.ReserveIdempotencyKeyMock.Expect(ctx, idempotencyKey).Return(true, nil)
+				mock.CreateChatMock.Expect(ctx, idempotentReq).Return(id, nil)
+				mock.SaveIdempotencyResultMock.Expect(ctx, idempotencyKey, id).Return(nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "repeated idempotency key case",
+			args: args{
+				ctx: ctx,
+				req: idempotentReq,
+			},
+			want: id,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.ReserveIdempotencyKeyMock.Expect(ctx, idempotencyKey).Return(false, nil)
+				mock.GetIdempotencyResultMock.Expect(ctx, idempotencyKey).Return(id, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
 		{
 			name: "service error case",
 			args: args{
@@ -83,7 +142,7 @@ func TestCreate(t *testing.T) {
 			err:  repoErr,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx, req).Return(0, repoErr)
+				mock.CreateChatMock.Optional().Expect(ctx, req).Return(0, repoErr)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
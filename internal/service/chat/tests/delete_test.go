@@ -9,8 +9,10 @@ import (
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ipv02/chat-server/internal/auth"
 	"github.com/ipv02/chat-server/internal/client/db"
 	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
 	"github.com/ipv02/chat-server/internal/repository"
 	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
 	"github.com/ipv02/chat-server/internal/service/chat"
@@ -23,22 +25,37 @@ func TestDelete(t *testing.T) {
 
 	type args struct {
 		ctx context.Context
-		req int64
+		req *model.ChatDelete
 	}
 
 	var (
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		id = gofakeit.Int64()
+		id     = int64(gofakeit.Number(1, 1000))
+		userID = gofakeit.Name()
 
 		repoErr = fmt.Errorf("repo error")
+
+		req = &model.ChatDelete{
+			ID:     id,
+			UserID: userID,
+		}
 	)
 
+	ctx = auth.NewContext(ctx, userID)
+
+	txManagerMock := func(mc *minimock.Controller) db.TxManager {
+		mock := dbMocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+			return f(ctx)
+		})
+		return mock
+	}
+
 	tests := []struct {
 		name               string
 		args               args
-		want               error
 		err                error
 		chatRepositoryMock chatRepositoryMockFunc
 		txManagerMock      txManagerMockFunc
@@ -47,13 +64,12 @@ func TestDelete(t *testing.T) {
 			name: "success case",
 			args: args{
 				ctx: ctx,
-				req: id,
+				req: req,
 			},
-			want: nil,
-			err:  nil,
+			err: nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.DeleteChatMock.Expect(ctx, id).Return(nil)
+				mock.DeleteChatMock.Optional().Expect(ctx, id).Return(nil)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -66,13 +82,12 @@ func TestDelete(t *testing.T) {
 			name: "service error case",
 			args: args{
 				ctx: ctx,
-				req: id,
+				req: req,
 			},
-			want: nil,
-			err:  repoErr,
+			err: repoErr,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.DeleteChatMock.Expect(ctx, id).Return(repoErr)
+				mock.DeleteChatMock.Optional().Expect(ctx, id).Return(repoErr)
 				return mock
 			},
 			txManagerMock: func(mc *minimock.Controller) db.TxManager {
@@ -81,6 +96,82 @@ func TestDelete(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "success case by owner",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, id).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, id, userID).Return(model.RoleOwner, nil)
+				mock.DeleteChatMock.Expect(ctx, id).Return(nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, id).Return(false, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "user is not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, id).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, id, userID).Return("", nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "permission denied case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, id).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, id, userID).Return(model.RoleAdmin, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "repository error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, id).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, id, userID).Return(model.RoleOwner, nil)
+				mock.DeleteChatMock.Expect(ctx, id).Return(repoErr)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
 	}
 
 	for _, tt := range tests {
@@ -88,13 +179,10 @@ func TestDelete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			chatRepoMock := tt.chatRepositoryMock(mc)
-			txManagerMock := tt.txManagerMock(mc)
-			service := chat.NewMockService(chatRepoMock, txManagerMock)
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), tt.txManagerMock(mc))
 
 			err := service.DeleteChat(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
-			require.Equal(t, tt.want, nil)
 		})
 	}
 }
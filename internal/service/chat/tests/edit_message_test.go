@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		editWindow = time.Hour
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		messageID = int64(gofakeit.Number(1, 1000))
+		authorID  = "1"
+		otherID   = "2"
+		text      = gofakeit.City()
+		deletedAt = time.Now()
+
+		repoErr = fmt.Errorf("repo error")
+
+		message = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
+			From:      authorID,
+			Text:      gofakeit.Name(),
+			CreatedAt: time.Now(),
+		}
+
+		oldMessage = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
+			From:      authorID,
+			CreatedAt: time.Now().Add(-2 * editWindow),
+		}
+
+		deletedMessage = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
+			From:      authorID,
+			CreatedAt: time.Now(),
+			DeletedAt: &deletedAt,
+		}
+	)
+
+	authorCtx := auth.NewContext(ctx, authorID)
+	otherCtx := auth.NewContext(ctx, otherID)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case by author",
+			ctx:  authorCtx,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(authorCtx, chatID, authorID).Return(model.RoleMember, nil)
+				mock.EditMessageMock.Expect(authorCtx, messageID, text).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "success case by admin",
+			ctx:  otherCtx,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return(model.RoleAdmin, nil)
+				mock.EditMessageMock.Expect(otherCtx, messageID, text).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "message not found case",
+			ctx:  authorCtx,
+			err:  chat.ErrMessageNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "message deleted case",
+			ctx:  authorCtx,
+			err:  chat.ErrMessageDeleted,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(deletedMessage, nil)
+				return mock
+			},
+		},
+		{
+			name: "author left the chat case",
+			ctx:  authorCtx,
+			err:  chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(authorCtx, chatID, authorID).Return("", nil)
+				return mock
+			},
+		},
+		{
+			name: "read only author case",
+			ctx:  authorCtx,
+			err:  chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(authorCtx, chatID, authorID).Return(model.RoleReadOnly, nil)
+				return mock
+			},
+		},
+		{
+			name: "permission denied case",
+			ctx:  otherCtx,
+			err:  chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return(model.RoleMember, nil)
+				return mock
+			},
+		},
+		{
+			name: "edit window expired case",
+			ctx:  authorCtx,
+			err:  chat.ErrEditWindowExpired,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(oldMessage, nil)
+				mock.GetMemberRoleMock.Expect(authorCtx, chatID, authorID).Return(model.RoleMember, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			ctx:  authorCtx,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(authorCtx, chatID, authorID).Return(model.RoleMember, nil)
+				mock.EditMessageMock.Expect(authorCtx, messageID, text).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock, chat.EditWindow(editWindow))
+
+			err := service.EditMessage(tt.ctx, &model.MessageEdit{
+				MessageID: messageID,
+				Text:      text,
+			})
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestGetChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Name()
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		chatName  = gofakeit.Name()
+		createdAt = gofakeit.Date()
+		longText  = strings.Repeat("я", 150)
+
+		repoErr = fmt.Errorf("repo error")
+
+		members = []*model.Member{
+			{UserID: "1", Role: model.RoleOwner},
+			{UserID: "2", Role: model.RoleMember},
+		}
+
+		membersFilter = &model.MemberListFilter{ChatID: chatID}
+
+		// Репозиторий возвращает новый объект на каждый вызов, сервис обрезает текст превью на месте
+		repoChat = func() *model.Chat {
+			return &model.Chat{
+				ID:        chatID,
+				Name:      chatName,
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+				LastMessage: &model.Message{
+					ChatID: chatID,
+					From:   "1",
+					Text:   longText,
+				},
+			}
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	want := repoChat()
+	want.Members = members
+	want.LastMessage.Text = strings.Repeat("я", 100)
+
+	tests := []struct {
+		name               string
+		want               *model.Chat
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			want: want,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetChatMock.Expect(ctx, chatID).Return(repoChat(), nil)
+				mock.ListChatMembersMock.Expect(ctx, membersFilter).Return(members, nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			want: nil,
+			err:  chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			want: nil,
+			err:  chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.GetChatMock.Expect(ctx, chatID).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadOnlyMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock)
+
+			res, err := service.GetChat(ctx, chatID)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
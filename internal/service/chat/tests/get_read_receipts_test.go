@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestGetReadReceipts(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Name()
+
+		messageID = int64(gofakeit.Number(1, 1000))
+
+		repoErr = fmt.Errorf("repo error")
+
+		message = &model.Message{
+			ID:        messageID,
+			ChatID:    int64(gofakeit.Number(1, 1000)),
+			From:      "1",
+			CreatedAt: gofakeit.Date(),
+		}
+
+		receipts = []*model.ReadReceipt{
+			{UserID: "2", ReadAt: gofakeit.Date()},
+			{UserID: "3", ReadAt: gofakeit.Date()},
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		want               []*model.ReadReceipt
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			want: receipts,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.IsChatMemberMock.Expect(ctx, message.ChatID, userID).Return(true, nil)
+				mock.ListReadReceiptsMock.Expect(ctx, message).Return(receipts, nil)
+				return mock
+			},
+		},
+		{
+			name: "message not found case",
+			want: nil,
+			err:  chat.ErrMessageNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			want: nil,
+			err:  chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.IsChatMemberMock.Expect(ctx, message.ChatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.IsChatMemberMock.Expect(ctx, message.ChatID, userID).Return(true, nil)
+				mock.ListReadReceiptsMock.Expect(ctx, message).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), dbMocks.NewTxManagerMock(mc))
+
+			res, err := service.GetReadReceipts(ctx, messageID)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
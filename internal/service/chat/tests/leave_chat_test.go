@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestLeaveChat(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.ChatLeave
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = int64(gofakeit.Number(1, 1000))
+		userID = strconv.FormatInt(gofakeit.Int64(), 10)
+
+		repoErr = fmt.Errorf("repo error")
+
+		req = &model.ChatLeave{
+			ChatID: chatID,
+			UserID: userID,
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleMember, nil)
+				mock.RemoveChatMembersMock.Expect(ctx, chatID, []string{userID}).Return(nil)
+				mock.CountChatMembersMock.Expect(ctx, chatID).Return(2, nil)
+				return mock
+			},
+		},
+		{
+			name: "last member leaves case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleMember, nil)
+				mock.RemoveChatMembersMock.Expect(ctx, chatID, []string{userID}).Return(nil)
+				mock.CountChatMembersMock.Expect(ctx, chatID).Return(0, nil)
+				mock.DeleteChatMock.Expect(ctx, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return("", nil)
+				return mock
+			},
+		},
+		{
+			name: "owner leaves non-empty chat case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrOwnerCannotLeave,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleOwner, nil)
+				mock.CountChatMembersMock.Expect(ctx, chatID).Return(2, nil)
+				return mock
+			},
+		},
+		{
+			name: "owner leaves as the last member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleOwner, nil)
+				mock.CountChatMembersMock.Expect(ctx, chatID).Return(1, nil)
+				mock.DeleteChatMock.Expect(ctx, chatID).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleMember, nil)
+				mock.RemoveChatMembersMock.Expect(ctx, chatID, []string{userID}).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.SerializableMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock)
+
+			err := service.LeaveChat(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
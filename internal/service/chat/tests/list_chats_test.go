@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestListChats(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.ChatListFilter
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID    = gofakeit.Name()
+		createdAt = gofakeit.Date()
+		messageAt = createdAt.Add(time.Hour)
+
+		repoErr = fmt.Errorf("repo error")
+
+		chats = []*model.Chat{
+			{ID: 3, CreatedAt: createdAt, LastMessageAt: &messageAt},
+			{ID: 2, CreatedAt: createdAt},
+			{ID: 1, CreatedAt: createdAt},
+		}
+
+		req = &model.ChatListFilter{
+			UserID: userID,
+			Limit:  2,
+		}
+
+		repoReq = &model.ChatListFilter{
+			UserID: userID,
+			Limit:  3,
+		}
+
+		res = &model.ChatList{
+			Chats: chats[:2],
+			// Чат без сообщений продолжает список по времени создания
+			NextCursor: &model.ChatCursor{
+				LastActivityAt: createdAt,
+				ID:             2,
+			},
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.ChatList
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case with next page",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.ListUserChatsMock.Expect(ctx, repoReq).Return(chats, nil)
+				return mock
+			},
+		},
+		{
+			name: "success case last page",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &model.ChatList{Chats: chats[:1]},
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.ListUserChatsMock.Expect(ctx, repoReq).Return(chats[:1], nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.ListUserChatsMock.Expect(ctx, repoReq).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), dbMocks.NewTxManagerMock(mc))
+
+			list, err := service.ListChats(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, list)
+		})
+	}
+}
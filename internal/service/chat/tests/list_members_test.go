@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestListMembers(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.MemberListFilter
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Name()
+
+		chatID = int64(gofakeit.Number(1, 1000))
+
+		repoErr = fmt.Errorf("repo error")
+
+		members = []*model.Member{
+			{UserID: "1"},
+			{UserID: "2"},
+			{UserID: "3"},
+		}
+
+		req = &model.MemberListFilter{
+			ChatID: chatID,
+			Limit:  2,
+		}
+
+		repoReq = &model.MemberListFilter{
+			ChatID: chatID,
+			Limit:  3,
+		}
+
+		res = &model.MemberList{
+			Members: members[:2],
+			NextCursor: &model.MemberCursor{
+				UserID: "2",
+			},
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.MemberList
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case with next page",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListChatMembersMock.Expect(ctx, repoReq).Return(members, nil)
+				return mock
+			},
+		},
+		{
+			name: "success case last page",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &model.MemberList{Members: members[:1]},
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListChatMembersMock.Expect(ctx, repoReq).Return(members[:1], nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListChatMembersMock.Expect(ctx, repoReq).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadOnlyMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock)
+
+			list, err := service.ListMembers(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, list)
+		})
+	}
+}
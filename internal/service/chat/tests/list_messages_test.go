@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.MessageListFilter
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Name()
+
+		chatID = int64(gofakeit.Number(1, 1000))
+
+		repoErr = fmt.Errorf("repo error")
+
+		newMessage = func(id int64) *model.Message {
+			return &model.Message{
+				ID:        id,
+				ChatID:    chatID,
+				From:      gofakeit.Name(),
+				Text:      gofakeit.City(),
+				CreatedAt: gofakeit.Date(),
+			}
+		}
+
+		messages = []*model.Message{newMessage(3), newMessage(2), newMessage(1)}
+
+		req = &model.MessageListFilter{
+			ChatID: chatID,
+			Limit:  2,
+		}
+
+		repoReq = &model.MessageListFilter{
+			ChatID: chatID,
+			Limit:  3,
+		}
+
+		defaultReq = &model.MessageListFilter{
+			ChatID: chatID,
+		}
+
+		defaultRepoReq = &model.MessageListFilter{
+			ChatID: chatID,
+			Limit:  51,
+		}
+
+		res = &model.MessageList{
+			Messages: messages[:2],
+			NextCursor: &model.MessageCursor{
+				CreatedAt: messages[1].CreatedAt,
+				ID:        messages[1].ID,
+			},
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.MessageList
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case with next page",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListMessagesMock.Expect(ctx, repoReq).Return(messages, nil)
+				return mock
+			},
+		},
+		{
+			name: "success case with default page size",
+			args: args{
+				ctx: ctx,
+				req: defaultReq,
+			},
+			want: &model.MessageList{Messages: messages},
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListMessagesMock.Expect(ctx, defaultRepoReq).Return(messages, nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListMessagesMock.Expect(ctx, repoReq).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepoMock := tt.chatRepositoryMock(mc)
+			service := chat.NewMockService(chatRepoMock, dbMocks.NewTxManagerMock(mc))
+
+			list, err := service.ListMessages(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, list)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestListThread(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.ThreadFilter
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = gofakeit.Name()
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		rootID    = int64(gofakeit.Number(1, 1000))
+		createdAt = gofakeit.Date()
+
+		repoErr = fmt.Errorf("repo error")
+
+		root = &model.Message{
+			ID:          rootID,
+			ChatID:      chatID,
+			CreatedAt:   createdAt,
+			ReplyCount:  3,
+			LastReplyAt: &createdAt,
+		}
+
+		replies = []*model.Message{
+			{ID: rootID + 1, ChatID: chatID, CreatedAt: createdAt.Add(time.Second), ReplyToMessageID: rootID},
+			{ID: rootID + 2, ChatID: chatID, CreatedAt: createdAt.Add(2 * time.Second), ReplyToMessageID: rootID},
+			{ID: rootID + 3, ChatID: chatID, CreatedAt: createdAt.Add(3 * time.Second), ReplyToMessageID: rootID},
+		}
+
+		req = &model.ThreadFilter{
+			RootID: rootID,
+			Limit:  2,
+		}
+
+		repoReq = &model.ThreadFilter{
+			RootID: rootID,
+			Limit:  3,
+		}
+
+		res = &model.Thread{
+			Root:    root,
+			Replies: replies[:2],
+			NextCursor: &model.MessageCursor{
+				CreatedAt: replies[1].CreatedAt,
+				ID:        replies[1].ID,
+			},
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *model.Thread
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case with next page",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: res,
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, rootID).Return(root, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListThreadRepliesMock.Expect(ctx, repoReq).Return(replies, nil)
+				return mock
+			},
+		},
+		{
+			name: "reply resolves to its root case",
+			args: args{
+				ctx: ctx,
+				req: &model.ThreadFilter{
+					RootID: replies[0].ID,
+					Limit:  2,
+				},
+			},
+			want: &model.Thread{Root: root, Replies: replies[:1]},
+			err:  nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.When(ctx, replies[0].ID).Then(replies[0], nil)
+				mock.GetMessageMock.When(ctx, rootID).Then(root, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListThreadRepliesMock.Expect(ctx, repoReq).Return(replies[:1], nil)
+				return mock
+			},
+		},
+		{
+			name: "message not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  chat.ErrMessageNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, rootID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, rootID).Return(root, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, rootID).Return(root, nil)
+				mock.IsChatMemberMock.Expect(ctx, chatID, userID).Return(true, nil)
+				mock.ListThreadRepliesMock.Expect(ctx, repoReq).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), dbMocks.NewTxManagerMock(mc))
+
+			thread, err := service.ListThread(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, thread)
+		})
+	}
+}
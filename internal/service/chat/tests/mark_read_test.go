@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestMarkRead(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.ChatRead
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		messageID = int64(gofakeit.Number(1, 1000))
+		userID    = gofakeit.Name()
+
+		repoErr = fmt.Errorf("repo error")
+
+		message = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
+			CreatedAt: gofakeit.Date(),
+		}
+
+		req = &model.ChatRead{
+			ChatID:    chatID,
+			UserID:    userID,
+			MessageID: messageID,
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleReadOnly, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, message).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "message not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrMessageNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "message from another chat case",
+			args: args{
+				ctx: ctx,
+				req: &model.ChatRead{
+					ChatID:    chatID + 1,
+					UserID:    userID,
+					MessageID: messageID,
+				},
+			},
+			err: chat.ErrMessageNotInChat,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				return mock
+			},
+		},
+		{
+			name: "user is not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return("", nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.GetMessageMock.Expect(ctx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleMember, nil)
+				mock.MarkReadMock.Expect(ctx, chatID, userID, message).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.RepeatableReadMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock)
+
+			err := service.MarkRead(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
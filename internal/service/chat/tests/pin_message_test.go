@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestPinMessage(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = int64(gofakeit.Number(1, 1000))
+		messageID = int64(gofakeit.Number(1, 1000))
+		authorID  = "1"
+		otherID   = "2"
+		deletedAt = time.Now()
+
+		repoErr = fmt.Errorf("repo error")
+
+		message = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
+			From:      authorID,
+			Text:      gofakeit.City(),
+			CreatedAt: time.Now(),
+		}
+
+		deletedMessage = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
+			From:      authorID,
+			DeletedAt: &deletedAt,
+		}
+	)
+
+	authorCtx := auth.NewContext(ctx, authorID)
+	otherCtx := auth.NewContext(ctx, otherID)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		pinned             bool
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:   "success case by admin",
+			ctx:    otherCtx,
+			pinned: true,
+			err:    nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return(model.RoleAdmin, nil)
+				mock.PinMessageMock.Expect(otherCtx, messageID, true).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "unpin case by owner",
+			ctx:    otherCtx,
+			pinned: false,
+			err:    nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return(model.RoleOwner, nil)
+				mock.PinMessageMock.Expect(otherCtx, messageID, false).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "member author case",
+			ctx:    authorCtx,
+			pinned: true,
+			err:    chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(authorCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(authorCtx, chatID, authorID).Return(model.RoleMember, nil)
+				return mock
+			},
+		},
+		{
+			name:   "read only case",
+			ctx:    otherCtx,
+			pinned: true,
+			err:    chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return(model.RoleReadOnly, nil)
+				return mock
+			},
+		},
+		{
+			name:   "not a member case",
+			ctx:    otherCtx,
+			pinned: true,
+			err:    chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return("", nil)
+				return mock
+			},
+		},
+		{
+			name:   "message not found case",
+			ctx:    otherCtx,
+			pinned: true,
+			err:    chat.ErrMessageNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name:   "deleted message case",
+			ctx:    otherCtx,
+			pinned: true,
+			err:    chat.ErrMessageDeleted,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(deletedMessage, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return(model.RoleAdmin, nil)
+				return mock
+			},
+		},
+		{
+			name:   "service error case",
+			ctx:    otherCtx,
+			pinned: true,
+			err:    repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.LockMessageMock.Expect(otherCtx, messageID).Return(message, nil)
+				mock.GetMemberRoleMock.Expect(otherCtx, chatID, otherID).Return(model.RoleAdmin, nil)
+				mock.PinMessageMock.Expect(otherCtx, messageID, true).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock)
+
+			err := service.PinMessage(tt.ctx, &model.MessagePin{
+				MessageID: messageID,
+				Pinned:    tt.pinned,
+			})
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
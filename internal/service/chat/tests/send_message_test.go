@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/hub"
 	"github.com/ipv02/chat-server/internal/model"
 	"github.com/ipv02/chat-server/internal/repository"
 	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
@@ -19,6 +24,7 @@ import (
 func TestSendMessage(t *testing.T) {
 	t.Parallel()
 	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type txManagerMockFunc func(mc *minimock.Controller) db.TxManager
 
 	type args struct {
 		ctx context.Context
@@ -29,25 +35,81 @@ func TestSendMessage(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
+		chatID    = int64(gofakeit.Number(1, 1000))
+		messageID = gofakeit.Int64()
 		from      = gofakeit.Name()
 		text      = gofakeit.City()
 		timestamp = gofakeit.Date()
+		// Время сообщения выставляет сервер, время клиента из запроса на него не влияет
+		createdAt = timestamp.Add(time.Hour)
+
+		rootID  = int64(gofakeit.Number(1, 1000))
+		replyID = rootID + 1
 
 		repoErr = fmt.Errorf("repo error")
 
 		req = &model.ChatSendMessage{
+			ChatID:    chatID,
 			From:      from,
 			Text:      text,
 			Timestamp: timestamppb.New(timestamp),
 		}
+
+		idempotentReq = &model.ChatSendMessage{
+			ChatID:         chatID,
+			From:           from,
+			Text:           text,
+			Timestamp:      req.Timestamp,
+			IdempotencyKey: gofakeit.UUID(),
+		}
+
+		idempotencyKey = &model.IdempotencyKey{
+			Scope:  model.IdempotencyScopeSendMessage,
+			UserID: from,
+			Key:    idempotentReq.IdempotencyKey,
+		}
 	)
 
+	ctx = auth.NewContext(ctx, from)
+
+	// Сервис заменяет ID сообщения, на которое отвечают, на корень треда, поэтому запрос создается на каждый случай
+	replyReq := func(replyTo int64) *model.ChatSendMessage {
+		return &model.ChatSendMessage{
+			ChatID:           chatID,
+			From:             from,
+			Text:             text,
+			Timestamp:        req.Timestamp,
+			ReplyToMessageID: replyTo,
+		}
+	}
+
+	// sent сообщение, которое репозиторий вернул после сохранения
+	sent := func(replyTo int64) *model.Message {
+		return &model.Message{
+			ID:               messageID,
+			ChatID:           chatID,
+			From:             from,
+			Text:             text,
+			CreatedAt:        createdAt,
+			ReplyToMessageID: replyTo,
+		}
+	}
+
+	txManagerMock := func(mc *minimock.Controller) db.TxManager {
+		mock := dbMocks.NewTxManagerMock(mc)
+		mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+			return f(ctx)
+		})
+		return mock
+	}
+
 	tests := []struct {
 		name               string
 		args               args
-		want               error
 		err                error
+		published          *model.Message
 		chatRepositoryMock chatRepositoryMockFunc
+		txManagerMock      txManagerMockFunc
 	}{
 		{
 			name: "success case",
@@ -55,13 +117,185 @@ func TestSendMessage(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			want: nil,
-			err:  nil,
+			err: nil,
+			published: &model.Message{
+				ID:        messageID,
+				ChatID:    chatID,
+				From:      from,
+				Text:      text,
+				CreatedAt: createdAt,
+			},
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(nil)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return(model.RoleMember, nil)
+				mock.SendMessageMock.Expect(ctx, req).Return(sent(0), nil)
 				return mock
 			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "success case with idempotency key",
+			args: args{
+				ctx: ctx,
+				req: idempotentReq,
+			},
+			err: nil,
+			published: &model.Message{
+				ID:        messageID,
+				ChatID:    chatID,
+				From:      from,
+				Text:      text,
+				CreatedAt: createdAt,
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.ReserveIdempotencyKeyMock.Expect(ctx, idempotencyKey).Return(true, nil)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return(model.RoleMember, nil)
+				mock.SendMessageMock.Expect(ctx, idempotentReq).Return(sent(0), nil)
+				mock.SaveIdempotencyResultMock.Expect(ctx, idempotencyKey, messageID).Return(nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "repeated idempotency key case",
+			args: args{
+				ctx: ctx,
+				req: idempotentReq,
+			},
+			err:       nil,
+			published: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.ReserveIdempotencyKeyMock.Expect(ctx, idempotencyKey).Return(false, nil)
+				mock.GetIdempotencyResultMock.Expect(ctx, idempotencyKey).Return(messageID, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "unauthenticated case",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			err: chat.ErrUnauthenticated,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repoMocks.NewChatRepositoryMock(mc)
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				return dbMocks.NewTxManagerMock(mc)
+			},
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(false, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "sender is not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrNotChatMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return("", nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "read-only member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return(model.RoleReadOnly, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "reply to thread reply case",
+			args: args{
+				ctx: ctx,
+				req: replyReq(replyID),
+			},
+			err: nil,
+			published: &model.Message{
+				ID:               messageID,
+				ChatID:           chatID,
+				From:             from,
+				Text:             text,
+				CreatedAt:        createdAt,
+				ReplyToMessageID: rootID,
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return(model.RoleMember, nil)
+				mock.GetMessageMock.Expect(ctx, replyID).Return(&model.Message{
+					ID:               replyID,
+					ChatID:           chatID,
+					ReplyToMessageID: rootID,
+				}, nil)
+				mock.SendMessageMock.Expect(ctx, replyReq(rootID)).Return(sent(rootID), nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "reply target not found case",
+			args: args{
+				ctx: ctx,
+				req: replyReq(replyID),
+			},
+			err: chat.ErrReplyTargetNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return(model.RoleMember, nil)
+				mock.GetMessageMock.Expect(ctx, replyID).Return(nil, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "reply to another chat case",
+			args: args{
+				ctx: ctx,
+				req: replyReq(rootID),
+			},
+			err: chat.ErrReplyToOtherChat,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return(model.RoleMember, nil)
+				mock.GetMessageMock.Expect(ctx, rootID).Return(&model.Message{
+					ID:     rootID,
+					ChatID: chatID + 1,
+				}, nil)
+				return mock
+			},
+			txManagerMock: txManagerMock,
 		},
 		{
 			name: "service error case",
@@ -69,13 +303,15 @@ func TestSendMessage(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			want: nil,
-			err:  repoErr,
+			err: repoErr,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repoMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(repoErr)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, from).Return(model.RoleMember, nil)
+				mock.SendMessageMock.Expect(ctx, req).Return(nil, repoErr)
 				return mock
 			},
+			txManagerMock: txManagerMock,
 		},
 	}
 
@@ -85,11 +321,61 @@ func TestSendMessage(t *testing.T) {
 			t.Parallel()
 
 			chatRepoMock := tt.chatRepositoryMock(mc)
-			service := chat.NewMockService(chatRepoMock)
+			txManagerMock := tt.txManagerMock(mc)
+			chatHub := hub.New(hub.DefaultBufferSize)
+			sub := chatHub.Subscribe(chatID)
+			service := chat.NewMockService(chatRepoMock, txManagerMock, chatHub)
 
 			err := service.SendMessage(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
-			require.Equal(t, tt.want, nil)
+
+			sub.Close()
+			var published *model.Message
+			for message := range sub.Messages() {
+				published = message
+			}
+			require.Equal(t, tt.published, published)
 		})
 	}
 }
+
+func TestSendMessageIdempotencyTTL(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		chatID         = int64(gofakeit.Number(1, 1000))
+		messageID      = gofakeit.Int64()
+		from           = gofakeit.Name()
+		idempotencyTTL = 10 * time.Minute
+
+		req = &model.ChatSendMessage{
+			ChatID:         chatID,
+			Text:           gofakeit.City(),
+			IdempotencyKey: gofakeit.UUID(),
+		}
+	)
+
+	ctx := auth.NewContext(context.Background(), from)
+
+	var reservedKey *model.IdempotencyKey
+	chatRepoMock := repoMocks.NewChatRepositoryMock(mc)
+	chatRepoMock.ReserveIdempotencyKeyMock.Set(func(_ context.Context, key *model.IdempotencyKey) (bool, error) {
+		reservedKey = key
+		return false, nil
+	})
+	chatRepoMock.GetIdempotencyResultMock.Return(messageID, nil)
+
+	txManagerMock := dbMocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	service := chat.NewMockService(chatRepoMock, txManagerMock, chat.IdempotencyTTL(idempotencyTTL))
+
+	err := service.SendMessage(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, reservedKey)
+	require.Equal(t, idempotencyTTL, reservedKey.TTL)
+}
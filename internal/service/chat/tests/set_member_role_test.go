@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ipv02/chat-server/internal/auth"
+	"github.com/ipv02/chat-server/internal/client/db"
+	dbMocks "github.com/ipv02/chat-server/internal/client/db/mocks"
+	"github.com/ipv02/chat-server/internal/model"
+	"github.com/ipv02/chat-server/internal/repository"
+	repoMocks "github.com/ipv02/chat-server/internal/repository/mocks"
+	"github.com/ipv02/chat-server/internal/service/chat"
+)
+
+func TestSetMemberRole(t *testing.T) {
+	t.Parallel()
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+
+	type args struct {
+		ctx context.Context
+		req *model.ChatMemberRole
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = int64(gofakeit.Number(1, 1000))
+		userID   = gofakeit.Name()
+		memberID = gofakeit.Name()
+
+		repoErr = fmt.Errorf("repo error")
+
+		req = &model.ChatMemberRole{
+			ChatID:   chatID,
+			UserID:   userID,
+			MemberID: memberID,
+			Role:     model.RoleAdmin,
+		}
+	)
+
+	ctx = auth.NewContext(ctx, userID)
+
+	tests := []struct {
+		name               string
+		args               args
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.When(ctx, chatID, userID).Then(model.RoleOwner, nil)
+				mock.GetMemberRoleMock.When(ctx, chatID, memberID).Then(model.RoleMember, nil)
+				mock.SetMemberRoleMock.Expect(ctx, chatID, memberID, model.RoleAdmin).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrChatNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name: "permission denied case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.Expect(ctx, chatID, userID).Return(model.RoleAdmin, nil)
+				return mock
+			},
+		},
+		{
+			name: "target is not a member case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: chat.ErrTargetNotMember,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.When(ctx, chatID, userID).Then(model.RoleOwner, nil)
+				mock.GetMemberRoleMock.When(ctx, chatID, memberID).Then("", nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repoMocks.NewChatRepositoryMock(mc)
+				mock.IsChatExistsMock.Expect(ctx, chatID).Return(true, nil)
+				mock.GetMemberRoleMock.When(ctx, chatID, userID).Then(model.RoleOwner, nil)
+				mock.GetMemberRoleMock.When(ctx, chatID, memberID).Then(model.RoleMember, nil)
+				mock.SetMemberRoleMock.Expect(ctx, chatID, memberID, model.RoleAdmin).Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	t.Run("owner role case", func(t *testing.T) {
+		t.Parallel()
+
+		service := chat.NewMockService(repoMocks.NewChatRepositoryMock(mc), dbMocks.NewTxManagerMock(mc))
+
+		err := service.SetMemberRole(ctx, &model.ChatMemberRole{
+			ChatID:   chatID,
+			UserID:   userID,
+			MemberID: memberID,
+			Role:     model.RoleOwner,
+		})
+		require.Equal(t, chat.ErrInvalidRole, err)
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.SerializableMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+			service := chat.NewMockService(tt.chatRepositoryMock(mc), txManagerMock)
+
+			err := service.SetMemberRole(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
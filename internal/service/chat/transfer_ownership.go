@@ -0,0 +1,58 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/ipv02/chat-server/internal/model"
+)
+
+// TransferOwnership передает владение чатом другому участнику, прежний владелец становится администратором
+func (s *service) TransferOwnership(ctx context.Context, transfer *model.ChatOwnershipTransfer) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	transfer.UserID = userID
+
+	// Передача владения меняет роли двух участников по результату чтения, поэтому выполняется в serializable,
+	// иначе параллельная передача или удаление участника приведет к двум владельцам или ни одному
+	err = s.txManager.Serializable(ctx, func(ctx context.Context) error {
+		exists, errTx := s.chatRepository.IsChatExists(ctx, transfer.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !exists {
+			return ErrChatNotFound
+		}
+
+		errTx = s.checkPermission(ctx, transfer.ChatID, transfer.UserID, permTransferOwnership)
+		if errTx != nil {
+			return errTx
+		}
+
+		if transfer.NewOwnerID == transfer.UserID {
+			return nil
+		}
+
+		role, errTx := s.chatRepository.GetMemberRole(ctx, transfer.ChatID, transfer.NewOwnerID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if role == "" {
+			return ErrTargetNotMember
+		}
+
+		// Сначала понижаем текущего владельца, чтобы не нарушить уникальность владельца чата
+		errTx = s.chatRepository.SetMemberRole(ctx, transfer.ChatID, transfer.UserID, model.RoleAdmin)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.chatRepository.SetMemberRole(ctx, transfer.ChatID, transfer.NewOwnerID, model.RoleOwner)
+	})
+
+	return err
+}
@@ -19,6 +19,20 @@ type ChatServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddMembers          func(ctx context.Context, members *model.ChatMembers) (err error)
+	funcAddMembersOrigin    string
+	inspectFuncAddMembers   func(ctx context.Context, members *model.ChatMembers)
+	afterAddMembersCounter  uint64
+	beforeAddMembersCounter uint64
+	AddMembersMock          mChatServiceMockAddMembers
+
+	funcConnectChat          func(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error) (err error)
+	funcConnectChatOrigin    string
+	inspectFuncConnectChat   func(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error)
+	afterConnectChatCounter  uint64
+	beforeConnectChatCounter uint64
+	ConnectChatMock          mChatServiceMockConnectChat
+
 	funcCreateChat          func(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error)
 	funcCreateChatOrigin    string
 	inspectFuncCreateChat   func(ctx context.Context, chat *model.ChatCreate)
@@ -26,19 +40,117 @@ type ChatServiceMock struct {
 	beforeCreateChatCounter uint64
 	CreateChatMock          mChatServiceMockCreateChat
 
-	funcDeleteChat          func(ctx context.Context, id int64) (err error)
+	funcDeleteChat          func(ctx context.Context, chat *model.ChatDelete) (err error)
 	funcDeleteChatOrigin    string
-	inspectFuncDeleteChat   func(ctx context.Context, id int64)
+	inspectFuncDeleteChat   func(ctx context.Context, chat *model.ChatDelete)
 	afterDeleteChatCounter  uint64
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatServiceMockDeleteChat
 
+	funcDeleteMessage          func(ctx context.Context, del *model.MessageDelete) (err error)
+	funcDeleteMessageOrigin    string
+	inspectFuncDeleteMessage   func(ctx context.Context, del *model.MessageDelete)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatServiceMockDeleteMessage
+
+	funcEditMessage          func(ctx context.Context, edit *model.MessageEdit) (err error)
+	funcEditMessageOrigin    string
+	inspectFuncEditMessage   func(ctx context.Context, edit *model.MessageEdit)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mChatServiceMockEditMessage
+
+	funcGetChat          func(ctx context.Context, id int64) (cp1 *model.Chat, err error)
+	funcGetChatOrigin    string
+	inspectFuncGetChat   func(ctx context.Context, id int64)
+	afterGetChatCounter  uint64
+	beforeGetChatCounter uint64
+	GetChatMock          mChatServiceMockGetChat
+
+	funcGetReadReceipts          func(ctx context.Context, messageID int64) (rpa1 []*model.ReadReceipt, err error)
+	funcGetReadReceiptsOrigin    string
+	inspectFuncGetReadReceipts   func(ctx context.Context, messageID int64)
+	afterGetReadReceiptsCounter  uint64
+	beforeGetReadReceiptsCounter uint64
+	GetReadReceiptsMock          mChatServiceMockGetReadReceipts
+
+	funcLeaveChat          func(ctx context.Context, leave *model.ChatLeave) (err error)
+	funcLeaveChatOrigin    string
+	inspectFuncLeaveChat   func(ctx context.Context, leave *model.ChatLeave)
+	afterLeaveChatCounter  uint64
+	beforeLeaveChatCounter uint64
+	LeaveChatMock          mChatServiceMockLeaveChat
+
+	funcListChats          func(ctx context.Context, filter *model.ChatListFilter) (cp1 *model.ChatList, err error)
+	funcListChatsOrigin    string
+	inspectFuncListChats   func(ctx context.Context, filter *model.ChatListFilter)
+	afterListChatsCounter  uint64
+	beforeListChatsCounter uint64
+	ListChatsMock          mChatServiceMockListChats
+
+	funcListMembers          func(ctx context.Context, filter *model.MemberListFilter) (mp1 *model.MemberList, err error)
+	funcListMembersOrigin    string
+	inspectFuncListMembers   func(ctx context.Context, filter *model.MemberListFilter)
+	afterListMembersCounter  uint64
+	beforeListMembersCounter uint64
+	ListMembersMock          mChatServiceMockListMembers
+
+	funcListMessages          func(ctx context.Context, filter *model.MessageListFilter) (mp1 *model.MessageList, err error)
+	funcListMessagesOrigin    string
+	inspectFuncListMessages   func(ctx context.Context, filter *model.MessageListFilter)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mChatServiceMockListMessages
+
+	funcListThread          func(ctx context.Context, filter *model.ThreadFilter) (tp1 *model.Thread, err error)
+	funcListThreadOrigin    string
+	inspectFuncListThread   func(ctx context.Context, filter *model.ThreadFilter)
+	afterListThreadCounter  uint64
+	beforeListThreadCounter uint64
+	ListThreadMock          mChatServiceMockListThread
+
+	funcMarkRead          func(ctx context.Context, read *model.ChatRead) (err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, read *model.ChatRead)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatServiceMockMarkRead
+
+	funcPinMessage          func(ctx context.Context, pin *model.MessagePin) (err error)
+	funcPinMessageOrigin    string
+	inspectFuncPinMessage   func(ctx context.Context, pin *model.MessagePin)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mChatServiceMockPinMessage
+
+	funcRemoveMembers          func(ctx context.Context, members *model.ChatMembers) (err error)
+	funcRemoveMembersOrigin    string
+	inspectFuncRemoveMembers   func(ctx context.Context, members *model.ChatMembers)
+	afterRemoveMembersCounter  uint64
+	beforeRemoveMembersCounter uint64
+	RemoveMembersMock          mChatServiceMockRemoveMembers
+
 	funcSendMessage          func(ctx context.Context, chat *model.ChatSendMessage) (err error)
 	funcSendMessageOrigin    string
 	inspectFuncSendMessage   func(ctx context.Context, chat *model.ChatSendMessage)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatServiceMockSendMessage
+
+	funcSetMemberRole          func(ctx context.Context, memberRole *model.ChatMemberRole) (err error)
+	funcSetMemberRoleOrigin    string
+	inspectFuncSetMemberRole   func(ctx context.Context, memberRole *model.ChatMemberRole)
+	afterSetMemberRoleCounter  uint64
+	beforeSetMemberRoleCounter uint64
+	SetMemberRoleMock          mChatServiceMockSetMemberRole
+
+	funcTransferOwnership          func(ctx context.Context, transfer *model.ChatOwnershipTransfer) (err error)
+	funcTransferOwnershipOrigin    string
+	inspectFuncTransferOwnership   func(ctx context.Context, transfer *model.ChatOwnershipTransfer)
+	afterTransferOwnershipCounter  uint64
+	beforeTransferOwnershipCounter uint64
+	TransferOwnershipMock          mChatServiceMockTransferOwnership
 }
 
 // NewChatServiceMock returns a mock for mm_service.ChatService
@@ -49,67 +161,114 @@ func NewChatServiceMock(t minimock.Tester) *ChatServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddMembersMock = mChatServiceMockAddMembers{mock: m}
+	m.AddMembersMock.callArgs = []*ChatServiceMockAddMembersParams{}
+
+	m.ConnectChatMock = mChatServiceMockConnectChat{mock: m}
+	m.ConnectChatMock.callArgs = []*ChatServiceMockConnectChatParams{}
+
 	m.CreateChatMock = mChatServiceMockCreateChat{mock: m}
 	m.CreateChatMock.callArgs = []*ChatServiceMockCreateChatParams{}
 
 	m.DeleteChatMock = mChatServiceMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatServiceMockDeleteChatParams{}
 
+	m.DeleteMessageMock = mChatServiceMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatServiceMockDeleteMessageParams{}
+
+	m.EditMessageMock = mChatServiceMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*ChatServiceMockEditMessageParams{}
+
+	m.GetChatMock = mChatServiceMockGetChat{mock: m}
+	m.GetChatMock.callArgs = []*ChatServiceMockGetChatParams{}
+
+	m.GetReadReceiptsMock = mChatServiceMockGetReadReceipts{mock: m}
+	m.GetReadReceiptsMock.callArgs = []*ChatServiceMockGetReadReceiptsParams{}
+
+	m.LeaveChatMock = mChatServiceMockLeaveChat{mock: m}
+	m.LeaveChatMock.callArgs = []*ChatServiceMockLeaveChatParams{}
+
+	m.ListChatsMock = mChatServiceMockListChats{mock: m}
+	m.ListChatsMock.callArgs = []*ChatServiceMockListChatsParams{}
+
+	m.ListMembersMock = mChatServiceMockListMembers{mock: m}
+	m.ListMembersMock.callArgs = []*ChatServiceMockListMembersParams{}
+
+	m.ListMessagesMock = mChatServiceMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*ChatServiceMockListMessagesParams{}
+
+	m.ListThreadMock = mChatServiceMockListThread{mock: m}
+	m.ListThreadMock.callArgs = []*ChatServiceMockListThreadParams{}
+
+	m.MarkReadMock = mChatServiceMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatServiceMockMarkReadParams{}
+
+	m.PinMessageMock = mChatServiceMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*ChatServiceMockPinMessageParams{}
+
+	m.RemoveMembersMock = mChatServiceMockRemoveMembers{mock: m}
+	m.RemoveMembersMock.callArgs = []*ChatServiceMockRemoveMembersParams{}
+
 	m.SendMessageMock = mChatServiceMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatServiceMockSendMessageParams{}
 
+	m.SetMemberRoleMock = mChatServiceMockSetMemberRole{mock: m}
+	m.SetMemberRoleMock.callArgs = []*ChatServiceMockSetMemberRoleParams{}
+
+	m.TransferOwnershipMock = mChatServiceMockTransferOwnership{mock: m}
+	m.TransferOwnershipMock.callArgs = []*ChatServiceMockTransferOwnershipParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatServiceMockCreateChat struct {
+type mChatServiceMockAddMembers struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockCreateChatExpectation
-	expectations       []*ChatServiceMockCreateChatExpectation
+	defaultExpectation *ChatServiceMockAddMembersExpectation
+	expectations       []*ChatServiceMockAddMembersExpectation
 
-	callArgs []*ChatServiceMockCreateChatParams
+	callArgs []*ChatServiceMockAddMembersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockCreateChatExpectation specifies expectation struct of the ChatService.CreateChat
-type ChatServiceMockCreateChatExpectation struct {
+// ChatServiceMockAddMembersExpectation specifies expectation struct of the ChatService.AddMembers
+type ChatServiceMockAddMembersExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockCreateChatParams
-	paramPtrs          *ChatServiceMockCreateChatParamPtrs
-	expectationOrigins ChatServiceMockCreateChatExpectationOrigins
-	results            *ChatServiceMockCreateChatResults
+	params             *ChatServiceMockAddMembersParams
+	paramPtrs          *ChatServiceMockAddMembersParamPtrs
+	expectationOrigins ChatServiceMockAddMembersExpectationOrigins
+	results            *ChatServiceMockAddMembersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockCreateChatParams contains parameters of the ChatService.CreateChat
-type ChatServiceMockCreateChatParams struct {
-	ctx  context.Context
-	chat *model.ChatCreate
+// ChatServiceMockAddMembersParams contains parameters of the ChatService.AddMembers
+type ChatServiceMockAddMembersParams struct {
+	ctx     context.Context
+	members *model.ChatMembers
 }
 
-// ChatServiceMockCreateChatParamPtrs contains pointers to parameters of the ChatService.CreateChat
-type ChatServiceMockCreateChatParamPtrs struct {
-	ctx  *context.Context
-	chat **model.ChatCreate
+// ChatServiceMockAddMembersParamPtrs contains pointers to parameters of the ChatService.AddMembers
+type ChatServiceMockAddMembersParamPtrs struct {
+	ctx     *context.Context
+	members **model.ChatMembers
 }
 
-// ChatServiceMockCreateChatResults contains results of the ChatService.CreateChat
-type ChatServiceMockCreateChatResults struct {
-	i1  int64
+// ChatServiceMockAddMembersResults contains results of the ChatService.AddMembers
+type ChatServiceMockAddMembersResults struct {
 	err error
 }
 
-// ChatServiceMockCreateChatOrigins contains origins of expectations of the ChatService.CreateChat
-type ChatServiceMockCreateChatExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originChat string
+// ChatServiceMockAddMembersOrigins contains origins of expectations of the ChatService.AddMembers
+type ChatServiceMockAddMembersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMembers string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -117,341 +276,344 @@ type ChatServiceMockCreateChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateChat *mChatServiceMockCreateChat) Optional() *mChatServiceMockCreateChat {
-	mmCreateChat.optional = true
-	return mmCreateChat
+func (mmAddMembers *mChatServiceMockAddMembers) Optional() *mChatServiceMockAddMembers {
+	mmAddMembers.optional = true
+	return mmAddMembers
 }
 
-// Expect sets up expected params for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Expect(ctx context.Context, chat *model.ChatCreate) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// Expect sets up expected params for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Expect(ctx context.Context, members *model.ChatMembers) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by ExpectParams functions")
+	if mmAddMembers.defaultExpectation.paramPtrs != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by ExpectParams functions")
 	}
 
-	mmCreateChat.defaultExpectation.params = &ChatServiceMockCreateChatParams{ctx, chat}
-	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateChat.expectations {
-		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
-			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+	mmAddMembers.defaultExpectation.params = &ChatServiceMockAddMembersParams{ctx, members}
+	mmAddMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddMembers.expectations {
+		if minimock.Equal(e.params, mmAddMembers.defaultExpectation.params) {
+			mmAddMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMembers.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateChat
+	return mmAddMembers
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmAddMembers
 }
 
-// ExpectChatParam2 sets up expected param chat for ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) ExpectChatParam2(chat *model.ChatCreate) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// ExpectMembersParam2 sets up expected param members for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectMembersParam2(members *model.ChatMembers) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
 	}
 
-	if mmCreateChat.defaultExpectation.params != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
 	}
 
-	if mmCreateChat.defaultExpectation.paramPtrs == nil {
-		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
-	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+	mmAddMembers.defaultExpectation.paramPtrs.members = &members
+	mmAddMembers.defaultExpectation.expectationOrigins.originMembers = minimock.CallerInfo(1)
 
-	return mmCreateChat
+	return mmAddMembers
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Inspect(f func(ctx context.Context, chat *model.ChatCreate)) *mChatServiceMockCreateChat {
-	if mmCreateChat.mock.inspectFuncCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreateChat")
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Inspect(f func(ctx context.Context, members *model.ChatMembers)) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.inspectFuncAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddMembers")
 	}
 
-	mmCreateChat.mock.inspectFuncCreateChat = f
+	mmAddMembers.mock.inspectFuncAddMembers = f
 
-	return mmCreateChat
+	return mmAddMembers
 }
 
-// Return sets up results that will be returned by ChatService.CreateChat
-func (mmCreateChat *mChatServiceMockCreateChat) Return(i1 int64, err error) *ChatServiceMock {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+// Return sets up results that will be returned by ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Return(err error) *ChatServiceMock {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
 	}
 
-	if mmCreateChat.defaultExpectation == nil {
-		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{mock: mmCreateChat.mock}
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{mock: mmAddMembers.mock}
 	}
-	mmCreateChat.defaultExpectation.results = &ChatServiceMockCreateChatResults{i1, err}
-	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+	mmAddMembers.defaultExpectation.results = &ChatServiceMockAddMembersResults{err}
+	mmAddMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
 }
 
-// Set uses given function f to mock the ChatService.CreateChat method
-func (mmCreateChat *mChatServiceMockCreateChat) Set(f func(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error)) *ChatServiceMock {
-	if mmCreateChat.defaultExpectation != nil {
-		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatService.CreateChat method")
+// Set uses given function f to mock the ChatService.AddMembers method
+func (mmAddMembers *mChatServiceMockAddMembers) Set(f func(ctx context.Context, members *model.ChatMembers) (err error)) *ChatServiceMock {
+	if mmAddMembers.defaultExpectation != nil {
+		mmAddMembers.mock.t.Fatalf("Default expectation is already set for the ChatService.AddMembers method")
 	}
 
-	if len(mmCreateChat.expectations) > 0 {
-		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatService.CreateChat method")
+	if len(mmAddMembers.expectations) > 0 {
+		mmAddMembers.mock.t.Fatalf("Some expectations are already set for the ChatService.AddMembers method")
 	}
 
-	mmCreateChat.mock.funcCreateChat = f
-	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
-	return mmCreateChat.mock
+	mmAddMembers.mock.funcAddMembers = f
+	mmAddMembers.mock.funcAddMembersOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
 }
 
-// When sets expectation for the ChatService.CreateChat which will trigger the result defined by the following
+// When sets expectation for the ChatService.AddMembers which will trigger the result defined by the following
 // Then helper
-func (mmCreateChat *mChatServiceMockCreateChat) When(ctx context.Context, chat *model.ChatCreate) *ChatServiceMockCreateChatExpectation {
-	if mmCreateChat.mock.funcCreateChat != nil {
-		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+func (mmAddMembers *mChatServiceMockAddMembers) When(ctx context.Context, members *model.ChatMembers) *ChatServiceMockAddMembersExpectation {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockCreateChatExpectation{
-		mock:               mmCreateChat.mock,
-		params:             &ChatServiceMockCreateChatParams{ctx, chat},
-		expectationOrigins: ChatServiceMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockAddMembersExpectation{
+		mock:               mmAddMembers.mock,
+		params:             &ChatServiceMockAddMembersParams{ctx, members},
+		expectationOrigins: ChatServiceMockAddMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	mmAddMembers.expectations = append(mmAddMembers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.CreateChat return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockCreateChatExpectation) Then(i1 int64, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockCreateChatResults{i1, err}
+// Then sets up ChatService.AddMembers return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddMembersExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddMembersResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.CreateChat should be invoked
-func (mmCreateChat *mChatServiceMockCreateChat) Times(n uint64) *mChatServiceMockCreateChat {
+// Times sets number of times ChatService.AddMembers should be invoked
+func (mmAddMembers *mChatServiceMockAddMembers) Times(n uint64) *mChatServiceMockAddMembers {
 	if n == 0 {
-		mmCreateChat.mock.t.Fatalf("Times of ChatServiceMock.CreateChat mock can not be zero")
+		mmAddMembers.mock.t.Fatalf("Times of ChatServiceMock.AddMembers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
-	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateChat
+	mm_atomic.StoreUint64(&mmAddMembers.expectedInvocations, n)
+	mmAddMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddMembers
 }
 
-func (mmCreateChat *mChatServiceMockCreateChat) invocationsDone() bool {
-	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+func (mmAddMembers *mChatServiceMockAddMembers) invocationsDone() bool {
+	if len(mmAddMembers.expectations) == 0 && mmAddMembers.defaultExpectation == nil && mmAddMembers.mock.funcAddMembers == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddMembers.mock.afterAddMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddMembers.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateChat implements mm_service.ChatService
-func (mmCreateChat *ChatServiceMock) CreateChat(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+// AddMembers implements mm_service.ChatService
+func (mmAddMembers *ChatServiceMock) AddMembers(ctx context.Context, members *model.ChatMembers) (err error) {
+	mm_atomic.AddUint64(&mmAddMembers.beforeAddMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMembers.afterAddMembersCounter, 1)
 
-	mmCreateChat.t.Helper()
+	mmAddMembers.t.Helper()
 
-	if mmCreateChat.inspectFuncCreateChat != nil {
-		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	if mmAddMembers.inspectFuncAddMembers != nil {
+		mmAddMembers.inspectFuncAddMembers(ctx, members)
 	}
 
-	mm_params := ChatServiceMockCreateChatParams{ctx, chat}
+	mm_params := ChatServiceMockAddMembersParams{ctx, members}
 
 	// Record call args
-	mmCreateChat.CreateChatMock.mutex.Lock()
-	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
-	mmCreateChat.CreateChatMock.mutex.Unlock()
+	mmAddMembers.AddMembersMock.mutex.Lock()
+	mmAddMembers.AddMembersMock.callArgs = append(mmAddMembers.AddMembersMock.callArgs, &mm_params)
+	mmAddMembers.AddMembersMock.mutex.Unlock()
 
-	for _, e := range mmCreateChat.CreateChatMock.expectations {
+	for _, e := range mmAddMembers.AddMembersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+	if mmAddMembers.AddMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMembers.AddMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMembers.AddMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMembers.AddMembersMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockCreateChatParams{ctx, chat}
+		mm_got := ChatServiceMockAddMembersParams{ctx, members}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
-				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			if mm_want_ptrs.members != nil && !minimock.Equal(*mm_want_ptrs.members, mm_got.members) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter members, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originMembers, *mm_want_ptrs.members, mm_got.members, minimock.Diff(*mm_want_ptrs.members, mm_got.members))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		mm_results := mmAddMembers.AddMembersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateChat.t.Fatal("No results are set for the ChatServiceMock.CreateChat")
+			mmAddMembers.t.Fatal("No results are set for the ChatServiceMock.AddMembers")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateChat.funcCreateChat != nil {
-		return mmCreateChat.funcCreateChat(ctx, chat)
+	if mmAddMembers.funcAddMembers != nil {
+		return mmAddMembers.funcAddMembers(ctx, members)
 	}
-	mmCreateChat.t.Fatalf("Unexpected call to ChatServiceMock.CreateChat. %v %v", ctx, chat)
+	mmAddMembers.t.Fatalf("Unexpected call to ChatServiceMock.AddMembers. %v %v", ctx, members)
 	return
 }
 
-// CreateChatAfterCounter returns a count of finished ChatServiceMock.CreateChat invocations
-func (mmCreateChat *ChatServiceMock) CreateChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+// AddMembersAfterCounter returns a count of finished ChatServiceMock.AddMembers invocations
+func (mmAddMembers *ChatServiceMock) AddMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.afterAddMembersCounter)
 }
 
-// CreateChatBeforeCounter returns a count of ChatServiceMock.CreateChat invocations
-func (mmCreateChat *ChatServiceMock) CreateChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+// AddMembersBeforeCounter returns a count of ChatServiceMock.AddMembers invocations
+func (mmAddMembers *ChatServiceMock) AddMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.beforeAddMembersCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.CreateChat.
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddMembers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateChat *mChatServiceMockCreateChat) Calls() []*ChatServiceMockCreateChatParams {
-	mmCreateChat.mutex.RLock()
+func (mmAddMembers *mChatServiceMockAddMembers) Calls() []*ChatServiceMockAddMembersParams {
+	mmAddMembers.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockCreateChatParams, len(mmCreateChat.callArgs))
-	copy(argCopy, mmCreateChat.callArgs)
+	argCopy := make([]*ChatServiceMockAddMembersParams, len(mmAddMembers.callArgs))
+	copy(argCopy, mmAddMembers.callArgs)
 
-	mmCreateChat.mutex.RUnlock()
+	mmAddMembers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// MinimockAddMembersDone returns true if the count of the AddMembers invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockCreateChatDone() bool {
-	if m.CreateChatMock.optional {
+func (m *ChatServiceMock) MinimockAddMembersDone() bool {
+	if m.AddMembersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateChatMock.expectations {
+	for _, e := range m.AddMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateChatMock.invocationsDone()
+	return m.AddMembersMock.invocationsDone()
 }
 
-// MinimockCreateChatInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockCreateChatInspect() {
-	for _, e := range m.CreateChatMock.expectations {
+// MinimockAddMembersInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddMembersInspect() {
+	for _, e := range m.AddMembersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	afterAddMembersCounter := mm_atomic.LoadUint64(&m.afterAddMembersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
-		if m.CreateChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+	if m.AddMembersMock.defaultExpectation != nil && afterAddMembersCounter < 1 {
+		if m.AddMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s", m.AddMembersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s with params: %#v", m.AddMembersMock.defaultExpectation.expectationOrigins.origin, *m.AddMembersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	if m.funcAddMembers != nil && afterAddMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s", m.funcAddMembersOrigin)
 	}
 
-	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.CreateChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	if !m.AddMembersMock.invocationsDone() && afterAddMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMembersMock.expectedInvocations), m.AddMembersMock.expectedInvocationsOrigin, afterAddMembersCounter)
 	}
 }
 
-type mChatServiceMockDeleteChat struct {
+type mChatServiceMockConnectChat struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockDeleteChatExpectation
-	expectations       []*ChatServiceMockDeleteChatExpectation
+	defaultExpectation *ChatServiceMockConnectChatExpectation
+	expectations       []*ChatServiceMockConnectChatExpectation
 
-	callArgs []*ChatServiceMockDeleteChatParams
+	callArgs []*ChatServiceMockConnectChatParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockDeleteChatExpectation specifies expectation struct of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatExpectation struct {
+// ChatServiceMockConnectChatExpectation specifies expectation struct of the ChatService.ConnectChat
+type ChatServiceMockConnectChatExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockDeleteChatParams
-	paramPtrs          *ChatServiceMockDeleteChatParamPtrs
-	expectationOrigins ChatServiceMockDeleteChatExpectationOrigins
-	results            *ChatServiceMockDeleteChatResults
+	params             *ChatServiceMockConnectChatParams
+	paramPtrs          *ChatServiceMockConnectChatParamPtrs
+	expectationOrigins ChatServiceMockConnectChatExpectationOrigins
+	results            *ChatServiceMockConnectChatResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockDeleteChatParams contains parameters of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatParams struct {
-	ctx context.Context
-	id  int64
+// ChatServiceMockConnectChatParams contains parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParams struct {
+	ctx  context.Context
+	chat *model.ChatConnect
+	send func(message *model.Message) error
 }
 
-// ChatServiceMockDeleteChatParamPtrs contains pointers to parameters of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// ChatServiceMockConnectChatParamPtrs contains pointers to parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParamPtrs struct {
+	ctx  *context.Context
+	chat **model.ChatConnect
+	send *func(message *model.Message) error
 }
 
-// ChatServiceMockDeleteChatResults contains results of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatResults struct {
+// ChatServiceMockConnectChatResults contains results of the ChatService.ConnectChat
+type ChatServiceMockConnectChatResults struct {
 	err error
 }
 
-// ChatServiceMockDeleteChatOrigins contains origins of expectations of the ChatService.DeleteChat
-type ChatServiceMockDeleteChatExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// ChatServiceMockConnectChatOrigins contains origins of expectations of the ChatService.ConnectChat
+type ChatServiceMockConnectChatExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+	originSend string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -459,341 +621,5848 @@ type ChatServiceMockDeleteChatExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteChat *mChatServiceMockDeleteChat) Optional() *mChatServiceMockDeleteChat {
-	mmDeleteChat.optional = true
-	return mmDeleteChat
+func (mmConnectChat *mChatServiceMockConnectChat) Optional() *mChatServiceMockConnectChat {
+	mmConnectChat.optional = true
+	return mmConnectChat
 }
 
-// Expect sets up expected params for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Expect(ctx context.Context, id int64) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// Expect sets up expected params for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Expect(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by ExpectParams functions")
+	if mmConnectChat.defaultExpectation.paramPtrs != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteChat.defaultExpectation.params = &ChatServiceMockDeleteChatParams{ctx, id}
-	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteChat.expectations {
-		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
-			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+	mmConnectChat.defaultExpectation.params = &ChatServiceMockConnectChatParams{ctx, chat, send}
+	mmConnectChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConnectChat.expectations {
+		if minimock.Equal(e.params, mmConnectChat.defaultExpectation.params) {
+			mmConnectChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConnectChat.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteChat
+	return mmConnectChat
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
 	}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
 	}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmConnectChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConnectChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	return mmConnectChat
 }
 
-// ExpectIdParam2 sets up expected param id for ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectIdParam2(id int64) *mChatServiceMockDeleteChat {
+// ExpectChatParam2 sets up expected param chat for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectChatParam2(chat *model.ChatConnect) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.chat = &chat
+	mmConnectChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// ExpectSendParam3 sets up expected param send for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectSendParam3(send func(message *model.Message) error) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.send = &send
+	mmConnectChat.defaultExpectation.expectationOrigins.originSend = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Inspect(f func(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error)) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.inspectFuncConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ConnectChat")
+	}
+
+	mmConnectChat.mock.inspectFuncConnectChat = f
+
+	return mmConnectChat
+}
+
+// Return sets up results that will be returned by ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Return(err error) *ChatServiceMock {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{mock: mmConnectChat.mock}
+	}
+	mmConnectChat.defaultExpectation.results = &ChatServiceMockConnectChatResults{err}
+	mmConnectChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConnectChat.mock
+}
+
+// Set uses given function f to mock the ChatService.ConnectChat method
+func (mmConnectChat *mChatServiceMockConnectChat) Set(f func(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error) (err error)) *ChatServiceMock {
+	if mmConnectChat.defaultExpectation != nil {
+		mmConnectChat.mock.t.Fatalf("Default expectation is already set for the ChatService.ConnectChat method")
+	}
+
+	if len(mmConnectChat.expectations) > 0 {
+		mmConnectChat.mock.t.Fatalf("Some expectations are already set for the ChatService.ConnectChat method")
+	}
+
+	mmConnectChat.mock.funcConnectChat = f
+	mmConnectChat.mock.funcConnectChatOrigin = minimock.CallerInfo(1)
+	return mmConnectChat.mock
+}
+
+// When sets expectation for the ChatService.ConnectChat which will trigger the result defined by the following
+// Then helper
+func (mmConnectChat *mChatServiceMockConnectChat) When(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error) *ChatServiceMockConnectChatExpectation {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockConnectChatExpectation{
+		mock:               mmConnectChat.mock,
+		params:             &ChatServiceMockConnectChatParams{ctx, chat, send},
+		expectationOrigins: ChatServiceMockConnectChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConnectChat.expectations = append(mmConnectChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ConnectChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockConnectChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockConnectChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ConnectChat should be invoked
+func (mmConnectChat *mChatServiceMockConnectChat) Times(n uint64) *mChatServiceMockConnectChat {
+	if n == 0 {
+		mmConnectChat.mock.t.Fatalf("Times of ChatServiceMock.ConnectChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConnectChat.expectedInvocations, n)
+	mmConnectChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConnectChat
+}
+
+func (mmConnectChat *mChatServiceMockConnectChat) invocationsDone() bool {
+	if len(mmConnectChat.expectations) == 0 && mmConnectChat.defaultExpectation == nil && mmConnectChat.mock.funcConnectChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConnectChat.mock.afterConnectChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConnectChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConnectChat implements mm_service.ChatService
+func (mmConnectChat *ChatServiceMock) ConnectChat(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error) (err error) {
+	mm_atomic.AddUint64(&mmConnectChat.beforeConnectChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmConnectChat.afterConnectChatCounter, 1)
+
+	mmConnectChat.t.Helper()
+
+	if mmConnectChat.inspectFuncConnectChat != nil {
+		mmConnectChat.inspectFuncConnectChat(ctx, chat, send)
+	}
+
+	mm_params := ChatServiceMockConnectChatParams{ctx, chat, send}
+
+	// Record call args
+	mmConnectChat.ConnectChatMock.mutex.Lock()
+	mmConnectChat.ConnectChatMock.callArgs = append(mmConnectChat.ConnectChatMock.callArgs, &mm_params)
+	mmConnectChat.ConnectChatMock.mutex.Unlock()
+
+	for _, e := range mmConnectChat.ConnectChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConnectChat.ConnectChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConnectChat.ConnectChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmConnectChat.ConnectChatMock.defaultExpectation.params
+		mm_want_ptrs := mmConnectChat.ConnectChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockConnectChatParams{ctx, chat, send}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+			if mm_want_ptrs.send != nil && !minimock.Equal(*mm_want_ptrs.send, mm_got.send) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter send, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originSend, *mm_want_ptrs.send, mm_got.send, minimock.Diff(*mm_want_ptrs.send, mm_got.send))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConnectChat.ConnectChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConnectChat.t.Fatal("No results are set for the ChatServiceMock.ConnectChat")
+		}
+		return (*mm_results).err
+	}
+	if mmConnectChat.funcConnectChat != nil {
+		return mmConnectChat.funcConnectChat(ctx, chat, send)
+	}
+	mmConnectChat.t.Fatalf("Unexpected call to ChatServiceMock.ConnectChat. %v %v %v", ctx, chat, send)
+	return
+}
+
+// ConnectChatAfterCounter returns a count of finished ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.afterConnectChatCounter)
+}
+
+// ConnectChatBeforeCounter returns a count of ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.beforeConnectChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ConnectChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConnectChat *mChatServiceMockConnectChat) Calls() []*ChatServiceMockConnectChatParams {
+	mmConnectChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockConnectChatParams, len(mmConnectChat.callArgs))
+	copy(argCopy, mmConnectChat.callArgs)
+
+	mmConnectChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnectChatDone returns true if the count of the ConnectChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockConnectChatDone() bool {
+	if m.ConnectChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConnectChatMock.invocationsDone()
+}
+
+// MinimockConnectChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockConnectChatInspect() {
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConnectChatCounter := mm_atomic.LoadUint64(&m.afterConnectChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnectChatMock.defaultExpectation != nil && afterConnectChatCounter < 1 {
+		if m.ConnectChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.ConnectChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", m.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *m.ConnectChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConnectChat != nil && afterConnectChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.funcConnectChatOrigin)
+	}
+
+	if !m.ConnectChatMock.invocationsDone() && afterConnectChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ConnectChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConnectChatMock.expectedInvocations), m.ConnectChatMock.expectedInvocationsOrigin, afterConnectChatCounter)
+	}
+}
+
+type mChatServiceMockCreateChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCreateChatExpectation
+	expectations       []*ChatServiceMockCreateChatExpectation
+
+	callArgs []*ChatServiceMockCreateChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockCreateChatExpectation specifies expectation struct of the ChatService.CreateChat
+type ChatServiceMockCreateChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockCreateChatParams
+	paramPtrs          *ChatServiceMockCreateChatParamPtrs
+	expectationOrigins ChatServiceMockCreateChatExpectationOrigins
+	results            *ChatServiceMockCreateChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockCreateChatParams contains parameters of the ChatService.CreateChat
+type ChatServiceMockCreateChatParams struct {
+	ctx  context.Context
+	chat *model.ChatCreate
+}
+
+// ChatServiceMockCreateChatParamPtrs contains pointers to parameters of the ChatService.CreateChat
+type ChatServiceMockCreateChatParamPtrs struct {
+	ctx  *context.Context
+	chat **model.ChatCreate
+}
+
+// ChatServiceMockCreateChatResults contains results of the ChatService.CreateChat
+type ChatServiceMockCreateChatResults struct {
+	i1  int64
+	err error
+}
+
+// ChatServiceMockCreateChatOrigins contains origins of expectations of the ChatService.CreateChat
+type ChatServiceMockCreateChatExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateChat *mChatServiceMockCreateChat) Optional() *mChatServiceMockCreateChat {
+	mmCreateChat.optional = true
+	return mmCreateChat
+}
+
+// Expect sets up expected params for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Expect(ctx context.Context, chat *model.ChatCreate) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by ExpectParams functions")
+	}
+
+	mmCreateChat.defaultExpectation.params = &ChatServiceMockCreateChatParams{ctx, chat}
+	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateChat.expectations {
+		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
+			mmCreateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateChat.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) ExpectChatParam2(chat *model.ChatCreate) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatServiceMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.chat = &chat
+	mmCreateChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Inspect(f func(ctx context.Context, chat *model.ChatCreate)) *mChatServiceMockCreateChat {
+	if mmCreateChat.mock.inspectFuncCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CreateChat")
+	}
+
+	mmCreateChat.mock.inspectFuncCreateChat = f
+
+	return mmCreateChat
+}
+
+// Return sets up results that will be returned by ChatService.CreateChat
+func (mmCreateChat *mChatServiceMockCreateChat) Return(i1 int64, err error) *ChatServiceMock {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatServiceMockCreateChatExpectation{mock: mmCreateChat.mock}
+	}
+	mmCreateChat.defaultExpectation.results = &ChatServiceMockCreateChatResults{i1, err}
+	mmCreateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// Set uses given function f to mock the ChatService.CreateChat method
+func (mmCreateChat *mChatServiceMockCreateChat) Set(f func(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error)) *ChatServiceMock {
+	if mmCreateChat.defaultExpectation != nil {
+		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatService.CreateChat method")
+	}
+
+	if len(mmCreateChat.expectations) > 0 {
+		mmCreateChat.mock.t.Fatalf("Some expectations are already set for the ChatService.CreateChat method")
+	}
+
+	mmCreateChat.mock.funcCreateChat = f
+	mmCreateChat.mock.funcCreateChatOrigin = minimock.CallerInfo(1)
+	return mmCreateChat.mock
+}
+
+// When sets expectation for the ChatService.CreateChat which will trigger the result defined by the following
+// Then helper
+func (mmCreateChat *mChatServiceMockCreateChat) When(ctx context.Context, chat *model.ChatCreate) *ChatServiceMockCreateChatExpectation {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatServiceMock.CreateChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCreateChatExpectation{
+		mock:               mmCreateChat.mock,
+		params:             &ChatServiceMockCreateChatParams{ctx, chat},
+		expectationOrigins: ChatServiceMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CreateChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCreateChatExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCreateChatResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CreateChat should be invoked
+func (mmCreateChat *mChatServiceMockCreateChat) Times(n uint64) *mChatServiceMockCreateChat {
+	if n == 0 {
+		mmCreateChat.mock.t.Fatalf("Times of ChatServiceMock.CreateChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateChat.expectedInvocations, n)
+	mmCreateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateChat
+}
+
+func (mmCreateChat *mChatServiceMockCreateChat) invocationsDone() bool {
+	if len(mmCreateChat.expectations) == 0 && mmCreateChat.defaultExpectation == nil && mmCreateChat.mock.funcCreateChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateChat.mock.afterCreateChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateChat implements mm_service.ChatService
+func (mmCreateChat *ChatServiceMock) CreateChat(ctx context.Context, chat *model.ChatCreate) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
+
+	mmCreateChat.t.Helper()
+
+	if mmCreateChat.inspectFuncCreateChat != nil {
+		mmCreateChat.inspectFuncCreateChat(ctx, chat)
+	}
+
+	mm_params := ChatServiceMockCreateChatParams{ctx, chat}
+
+	// Record call args
+	mmCreateChat.CreateChatMock.mutex.Lock()
+	mmCreateChat.CreateChatMock.callArgs = append(mmCreateChat.CreateChatMock.callArgs, &mm_params)
+	mmCreateChat.CreateChatMock.mutex.Unlock()
+
+	for _, e := range mmCreateChat.CreateChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateChat.CreateChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateChat.CreateChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCreateChatParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateChat.t.Errorf("ChatServiceMock.CreateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateChat.CreateChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateChat.t.Fatal("No results are set for the ChatServiceMock.CreateChat")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateChat.funcCreateChat != nil {
+		return mmCreateChat.funcCreateChat(ctx, chat)
+	}
+	mmCreateChat.t.Fatalf("Unexpected call to ChatServiceMock.CreateChat. %v %v", ctx, chat)
+	return
+}
+
+// CreateChatAfterCounter returns a count of finished ChatServiceMock.CreateChat invocations
+func (mmCreateChat *ChatServiceMock) CreateChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.afterCreateChatCounter)
+}
+
+// CreateChatBeforeCounter returns a count of ChatServiceMock.CreateChat invocations
+func (mmCreateChat *ChatServiceMock) CreateChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateChat.beforeCreateChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CreateChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateChat *mChatServiceMockCreateChat) Calls() []*ChatServiceMockCreateChatParams {
+	mmCreateChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCreateChatParams, len(mmCreateChat.callArgs))
+	copy(argCopy, mmCreateChat.callArgs)
+
+	mmCreateChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateChatDone returns true if the count of the CreateChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCreateChatDone() bool {
+	if m.CreateChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateChatMock.invocationsDone()
+}
+
+// MinimockCreateChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCreateChatInspect() {
+	for _, e := range m.CreateChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateChatCounter := mm_atomic.LoadUint64(&m.afterCreateChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateChatMock.defaultExpectation != nil && afterCreateChatCounter < 1 {
+		if m.CreateChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.CreateChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s with params: %#v", m.CreateChatMock.defaultExpectation.expectationOrigins.origin, *m.CreateChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateChat != nil && afterCreateChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.CreateChat at\n%s", m.funcCreateChatOrigin)
+	}
+
+	if !m.CreateChatMock.invocationsDone() && afterCreateChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CreateChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateChatMock.expectedInvocations), m.CreateChatMock.expectedInvocationsOrigin, afterCreateChatCounter)
+	}
+}
+
+type mChatServiceMockDeleteChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteChatExpectation
+	expectations       []*ChatServiceMockDeleteChatExpectation
+
+	callArgs []*ChatServiceMockDeleteChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteChatExpectation specifies expectation struct of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteChatParams
+	paramPtrs          *ChatServiceMockDeleteChatParamPtrs
+	expectationOrigins ChatServiceMockDeleteChatExpectationOrigins
+	results            *ChatServiceMockDeleteChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteChatParams contains parameters of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatParams struct {
+	ctx  context.Context
+	chat *model.ChatDelete
+}
+
+// ChatServiceMockDeleteChatParamPtrs contains pointers to parameters of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatParamPtrs struct {
+	ctx  *context.Context
+	chat **model.ChatDelete
+}
+
+// ChatServiceMockDeleteChatResults contains results of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatResults struct {
+	err error
+}
+
+// ChatServiceMockDeleteChatOrigins contains origins of expectations of the ChatService.DeleteChat
+type ChatServiceMockDeleteChatExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteChat *mChatServiceMockDeleteChat) Optional() *mChatServiceMockDeleteChat {
+	mmDeleteChat.optional = true
+	return mmDeleteChat
+}
+
+// Expect sets up expected params for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Expect(ctx context.Context, chat *model.ChatDelete) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteChat.defaultExpectation.params = &ChatServiceMockDeleteChatParams{ctx, chat}
+	mmDeleteChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteChat.expectations {
+		if minimock.Equal(e.params, mmDeleteChat.defaultExpectation.params) {
+			mmDeleteChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChat.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) ExpectChatParam2(chat *model.ChatDelete) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	}
+
+	if mmDeleteChat.defaultExpectation.params != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+	}
+
+	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
+		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+	}
+	mmDeleteChat.defaultExpectation.paramPtrs.chat = &chat
+	mmDeleteChat.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmDeleteChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Inspect(f func(ctx context.Context, chat *model.ChatDelete)) *mChatServiceMockDeleteChat {
+	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteChat")
+	}
+
+	mmDeleteChat.mock.inspectFuncDeleteChat = f
+
+	return mmDeleteChat
+}
+
+// Return sets up results that will be returned by ChatService.DeleteChat
+func (mmDeleteChat *mChatServiceMockDeleteChat) Return(err error) *ChatServiceMock {
+	if mmDeleteChat.mock.funcDeleteChat != nil {
+		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+	}
+
+	if mmDeleteChat.defaultExpectation == nil {
+		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	}
+	mmDeleteChat.defaultExpectation.results = &ChatServiceMockDeleteChatResults{err}
+	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteChat method
+func (mmDeleteChat *mChatServiceMockDeleteChat) Set(f func(ctx context.Context, chat *model.ChatDelete) (err error)) *ChatServiceMock {
+	if mmDeleteChat.defaultExpectation != nil {
+		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteChat method")
+	}
+
+	if len(mmDeleteChat.expectations) > 0 {
+		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteChat method")
+	}
+
+	mmDeleteChat.mock.funcDeleteChat = f
+	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat.mock
+}
+
+// When sets expectation for the ChatService.DeleteChat which will trigger the result defined by the following
+// Then helper
+func (mmDeleteChat *mChatServiceMockDeleteChat) When(ctx context.Context, chat *model.ChatDelete) *ChatServiceMockDeleteChatExpectation {
 	if mmDeleteChat.mock.funcDeleteChat != nil {
 		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{}
+	expectation := &ChatServiceMockDeleteChatExpectation{
+		mock:               mmDeleteChat.mock,
+		params:             &ChatServiceMockDeleteChatParams{ctx, chat},
+		expectationOrigins: ChatServiceMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteChat should be invoked
+func (mmDeleteChat *mChatServiceMockDeleteChat) Times(n uint64) *mChatServiceMockDeleteChat {
+	if n == 0 {
+		mmDeleteChat.mock.t.Fatalf("Times of ChatServiceMock.DeleteChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
+	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChat
+}
+
+func (mmDeleteChat *mChatServiceMockDeleteChat) invocationsDone() bool {
+	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteChat implements mm_service.ChatService
+func (mmDeleteChat *ChatServiceMock) DeleteChat(ctx context.Context, chat *model.ChatDelete) (err error) {
+	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+
+	mmDeleteChat.t.Helper()
+
+	if mmDeleteChat.inspectFuncDeleteChat != nil {
+		mmDeleteChat.inspectFuncDeleteChat(ctx, chat)
+	}
+
+	mm_params := ChatServiceMockDeleteChatParams{ctx, chat}
+
+	// Record call args
+	mmDeleteChat.DeleteChatMock.mutex.Lock()
+	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
+	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+
+	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteChatParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteChat.t.Fatal("No results are set for the ChatServiceMock.DeleteChat")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteChat.funcDeleteChat != nil {
+		return mmDeleteChat.funcDeleteChat(ctx, chat)
+	}
+	mmDeleteChat.t.Fatalf("Unexpected call to ChatServiceMock.DeleteChat. %v %v", ctx, chat)
+	return
+}
+
+// DeleteChatAfterCounter returns a count of finished ChatServiceMock.DeleteChat invocations
+func (mmDeleteChat *ChatServiceMock) DeleteChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+}
+
+// DeleteChatBeforeCounter returns a count of ChatServiceMock.DeleteChat invocations
+func (mmDeleteChat *ChatServiceMock) DeleteChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteChat *mChatServiceMockDeleteChat) Calls() []*ChatServiceMockDeleteChatParams {
+	mmDeleteChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteChatParams, len(mmDeleteChat.callArgs))
+	copy(argCopy, mmDeleteChat.callArgs)
+
+	mmDeleteChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteChatDone() bool {
+	if m.DeleteChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteChatMock.invocationsDone()
+}
+
+// MinimockDeleteChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteChatInspect() {
+	for _, e := range m.DeleteChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
+		if m.DeleteChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	}
+
+	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	}
+}
+
+type mChatServiceMockDeleteMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteMessageExpectation
+	expectations       []*ChatServiceMockDeleteMessageExpectation
+
+	callArgs []*ChatServiceMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteMessageExpectation specifies expectation struct of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteMessageParams
+	paramPtrs          *ChatServiceMockDeleteMessageParamPtrs
+	expectationOrigins ChatServiceMockDeleteMessageExpectationOrigins
+	results            *ChatServiceMockDeleteMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteMessageParams contains parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParams struct {
+	ctx context.Context
+	del *model.MessageDelete
+}
+
+// ChatServiceMockDeleteMessageParamPtrs contains pointers to parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParamPtrs struct {
+	ctx *context.Context
+	del **model.MessageDelete
+}
+
+// ChatServiceMockDeleteMessageResults contains results of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageResults struct {
+	err error
+}
+
+// ChatServiceMockDeleteMessageOrigins contains origins of expectations of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originDel string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Optional() *mChatServiceMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Expect(ctx context.Context, del *model.MessageDelete) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &ChatServiceMockDeleteMessageParams{ctx, del}
+	mmDeleteMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectDelParam2 sets up expected param del for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectDelParam2(del *model.MessageDelete) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.del = &del
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originDel = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Inspect(f func(ctx context.Context, del *model.MessageDelete)) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Return(err error) *ChatServiceMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &ChatServiceMockDeleteMessageResults{err}
+	mmDeleteMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteMessage method
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Set(f func(ctx context.Context, del *model.MessageDelete) (err error)) *ChatServiceMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	mmDeleteMessage.mock.funcDeleteMessageOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the ChatService.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) When(ctx context.Context, del *model.MessageDelete) *ChatServiceMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteMessageExpectation{
+		mock:               mmDeleteMessage.mock,
+		params:             &ChatServiceMockDeleteMessageParams{ctx, del},
+		expectationOrigins: ChatServiceMockDeleteMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Times(n uint64) *mChatServiceMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatServiceMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	mmDeleteMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements mm_service.ChatService
+func (mmDeleteMessage *ChatServiceMock) DeleteMessage(ctx context.Context, del *model.MessageDelete) (err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	mmDeleteMessage.t.Helper()
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, del)
+	}
+
+	mm_params := ChatServiceMockDeleteMessageParams{ctx, del}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteMessageParams{ctx, del}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.del != nil && !minimock.Equal(*mm_want_ptrs.del, mm_got.del) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter del, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originDel, *mm_want_ptrs.del, mm_got.del, minimock.Diff(*mm_want_ptrs.del, mm_got.del))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the ChatServiceMock.DeleteMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, del)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatServiceMock.DeleteMessage. %v %v", ctx, del)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Calls() []*ChatServiceMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.DeleteMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", m.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.funcDeleteMessageOrigin)
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), m.DeleteMessageMock.expectedInvocationsOrigin, afterDeleteMessageCounter)
+	}
+}
+
+type mChatServiceMockEditMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockEditMessageExpectation
+	expectations       []*ChatServiceMockEditMessageExpectation
+
+	callArgs []*ChatServiceMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockEditMessageExpectation specifies expectation struct of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockEditMessageParams
+	paramPtrs          *ChatServiceMockEditMessageParamPtrs
+	expectationOrigins ChatServiceMockEditMessageExpectationOrigins
+	results            *ChatServiceMockEditMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockEditMessageParams contains parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParams struct {
+	ctx  context.Context
+	edit *model.MessageEdit
+}
+
+// ChatServiceMockEditMessageParamPtrs contains pointers to parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParamPtrs struct {
+	ctx  *context.Context
+	edit **model.MessageEdit
+}
+
+// ChatServiceMockEditMessageResults contains results of the ChatService.EditMessage
+type ChatServiceMockEditMessageResults struct {
+	err error
+}
+
+// ChatServiceMockEditMessageOrigins contains origins of expectations of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originEdit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mChatServiceMockEditMessage) Optional() *mChatServiceMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Expect(ctx context.Context, edit *model.MessageEdit) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &ChatServiceMockEditMessageParams{ctx, edit}
+	mmEditMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEditMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectEditParam2 sets up expected param edit for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectEditParam2(edit *model.MessageEdit) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.edit = &edit
+	mmEditMessage.defaultExpectation.expectationOrigins.originEdit = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Inspect(f func(ctx context.Context, edit *model.MessageEdit)) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Return(err error) *ChatServiceMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &ChatServiceMockEditMessageResults{err}
+	mmEditMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.EditMessage method
+func (mmEditMessage *mChatServiceMockEditMessage) Set(f func(ctx context.Context, edit *model.MessageEdit) (err error)) *ChatServiceMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	mmEditMessage.mock.funcEditMessageOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the ChatService.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mChatServiceMockEditMessage) When(ctx context.Context, edit *model.MessageEdit) *ChatServiceMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockEditMessageExpectation{
+		mock:               mmEditMessage.mock,
+		params:             &ChatServiceMockEditMessageParams{ctx, edit},
+		expectationOrigins: ChatServiceMockEditMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.EditMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockEditMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockEditMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.EditMessage should be invoked
+func (mmEditMessage *mChatServiceMockEditMessage) Times(n uint64) *mChatServiceMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of ChatServiceMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	mmEditMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mChatServiceMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements mm_service.ChatService
+func (mmEditMessage *ChatServiceMock) EditMessage(ctx context.Context, edit *model.MessageEdit) (err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	mmEditMessage.t.Helper()
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, edit)
+	}
+
+	mm_params := ChatServiceMockEditMessageParams{ctx, edit}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockEditMessageParams{ctx, edit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.edit != nil && !minimock.Equal(*mm_want_ptrs.edit, mm_got.edit) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter edit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originEdit, *mm_want_ptrs.edit, mm_got.edit, minimock.Diff(*mm_want_ptrs.edit, mm_got.edit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the ChatServiceMock.EditMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, edit)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to ChatServiceMock.EditMessage. %v %v", ctx, edit)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mChatServiceMockEditMessage) Calls() []*ChatServiceMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.EditMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", m.EditMessageMock.defaultExpectation.expectationOrigins.origin, *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.funcEditMessageOrigin)
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.EditMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), m.EditMessageMock.expectedInvocationsOrigin, afterEditMessageCounter)
+	}
+}
+
+type mChatServiceMockGetChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetChatExpectation
+	expectations       []*ChatServiceMockGetChatExpectation
+
+	callArgs []*ChatServiceMockGetChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetChatExpectation specifies expectation struct of the ChatService.GetChat
+type ChatServiceMockGetChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetChatParams
+	paramPtrs          *ChatServiceMockGetChatParamPtrs
+	expectationOrigins ChatServiceMockGetChatExpectationOrigins
+	results            *ChatServiceMockGetChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetChatParams contains parameters of the ChatService.GetChat
+type ChatServiceMockGetChatParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatServiceMockGetChatParamPtrs contains pointers to parameters of the ChatService.GetChat
+type ChatServiceMockGetChatParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatServiceMockGetChatResults contains results of the ChatService.GetChat
+type ChatServiceMockGetChatResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// ChatServiceMockGetChatOrigins contains origins of expectations of the ChatService.GetChat
+type ChatServiceMockGetChatExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChat *mChatServiceMockGetChat) Optional() *mChatServiceMockGetChat {
+	mmGetChat.optional = true
+	return mmGetChat
+}
+
+// Expect sets up expected params for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Expect(ctx context.Context, id int64) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by ExpectParams functions")
+	}
+
+	mmGetChat.defaultExpectation.params = &ChatServiceMockGetChatParams{ctx, id}
+	mmGetChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChat.expectations {
+		if minimock.Equal(e.params, mmGetChat.defaultExpectation.params) {
+			mmGetChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChat.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatServiceMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) ExpectIdParam2(id int64) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatServiceMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.id = &id
+	mmGetChat.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockGetChat {
+	if mmGetChat.mock.inspectFuncGetChat != nil {
+		mmGetChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetChat")
+	}
+
+	mmGetChat.mock.inspectFuncGetChat = f
+
+	return mmGetChat
+}
+
+// Return sets up results that will be returned by ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Return(cp1 *model.Chat, err error) *ChatServiceMock {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{mock: mmGetChat.mock}
+	}
+	mmGetChat.defaultExpectation.results = &ChatServiceMockGetChatResults{cp1, err}
+	mmGetChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// Set uses given function f to mock the ChatService.GetChat method
+func (mmGetChat *mChatServiceMockGetChat) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatServiceMock {
+	if mmGetChat.defaultExpectation != nil {
+		mmGetChat.mock.t.Fatalf("Default expectation is already set for the ChatService.GetChat method")
+	}
+
+	if len(mmGetChat.expectations) > 0 {
+		mmGetChat.mock.t.Fatalf("Some expectations are already set for the ChatService.GetChat method")
+	}
+
+	mmGetChat.mock.funcGetChat = f
+	mmGetChat.mock.funcGetChatOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// When sets expectation for the ChatService.GetChat which will trigger the result defined by the following
+// Then helper
+func (mmGetChat *mChatServiceMockGetChat) When(ctx context.Context, id int64) *ChatServiceMockGetChatExpectation {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetChatExpectation{
+		mock:               mmGetChat.mock,
+		params:             &ChatServiceMockGetChatParams{ctx, id},
+		expectationOrigins: ChatServiceMockGetChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChat.expectations = append(mmGetChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetChatExpectation) Then(cp1 *model.Chat, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetChatResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetChat should be invoked
+func (mmGetChat *mChatServiceMockGetChat) Times(n uint64) *mChatServiceMockGetChat {
+	if n == 0 {
+		mmGetChat.mock.t.Fatalf("Times of ChatServiceMock.GetChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChat.expectedInvocations, n)
+	mmGetChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChat
+}
+
+func (mmGetChat *mChatServiceMockGetChat) invocationsDone() bool {
+	if len(mmGetChat.expectations) == 0 && mmGetChat.defaultExpectation == nil && mmGetChat.mock.funcGetChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChat.mock.afterGetChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChat implements mm_service.ChatService
+func (mmGetChat *ChatServiceMock) GetChat(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGetChat.beforeGetChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChat.afterGetChatCounter, 1)
+
+	mmGetChat.t.Helper()
+
+	if mmGetChat.inspectFuncGetChat != nil {
+		mmGetChat.inspectFuncGetChat(ctx, id)
+	}
+
+	mm_params := ChatServiceMockGetChatParams{ctx, id}
+
+	// Record call args
+	mmGetChat.GetChatMock.mutex.Lock()
+	mmGetChat.GetChatMock.callArgs = append(mmGetChat.GetChatMock.callArgs, &mm_params)
+	mmGetChat.GetChatMock.mutex.Unlock()
+
+	for _, e := range mmGetChat.GetChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetChat.GetChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChat.GetChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChat.GetChatMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChat.GetChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetChatParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChat.GetChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChat.t.Fatal("No results are set for the ChatServiceMock.GetChat")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetChat.funcGetChat != nil {
+		return mmGetChat.funcGetChat(ctx, id)
+	}
+	mmGetChat.t.Fatalf("Unexpected call to ChatServiceMock.GetChat. %v %v", ctx, id)
+	return
+}
+
+// GetChatAfterCounter returns a count of finished ChatServiceMock.GetChat invocations
+func (mmGetChat *ChatServiceMock) GetChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.afterGetChatCounter)
+}
+
+// GetChatBeforeCounter returns a count of ChatServiceMock.GetChat invocations
+func (mmGetChat *ChatServiceMock) GetChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.beforeGetChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChat *mChatServiceMockGetChat) Calls() []*ChatServiceMockGetChatParams {
+	mmGetChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetChatParams, len(mmGetChat.callArgs))
+	copy(argCopy, mmGetChat.callArgs)
+
+	mmGetChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatDone returns true if the count of the GetChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetChatDone() bool {
+	if m.GetChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMock.invocationsDone()
+}
+
+// MinimockGetChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetChatInspect() {
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChatCounter := mm_atomic.LoadUint64(&m.afterGetChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMock.defaultExpectation != nil && afterGetChatCounter < 1 {
+		if m.GetChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s", m.GetChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s with params: %#v", m.GetChatMock.defaultExpectation.expectationOrigins.origin, *m.GetChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChat != nil && afterGetChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s", m.funcGetChatOrigin)
+	}
+
+	if !m.GetChatMock.invocationsDone() && afterGetChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMock.expectedInvocations), m.GetChatMock.expectedInvocationsOrigin, afterGetChatCounter)
+	}
+}
+
+type mChatServiceMockGetReadReceipts struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetReadReceiptsExpectation
+	expectations       []*ChatServiceMockGetReadReceiptsExpectation
+
+	callArgs []*ChatServiceMockGetReadReceiptsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetReadReceiptsExpectation specifies expectation struct of the ChatService.GetReadReceipts
+type ChatServiceMockGetReadReceiptsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetReadReceiptsParams
+	paramPtrs          *ChatServiceMockGetReadReceiptsParamPtrs
+	expectationOrigins ChatServiceMockGetReadReceiptsExpectationOrigins
+	results            *ChatServiceMockGetReadReceiptsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetReadReceiptsParams contains parameters of the ChatService.GetReadReceipts
+type ChatServiceMockGetReadReceiptsParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// ChatServiceMockGetReadReceiptsParamPtrs contains pointers to parameters of the ChatService.GetReadReceipts
+type ChatServiceMockGetReadReceiptsParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// ChatServiceMockGetReadReceiptsResults contains results of the ChatService.GetReadReceipts
+type ChatServiceMockGetReadReceiptsResults struct {
+	rpa1 []*model.ReadReceipt
+	err  error
+}
+
+// ChatServiceMockGetReadReceiptsOrigins contains origins of expectations of the ChatService.GetReadReceipts
+type ChatServiceMockGetReadReceiptsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) Optional() *mChatServiceMockGetReadReceipts {
+	mmGetReadReceipts.optional = true
+	return mmGetReadReceipts
+}
+
+// Expect sets up expected params for ChatService.GetReadReceipts
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) Expect(ctx context.Context, messageID int64) *mChatServiceMockGetReadReceipts {
+	if mmGetReadReceipts.mock.funcGetReadReceipts != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by Set")
+	}
+
+	if mmGetReadReceipts.defaultExpectation == nil {
+		mmGetReadReceipts.defaultExpectation = &ChatServiceMockGetReadReceiptsExpectation{}
+	}
+
+	if mmGetReadReceipts.defaultExpectation.paramPtrs != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by ExpectParams functions")
+	}
+
+	mmGetReadReceipts.defaultExpectation.params = &ChatServiceMockGetReadReceiptsParams{ctx, messageID}
+	mmGetReadReceipts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReadReceipts.expectations {
+		if minimock.Equal(e.params, mmGetReadReceipts.defaultExpectation.params) {
+			mmGetReadReceipts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReadReceipts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReadReceipts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetReadReceipts
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetReadReceipts {
+	if mmGetReadReceipts.mock.funcGetReadReceipts != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by Set")
+	}
+
+	if mmGetReadReceipts.defaultExpectation == nil {
+		mmGetReadReceipts.defaultExpectation = &ChatServiceMockGetReadReceiptsExpectation{}
+	}
+
+	if mmGetReadReceipts.defaultExpectation.params != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by Expect")
+	}
+
+	if mmGetReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmGetReadReceipts.defaultExpectation.paramPtrs = &ChatServiceMockGetReadReceiptsParamPtrs{}
+	}
+	mmGetReadReceipts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReadReceipts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReadReceipts
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for ChatService.GetReadReceipts
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) ExpectMessageIDParam2(messageID int64) *mChatServiceMockGetReadReceipts {
+	if mmGetReadReceipts.mock.funcGetReadReceipts != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by Set")
+	}
+
+	if mmGetReadReceipts.defaultExpectation == nil {
+		mmGetReadReceipts.defaultExpectation = &ChatServiceMockGetReadReceiptsExpectation{}
+	}
+
+	if mmGetReadReceipts.defaultExpectation.params != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by Expect")
+	}
+
+	if mmGetReadReceipts.defaultExpectation.paramPtrs == nil {
+		mmGetReadReceipts.defaultExpectation.paramPtrs = &ChatServiceMockGetReadReceiptsParamPtrs{}
+	}
+	mmGetReadReceipts.defaultExpectation.paramPtrs.messageID = &messageID
+	mmGetReadReceipts.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmGetReadReceipts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetReadReceipts
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) Inspect(f func(ctx context.Context, messageID int64)) *mChatServiceMockGetReadReceipts {
+	if mmGetReadReceipts.mock.inspectFuncGetReadReceipts != nil {
+		mmGetReadReceipts.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetReadReceipts")
+	}
+
+	mmGetReadReceipts.mock.inspectFuncGetReadReceipts = f
+
+	return mmGetReadReceipts
+}
+
+// Return sets up results that will be returned by ChatService.GetReadReceipts
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) Return(rpa1 []*model.ReadReceipt, err error) *ChatServiceMock {
+	if mmGetReadReceipts.mock.funcGetReadReceipts != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by Set")
+	}
+
+	if mmGetReadReceipts.defaultExpectation == nil {
+		mmGetReadReceipts.defaultExpectation = &ChatServiceMockGetReadReceiptsExpectation{mock: mmGetReadReceipts.mock}
+	}
+	mmGetReadReceipts.defaultExpectation.results = &ChatServiceMockGetReadReceiptsResults{rpa1, err}
+	mmGetReadReceipts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReadReceipts.mock
+}
+
+// Set uses given function f to mock the ChatService.GetReadReceipts method
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) Set(f func(ctx context.Context, messageID int64) (rpa1 []*model.ReadReceipt, err error)) *ChatServiceMock {
+	if mmGetReadReceipts.defaultExpectation != nil {
+		mmGetReadReceipts.mock.t.Fatalf("Default expectation is already set for the ChatService.GetReadReceipts method")
+	}
+
+	if len(mmGetReadReceipts.expectations) > 0 {
+		mmGetReadReceipts.mock.t.Fatalf("Some expectations are already set for the ChatService.GetReadReceipts method")
+	}
+
+	mmGetReadReceipts.mock.funcGetReadReceipts = f
+	mmGetReadReceipts.mock.funcGetReadReceiptsOrigin = minimock.CallerInfo(1)
+	return mmGetReadReceipts.mock
+}
+
+// When sets expectation for the ChatService.GetReadReceipts which will trigger the result defined by the following
+// Then helper
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) When(ctx context.Context, messageID int64) *ChatServiceMockGetReadReceiptsExpectation {
+	if mmGetReadReceipts.mock.funcGetReadReceipts != nil {
+		mmGetReadReceipts.mock.t.Fatalf("ChatServiceMock.GetReadReceipts mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetReadReceiptsExpectation{
+		mock:               mmGetReadReceipts.mock,
+		params:             &ChatServiceMockGetReadReceiptsParams{ctx, messageID},
+		expectationOrigins: ChatServiceMockGetReadReceiptsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReadReceipts.expectations = append(mmGetReadReceipts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetReadReceipts return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetReadReceiptsExpectation) Then(rpa1 []*model.ReadReceipt, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetReadReceiptsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetReadReceipts should be invoked
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) Times(n uint64) *mChatServiceMockGetReadReceipts {
+	if n == 0 {
+		mmGetReadReceipts.mock.t.Fatalf("Times of ChatServiceMock.GetReadReceipts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReadReceipts.expectedInvocations, n)
+	mmGetReadReceipts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReadReceipts
+}
+
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) invocationsDone() bool {
+	if len(mmGetReadReceipts.expectations) == 0 && mmGetReadReceipts.defaultExpectation == nil && mmGetReadReceipts.mock.funcGetReadReceipts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReadReceipts.mock.afterGetReadReceiptsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReadReceipts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReadReceipts implements mm_service.ChatService
+func (mmGetReadReceipts *ChatServiceMock) GetReadReceipts(ctx context.Context, messageID int64) (rpa1 []*model.ReadReceipt, err error) {
+	mm_atomic.AddUint64(&mmGetReadReceipts.beforeGetReadReceiptsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReadReceipts.afterGetReadReceiptsCounter, 1)
+
+	mmGetReadReceipts.t.Helper()
+
+	if mmGetReadReceipts.inspectFuncGetReadReceipts != nil {
+		mmGetReadReceipts.inspectFuncGetReadReceipts(ctx, messageID)
+	}
+
+	mm_params := ChatServiceMockGetReadReceiptsParams{ctx, messageID}
+
+	// Record call args
+	mmGetReadReceipts.GetReadReceiptsMock.mutex.Lock()
+	mmGetReadReceipts.GetReadReceiptsMock.callArgs = append(mmGetReadReceipts.GetReadReceiptsMock.callArgs, &mm_params)
+	mmGetReadReceipts.GetReadReceiptsMock.mutex.Unlock()
+
+	for _, e := range mmGetReadReceipts.GetReadReceiptsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetReadReceiptsParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReadReceipts.t.Errorf("ChatServiceMock.GetReadReceipts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmGetReadReceipts.t.Errorf("ChatServiceMock.GetReadReceipts got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReadReceipts.t.Errorf("ChatServiceMock.GetReadReceipts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReadReceipts.GetReadReceiptsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReadReceipts.t.Fatal("No results are set for the ChatServiceMock.GetReadReceipts")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetReadReceipts.funcGetReadReceipts != nil {
+		return mmGetReadReceipts.funcGetReadReceipts(ctx, messageID)
+	}
+	mmGetReadReceipts.t.Fatalf("Unexpected call to ChatServiceMock.GetReadReceipts. %v %v", ctx, messageID)
+	return
+}
+
+// GetReadReceiptsAfterCounter returns a count of finished ChatServiceMock.GetReadReceipts invocations
+func (mmGetReadReceipts *ChatServiceMock) GetReadReceiptsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadReceipts.afterGetReadReceiptsCounter)
+}
+
+// GetReadReceiptsBeforeCounter returns a count of ChatServiceMock.GetReadReceipts invocations
+func (mmGetReadReceipts *ChatServiceMock) GetReadReceiptsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadReceipts.beforeGetReadReceiptsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetReadReceipts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReadReceipts *mChatServiceMockGetReadReceipts) Calls() []*ChatServiceMockGetReadReceiptsParams {
+	mmGetReadReceipts.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetReadReceiptsParams, len(mmGetReadReceipts.callArgs))
+	copy(argCopy, mmGetReadReceipts.callArgs)
+
+	mmGetReadReceipts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReadReceiptsDone returns true if the count of the GetReadReceipts invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetReadReceiptsDone() bool {
+	if m.GetReadReceiptsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReadReceiptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReadReceiptsMock.invocationsDone()
+}
+
+// MinimockGetReadReceiptsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetReadReceiptsInspect() {
+	for _, e := range m.GetReadReceiptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadReceipts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReadReceiptsCounter := mm_atomic.LoadUint64(&m.afterGetReadReceiptsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReadReceiptsMock.defaultExpectation != nil && afterGetReadReceiptsCounter < 1 {
+		if m.GetReadReceiptsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadReceipts at\n%s", m.GetReadReceiptsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadReceipts at\n%s with params: %#v", m.GetReadReceiptsMock.defaultExpectation.expectationOrigins.origin, *m.GetReadReceiptsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReadReceipts != nil && afterGetReadReceiptsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetReadReceipts at\n%s", m.funcGetReadReceiptsOrigin)
+	}
+
+	if !m.GetReadReceiptsMock.invocationsDone() && afterGetReadReceiptsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetReadReceipts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReadReceiptsMock.expectedInvocations), m.GetReadReceiptsMock.expectedInvocationsOrigin, afterGetReadReceiptsCounter)
+	}
+}
+
+type mChatServiceMockLeaveChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockLeaveChatExpectation
+	expectations       []*ChatServiceMockLeaveChatExpectation
+
+	callArgs []*ChatServiceMockLeaveChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockLeaveChatExpectation specifies expectation struct of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockLeaveChatParams
+	paramPtrs          *ChatServiceMockLeaveChatParamPtrs
+	expectationOrigins ChatServiceMockLeaveChatExpectationOrigins
+	results            *ChatServiceMockLeaveChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockLeaveChatParams contains parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParams struct {
+	ctx   context.Context
+	leave *model.ChatLeave
+}
+
+// ChatServiceMockLeaveChatParamPtrs contains pointers to parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParamPtrs struct {
+	ctx   *context.Context
+	leave **model.ChatLeave
+}
+
+// ChatServiceMockLeaveChatResults contains results of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatResults struct {
+	err error
+}
+
+// ChatServiceMockLeaveChatOrigins contains origins of expectations of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLeave string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLeaveChat *mChatServiceMockLeaveChat) Optional() *mChatServiceMockLeaveChat {
+	mmLeaveChat.optional = true
+	return mmLeaveChat
+}
+
+// Expect sets up expected params for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Expect(ctx context.Context, leave *model.ChatLeave) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by ExpectParams functions")
+	}
+
+	mmLeaveChat.defaultExpectation.params = &ChatServiceMockLeaveChatParams{ctx, leave}
+	mmLeaveChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLeaveChat.expectations {
+		if minimock.Equal(e.params, mmLeaveChat.defaultExpectation.params) {
+			mmLeaveChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeaveChat.defaultExpectation.params)
+		}
+	}
+
+	return mmLeaveChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLeaveChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// ExpectLeaveParam2 sets up expected param leave for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectLeaveParam2(leave *model.ChatLeave) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.leave = &leave
+	mmLeaveChat.defaultExpectation.expectationOrigins.originLeave = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Inspect(f func(ctx context.Context, leave *model.ChatLeave)) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.inspectFuncLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.LeaveChat")
+	}
+
+	mmLeaveChat.mock.inspectFuncLeaveChat = f
+
+	return mmLeaveChat
+}
+
+// Return sets up results that will be returned by ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Return(err error) *ChatServiceMock {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{mock: mmLeaveChat.mock}
+	}
+	mmLeaveChat.defaultExpectation.results = &ChatServiceMockLeaveChatResults{err}
+	mmLeaveChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat.mock
+}
+
+// Set uses given function f to mock the ChatService.LeaveChat method
+func (mmLeaveChat *mChatServiceMockLeaveChat) Set(f func(ctx context.Context, leave *model.ChatLeave) (err error)) *ChatServiceMock {
+	if mmLeaveChat.defaultExpectation != nil {
+		mmLeaveChat.mock.t.Fatalf("Default expectation is already set for the ChatService.LeaveChat method")
+	}
+
+	if len(mmLeaveChat.expectations) > 0 {
+		mmLeaveChat.mock.t.Fatalf("Some expectations are already set for the ChatService.LeaveChat method")
+	}
+
+	mmLeaveChat.mock.funcLeaveChat = f
+	mmLeaveChat.mock.funcLeaveChatOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat.mock
+}
+
+// When sets expectation for the ChatService.LeaveChat which will trigger the result defined by the following
+// Then helper
+func (mmLeaveChat *mChatServiceMockLeaveChat) When(ctx context.Context, leave *model.ChatLeave) *ChatServiceMockLeaveChatExpectation {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockLeaveChatExpectation{
+		mock:               mmLeaveChat.mock,
+		params:             &ChatServiceMockLeaveChatParams{ctx, leave},
+		expectationOrigins: ChatServiceMockLeaveChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLeaveChat.expectations = append(mmLeaveChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.LeaveChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockLeaveChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockLeaveChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.LeaveChat should be invoked
+func (mmLeaveChat *mChatServiceMockLeaveChat) Times(n uint64) *mChatServiceMockLeaveChat {
+	if n == 0 {
+		mmLeaveChat.mock.t.Fatalf("Times of ChatServiceMock.LeaveChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLeaveChat.expectedInvocations, n)
+	mmLeaveChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat
+}
+
+func (mmLeaveChat *mChatServiceMockLeaveChat) invocationsDone() bool {
+	if len(mmLeaveChat.expectations) == 0 && mmLeaveChat.defaultExpectation == nil && mmLeaveChat.mock.funcLeaveChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLeaveChat.mock.afterLeaveChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLeaveChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LeaveChat implements mm_service.ChatService
+func (mmLeaveChat *ChatServiceMock) LeaveChat(ctx context.Context, leave *model.ChatLeave) (err error) {
+	mm_atomic.AddUint64(&mmLeaveChat.beforeLeaveChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeaveChat.afterLeaveChatCounter, 1)
+
+	mmLeaveChat.t.Helper()
+
+	if mmLeaveChat.inspectFuncLeaveChat != nil {
+		mmLeaveChat.inspectFuncLeaveChat(ctx, leave)
+	}
+
+	mm_params := ChatServiceMockLeaveChatParams{ctx, leave}
+
+	// Record call args
+	mmLeaveChat.LeaveChatMock.mutex.Lock()
+	mmLeaveChat.LeaveChatMock.callArgs = append(mmLeaveChat.LeaveChatMock.callArgs, &mm_params)
+	mmLeaveChat.LeaveChatMock.mutex.Unlock()
+
+	for _, e := range mmLeaveChat.LeaveChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLeaveChat.LeaveChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeaveChat.LeaveChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeaveChat.LeaveChatMock.defaultExpectation.params
+		mm_want_ptrs := mmLeaveChat.LeaveChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockLeaveChatParams{ctx, leave}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.leave != nil && !minimock.Equal(*mm_want_ptrs.leave, mm_got.leave) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter leave, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originLeave, *mm_want_ptrs.leave, mm_got.leave, minimock.Diff(*mm_want_ptrs.leave, mm_got.leave))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLeaveChat.LeaveChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeaveChat.t.Fatal("No results are set for the ChatServiceMock.LeaveChat")
+		}
+		return (*mm_results).err
+	}
+	if mmLeaveChat.funcLeaveChat != nil {
+		return mmLeaveChat.funcLeaveChat(ctx, leave)
+	}
+	mmLeaveChat.t.Fatalf("Unexpected call to ChatServiceMock.LeaveChat. %v %v", ctx, leave)
+	return
+}
+
+// LeaveChatAfterCounter returns a count of finished ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.afterLeaveChatCounter)
+}
+
+// LeaveChatBeforeCounter returns a count of ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.beforeLeaveChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.LeaveChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeaveChat *mChatServiceMockLeaveChat) Calls() []*ChatServiceMockLeaveChatParams {
+	mmLeaveChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockLeaveChatParams, len(mmLeaveChat.callArgs))
+	copy(argCopy, mmLeaveChat.callArgs)
+
+	mmLeaveChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveChatDone returns true if the count of the LeaveChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockLeaveChatDone() bool {
+	if m.LeaveChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LeaveChatMock.invocationsDone()
+}
+
+// MinimockLeaveChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockLeaveChatInspect() {
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLeaveChatCounter := mm_atomic.LoadUint64(&m.afterLeaveChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveChatMock.defaultExpectation != nil && afterLeaveChatCounter < 1 {
+		if m.LeaveChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s", m.LeaveChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s with params: %#v", m.LeaveChatMock.defaultExpectation.expectationOrigins.origin, *m.LeaveChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveChat != nil && afterLeaveChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s", m.funcLeaveChatOrigin)
+	}
+
+	if !m.LeaveChatMock.invocationsDone() && afterLeaveChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.LeaveChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LeaveChatMock.expectedInvocations), m.LeaveChatMock.expectedInvocationsOrigin, afterLeaveChatCounter)
+	}
+}
+
+type mChatServiceMockListChats struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListChatsExpectation
+	expectations       []*ChatServiceMockListChatsExpectation
+
+	callArgs []*ChatServiceMockListChatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListChatsExpectation specifies expectation struct of the ChatService.ListChats
+type ChatServiceMockListChatsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListChatsParams
+	paramPtrs          *ChatServiceMockListChatsParamPtrs
+	expectationOrigins ChatServiceMockListChatsExpectationOrigins
+	results            *ChatServiceMockListChatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListChatsParams contains parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParams struct {
+	ctx    context.Context
+	filter *model.ChatListFilter
+}
+
+// ChatServiceMockListChatsParamPtrs contains pointers to parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParamPtrs struct {
+	ctx    *context.Context
+	filter **model.ChatListFilter
+}
+
+// ChatServiceMockListChatsResults contains results of the ChatService.ListChats
+type ChatServiceMockListChatsResults struct {
+	cp1 *model.ChatList
+	err error
+}
+
+// ChatServiceMockListChatsOrigins contains origins of expectations of the ChatService.ListChats
+type ChatServiceMockListChatsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChats *mChatServiceMockListChats) Optional() *mChatServiceMockListChats {
+	mmListChats.optional = true
+	return mmListChats
+}
+
+// Expect sets up expected params for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Expect(ctx context.Context, filter *model.ChatListFilter) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by ExpectParams functions")
+	}
+
+	mmListChats.defaultExpectation.params = &ChatServiceMockListChatsParams{ctx, filter}
+	mmListChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChats.expectations {
+		if minimock.Equal(e.params, mmListChats.defaultExpectation.params) {
+			mmListChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChats.defaultExpectation.params)
+		}
+	}
+
+	return mmListChats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChats
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectFilterParam2(filter *model.ChatListFilter) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.filter = &filter
+	mmListChats.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListChats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Inspect(f func(ctx context.Context, filter *model.ChatListFilter)) *mChatServiceMockListChats {
+	if mmListChats.mock.inspectFuncListChats != nil {
+		mmListChats.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListChats")
+	}
+
+	mmListChats.mock.inspectFuncListChats = f
+
+	return mmListChats
+}
+
+// Return sets up results that will be returned by ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Return(cp1 *model.ChatList, err error) *ChatServiceMock {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{mock: mmListChats.mock}
+	}
+	mmListChats.defaultExpectation.results = &ChatServiceMockListChatsResults{cp1, err}
+	mmListChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChats.mock
+}
+
+// Set uses given function f to mock the ChatService.ListChats method
+func (mmListChats *mChatServiceMockListChats) Set(f func(ctx context.Context, filter *model.ChatListFilter) (cp1 *model.ChatList, err error)) *ChatServiceMock {
+	if mmListChats.defaultExpectation != nil {
+		mmListChats.mock.t.Fatalf("Default expectation is already set for the ChatService.ListChats method")
+	}
+
+	if len(mmListChats.expectations) > 0 {
+		mmListChats.mock.t.Fatalf("Some expectations are already set for the ChatService.ListChats method")
+	}
+
+	mmListChats.mock.funcListChats = f
+	mmListChats.mock.funcListChatsOrigin = minimock.CallerInfo(1)
+	return mmListChats.mock
+}
+
+// When sets expectation for the ChatService.ListChats which will trigger the result defined by the following
+// Then helper
+func (mmListChats *mChatServiceMockListChats) When(ctx context.Context, filter *model.ChatListFilter) *ChatServiceMockListChatsExpectation {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListChatsExpectation{
+		mock:               mmListChats.mock,
+		params:             &ChatServiceMockListChatsParams{ctx, filter},
+		expectationOrigins: ChatServiceMockListChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChats.expectations = append(mmListChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListChats return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListChatsExpectation) Then(cp1 *model.ChatList, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListChatsResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListChats should be invoked
+func (mmListChats *mChatServiceMockListChats) Times(n uint64) *mChatServiceMockListChats {
+	if n == 0 {
+		mmListChats.mock.t.Fatalf("Times of ChatServiceMock.ListChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChats.expectedInvocations, n)
+	mmListChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChats
+}
+
+func (mmListChats *mChatServiceMockListChats) invocationsDone() bool {
+	if len(mmListChats.expectations) == 0 && mmListChats.defaultExpectation == nil && mmListChats.mock.funcListChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChats.mock.afterListChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChats implements mm_service.ChatService
+func (mmListChats *ChatServiceMock) ListChats(ctx context.Context, filter *model.ChatListFilter) (cp1 *model.ChatList, err error) {
+	mm_atomic.AddUint64(&mmListChats.beforeListChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChats.afterListChatsCounter, 1)
+
+	mmListChats.t.Helper()
+
+	if mmListChats.inspectFuncListChats != nil {
+		mmListChats.inspectFuncListChats(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockListChatsParams{ctx, filter}
+
+	// Record call args
+	mmListChats.ListChatsMock.mutex.Lock()
+	mmListChats.ListChatsMock.callArgs = append(mmListChats.ListChatsMock.callArgs, &mm_params)
+	mmListChats.ListChatsMock.mutex.Unlock()
+
+	for _, e := range mmListChats.ListChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmListChats.ListChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChats.ListChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChats.ListChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmListChats.ListChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListChatsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChats.ListChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChats.t.Fatal("No results are set for the ChatServiceMock.ListChats")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmListChats.funcListChats != nil {
+		return mmListChats.funcListChats(ctx, filter)
+	}
+	mmListChats.t.Fatalf("Unexpected call to ChatServiceMock.ListChats. %v %v", ctx, filter)
+	return
+}
+
+// ListChatsAfterCounter returns a count of finished ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.afterListChatsCounter)
+}
+
+// ListChatsBeforeCounter returns a count of ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.beforeListChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChats *mChatServiceMockListChats) Calls() []*ChatServiceMockListChatsParams {
+	mmListChats.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListChatsParams, len(mmListChats.callArgs))
+	copy(argCopy, mmListChats.callArgs)
+
+	mmListChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChatsDone returns true if the count of the ListChats invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListChatsDone() bool {
+	if m.ListChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChatsMock.invocationsDone()
+}
+
+// MinimockListChatsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListChatsInspect() {
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChatsCounter := mm_atomic.LoadUint64(&m.afterListChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChatsMock.defaultExpectation != nil && afterListChatsCounter < 1 {
+		if m.ListChatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s", m.ListChatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s with params: %#v", m.ListChatsMock.defaultExpectation.expectationOrigins.origin, *m.ListChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChats != nil && afterListChatsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s", m.funcListChatsOrigin)
+	}
+
+	if !m.ListChatsMock.invocationsDone() && afterListChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListChats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatsMock.expectedInvocations), m.ListChatsMock.expectedInvocationsOrigin, afterListChatsCounter)
+	}
+}
+
+type mChatServiceMockListMembers struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMembersExpectation
+	expectations       []*ChatServiceMockListMembersExpectation
+
+	callArgs []*ChatServiceMockListMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListMembersExpectation specifies expectation struct of the ChatService.ListMembers
+type ChatServiceMockListMembersExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListMembersParams
+	paramPtrs          *ChatServiceMockListMembersParamPtrs
+	expectationOrigins ChatServiceMockListMembersExpectationOrigins
+	results            *ChatServiceMockListMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListMembersParams contains parameters of the ChatService.ListMembers
+type ChatServiceMockListMembersParams struct {
+	ctx    context.Context
+	filter *model.MemberListFilter
+}
+
+// ChatServiceMockListMembersParamPtrs contains pointers to parameters of the ChatService.ListMembers
+type ChatServiceMockListMembersParamPtrs struct {
+	ctx    *context.Context
+	filter **model.MemberListFilter
+}
+
+// ChatServiceMockListMembersResults contains results of the ChatService.ListMembers
+type ChatServiceMockListMembersResults struct {
+	mp1 *model.MemberList
+	err error
+}
+
+// ChatServiceMockListMembersOrigins contains origins of expectations of the ChatService.ListMembers
+type ChatServiceMockListMembersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMembers *mChatServiceMockListMembers) Optional() *mChatServiceMockListMembers {
+	mmListMembers.optional = true
+	return mmListMembers
+}
+
+// Expect sets up expected params for ChatService.ListMembers
+func (mmListMembers *mChatServiceMockListMembers) Expect(ctx context.Context, filter *model.MemberListFilter) *mChatServiceMockListMembers {
+	if mmListMembers.mock.funcListMembers != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by Set")
+	}
+
+	if mmListMembers.defaultExpectation == nil {
+		mmListMembers.defaultExpectation = &ChatServiceMockListMembersExpectation{}
+	}
+
+	if mmListMembers.defaultExpectation.paramPtrs != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by ExpectParams functions")
+	}
+
+	mmListMembers.defaultExpectation.params = &ChatServiceMockListMembersParams{ctx, filter}
+	mmListMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMembers.expectations {
+		if minimock.Equal(e.params, mmListMembers.defaultExpectation.params) {
+			mmListMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmListMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMembers
+func (mmListMembers *mChatServiceMockListMembers) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMembers {
+	if mmListMembers.mock.funcListMembers != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by Set")
+	}
+
+	if mmListMembers.defaultExpectation == nil {
+		mmListMembers.defaultExpectation = &ChatServiceMockListMembersExpectation{}
+	}
+
+	if mmListMembers.defaultExpectation.params != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by Expect")
+	}
+
+	if mmListMembers.defaultExpectation.paramPtrs == nil {
+		mmListMembers.defaultExpectation.paramPtrs = &ChatServiceMockListMembersParamPtrs{}
+	}
+	mmListMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMembers
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.ListMembers
+func (mmListMembers *mChatServiceMockListMembers) ExpectFilterParam2(filter *model.MemberListFilter) *mChatServiceMockListMembers {
+	if mmListMembers.mock.funcListMembers != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by Set")
+	}
+
+	if mmListMembers.defaultExpectation == nil {
+		mmListMembers.defaultExpectation = &ChatServiceMockListMembersExpectation{}
+	}
+
+	if mmListMembers.defaultExpectation.params != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by Expect")
+	}
+
+	if mmListMembers.defaultExpectation.paramPtrs == nil {
+		mmListMembers.defaultExpectation.paramPtrs = &ChatServiceMockListMembersParamPtrs{}
+	}
+	mmListMembers.defaultExpectation.paramPtrs.filter = &filter
+	mmListMembers.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMembers
+func (mmListMembers *mChatServiceMockListMembers) Inspect(f func(ctx context.Context, filter *model.MemberListFilter)) *mChatServiceMockListMembers {
+	if mmListMembers.mock.inspectFuncListMembers != nil {
+		mmListMembers.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMembers")
+	}
+
+	mmListMembers.mock.inspectFuncListMembers = f
+
+	return mmListMembers
+}
+
+// Return sets up results that will be returned by ChatService.ListMembers
+func (mmListMembers *mChatServiceMockListMembers) Return(mp1 *model.MemberList, err error) *ChatServiceMock {
+	if mmListMembers.mock.funcListMembers != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by Set")
+	}
+
+	if mmListMembers.defaultExpectation == nil {
+		mmListMembers.defaultExpectation = &ChatServiceMockListMembersExpectation{mock: mmListMembers.mock}
+	}
+	mmListMembers.defaultExpectation.results = &ChatServiceMockListMembersResults{mp1, err}
+	mmListMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMembers.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMembers method
+func (mmListMembers *mChatServiceMockListMembers) Set(f func(ctx context.Context, filter *model.MemberListFilter) (mp1 *model.MemberList, err error)) *ChatServiceMock {
+	if mmListMembers.defaultExpectation != nil {
+		mmListMembers.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMembers method")
+	}
+
+	if len(mmListMembers.expectations) > 0 {
+		mmListMembers.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMembers method")
+	}
+
+	mmListMembers.mock.funcListMembers = f
+	mmListMembers.mock.funcListMembersOrigin = minimock.CallerInfo(1)
+	return mmListMembers.mock
+}
+
+// When sets expectation for the ChatService.ListMembers which will trigger the result defined by the following
+// Then helper
+func (mmListMembers *mChatServiceMockListMembers) When(ctx context.Context, filter *model.MemberListFilter) *ChatServiceMockListMembersExpectation {
+	if mmListMembers.mock.funcListMembers != nil {
+		mmListMembers.mock.t.Fatalf("ChatServiceMock.ListMembers mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMembersExpectation{
+		mock:               mmListMembers.mock,
+		params:             &ChatServiceMockListMembersParams{ctx, filter},
+		expectationOrigins: ChatServiceMockListMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMembers.expectations = append(mmListMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMembers return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMembersExpectation) Then(mp1 *model.MemberList, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMembersResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMembers should be invoked
+func (mmListMembers *mChatServiceMockListMembers) Times(n uint64) *mChatServiceMockListMembers {
+	if n == 0 {
+		mmListMembers.mock.t.Fatalf("Times of ChatServiceMock.ListMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMembers.expectedInvocations, n)
+	mmListMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMembers
+}
+
+func (mmListMembers *mChatServiceMockListMembers) invocationsDone() bool {
+	if len(mmListMembers.expectations) == 0 && mmListMembers.defaultExpectation == nil && mmListMembers.mock.funcListMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMembers.mock.afterListMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMembers implements mm_service.ChatService
+func (mmListMembers *ChatServiceMock) ListMembers(ctx context.Context, filter *model.MemberListFilter) (mp1 *model.MemberList, err error) {
+	mm_atomic.AddUint64(&mmListMembers.beforeListMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMembers.afterListMembersCounter, 1)
+
+	mmListMembers.t.Helper()
+
+	if mmListMembers.inspectFuncListMembers != nil {
+		mmListMembers.inspectFuncListMembers(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockListMembersParams{ctx, filter}
+
+	// Record call args
+	mmListMembers.ListMembersMock.mutex.Lock()
+	mmListMembers.ListMembersMock.callArgs = append(mmListMembers.ListMembersMock.callArgs, &mm_params)
+	mmListMembers.ListMembersMock.mutex.Unlock()
+
+	for _, e := range mmListMembers.ListMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmListMembers.ListMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMembers.ListMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMembers.ListMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmListMembers.ListMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMembersParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMembers.t.Errorf("ChatServiceMock.ListMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMembers.ListMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListMembers.t.Errorf("ChatServiceMock.ListMembers got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMembers.ListMembersMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMembers.t.Errorf("ChatServiceMock.ListMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMembers.ListMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMembers.ListMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMembers.t.Fatal("No results are set for the ChatServiceMock.ListMembers")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmListMembers.funcListMembers != nil {
+		return mmListMembers.funcListMembers(ctx, filter)
+	}
+	mmListMembers.t.Fatalf("Unexpected call to ChatServiceMock.ListMembers. %v %v", ctx, filter)
+	return
+}
+
+// ListMembersAfterCounter returns a count of finished ChatServiceMock.ListMembers invocations
+func (mmListMembers *ChatServiceMock) ListMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMembers.afterListMembersCounter)
+}
+
+// ListMembersBeforeCounter returns a count of ChatServiceMock.ListMembers invocations
+func (mmListMembers *ChatServiceMock) ListMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMembers.beforeListMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMembers *mChatServiceMockListMembers) Calls() []*ChatServiceMockListMembersParams {
+	mmListMembers.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMembersParams, len(mmListMembers.callArgs))
+	copy(argCopy, mmListMembers.callArgs)
+
+	mmListMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMembersDone returns true if the count of the ListMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMembersDone() bool {
+	if m.ListMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMembersMock.invocationsDone()
+}
+
+// MinimockListMembersInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMembersInspect() {
+	for _, e := range m.ListMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMembersCounter := mm_atomic.LoadUint64(&m.afterListMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMembersMock.defaultExpectation != nil && afterListMembersCounter < 1 {
+		if m.ListMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMembers at\n%s", m.ListMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMembers at\n%s with params: %#v", m.ListMembersMock.defaultExpectation.expectationOrigins.origin, *m.ListMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMembers != nil && afterListMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListMembers at\n%s", m.funcListMembersOrigin)
+	}
+
+	if !m.ListMembersMock.invocationsDone() && afterListMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMembersMock.expectedInvocations), m.ListMembersMock.expectedInvocationsOrigin, afterListMembersCounter)
+	}
+}
+
+type mChatServiceMockListMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMessagesExpectation
+	expectations       []*ChatServiceMockListMessagesExpectation
+
+	callArgs []*ChatServiceMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListMessagesExpectation specifies expectation struct of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListMessagesParams
+	paramPtrs          *ChatServiceMockListMessagesParamPtrs
+	expectationOrigins ChatServiceMockListMessagesExpectationOrigins
+	results            *ChatServiceMockListMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListMessagesParams contains parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParams struct {
+	ctx    context.Context
+	filter *model.MessageListFilter
+}
+
+// ChatServiceMockListMessagesParamPtrs contains pointers to parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParamPtrs struct {
+	ctx    *context.Context
+	filter **model.MessageListFilter
+}
+
+// ChatServiceMockListMessagesResults contains results of the ChatService.ListMessages
+type ChatServiceMockListMessagesResults struct {
+	mp1 *model.MessageList
+	err error
+}
+
+// ChatServiceMockListMessagesOrigins contains origins of expectations of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mChatServiceMockListMessages) Optional() *mChatServiceMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Expect(ctx context.Context, filter *model.MessageListFilter) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &ChatServiceMockListMessagesParams{ctx, filter}
+	mmListMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectFilterParam2(filter *model.MessageListFilter) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.filter = &filter
+	mmListMessages.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Inspect(f func(ctx context.Context, filter *model.MessageListFilter)) *mChatServiceMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Return(mp1 *model.MessageList, err error) *ChatServiceMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &ChatServiceMockListMessagesResults{mp1, err}
+	mmListMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMessages method
+func (mmListMessages *mChatServiceMockListMessages) Set(f func(ctx context.Context, filter *model.MessageListFilter) (mp1 *model.MessageList, err error)) *ChatServiceMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	mmListMessages.mock.funcListMessagesOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// When sets expectation for the ChatService.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mChatServiceMockListMessages) When(ctx context.Context, filter *model.MessageListFilter) *ChatServiceMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMessagesExpectation{
+		mock:               mmListMessages.mock,
+		params:             &ChatServiceMockListMessagesParams{ctx, filter},
+		expectationOrigins: ChatServiceMockListMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMessagesExpectation) Then(mp1 *model.MessageList, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMessagesResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMessages should be invoked
+func (mmListMessages *mChatServiceMockListMessages) Times(n uint64) *mChatServiceMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of ChatServiceMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	mmListMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessages
+}
+
+func (mmListMessages *mChatServiceMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements mm_service.ChatService
+func (mmListMessages *ChatServiceMock) ListMessages(ctx context.Context, filter *model.MessageListFilter) (mp1 *model.MessageList, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	mmListMessages.t.Helper()
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockListMessagesParams{ctx, filter}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMessagesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the ChatServiceMock.ListMessages")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, filter)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListMessages. %v %v", ctx, filter)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mChatServiceMockListMessages) Calls() []*ChatServiceMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s", m.ListMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s with params: %#v", m.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s", m.funcListMessagesOrigin)
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), m.ListMessagesMock.expectedInvocationsOrigin, afterListMessagesCounter)
+	}
+}
+
+type mChatServiceMockListThread struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListThreadExpectation
+	expectations       []*ChatServiceMockListThreadExpectation
+
+	callArgs []*ChatServiceMockListThreadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListThreadExpectation specifies expectation struct of the ChatService.ListThread
+type ChatServiceMockListThreadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListThreadParams
+	paramPtrs          *ChatServiceMockListThreadParamPtrs
+	expectationOrigins ChatServiceMockListThreadExpectationOrigins
+	results            *ChatServiceMockListThreadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListThreadParams contains parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParams struct {
+	ctx    context.Context
+	filter *model.ThreadFilter
+}
+
+// ChatServiceMockListThreadParamPtrs contains pointers to parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParamPtrs struct {
+	ctx    *context.Context
+	filter **model.ThreadFilter
+}
+
+// ChatServiceMockListThreadResults contains results of the ChatService.ListThread
+type ChatServiceMockListThreadResults struct {
+	tp1 *model.Thread
+	err error
+}
+
+// ChatServiceMockListThreadOrigins contains origins of expectations of the ChatService.ListThread
+type ChatServiceMockListThreadExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListThread *mChatServiceMockListThread) Optional() *mChatServiceMockListThread {
+	mmListThread.optional = true
+	return mmListThread
+}
+
+// Expect sets up expected params for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Expect(ctx context.Context, filter *model.ThreadFilter) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by ExpectParams functions")
+	}
+
+	mmListThread.defaultExpectation.params = &ChatServiceMockListThreadParams{ctx, filter}
+	mmListThread.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListThread.expectations {
+		if minimock.Equal(e.params, mmListThread.defaultExpectation.params) {
+			mmListThread.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListThread.defaultExpectation.params)
+		}
+	}
+
+	return mmListThread
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListThread.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListThread
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectFilterParam2(filter *model.ThreadFilter) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.filter = &filter
+	mmListThread.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListThread
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Inspect(f func(ctx context.Context, filter *model.ThreadFilter)) *mChatServiceMockListThread {
+	if mmListThread.mock.inspectFuncListThread != nil {
+		mmListThread.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListThread")
+	}
+
+	mmListThread.mock.inspectFuncListThread = f
+
+	return mmListThread
+}
+
+// Return sets up results that will be returned by ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Return(tp1 *model.Thread, err error) *ChatServiceMock {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{mock: mmListThread.mock}
+	}
+	mmListThread.defaultExpectation.results = &ChatServiceMockListThreadResults{tp1, err}
+	mmListThread.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListThread.mock
+}
+
+// Set uses given function f to mock the ChatService.ListThread method
+func (mmListThread *mChatServiceMockListThread) Set(f func(ctx context.Context, filter *model.ThreadFilter) (tp1 *model.Thread, err error)) *ChatServiceMock {
+	if mmListThread.defaultExpectation != nil {
+		mmListThread.mock.t.Fatalf("Default expectation is already set for the ChatService.ListThread method")
+	}
+
+	if len(mmListThread.expectations) > 0 {
+		mmListThread.mock.t.Fatalf("Some expectations are already set for the ChatService.ListThread method")
+	}
+
+	mmListThread.mock.funcListThread = f
+	mmListThread.mock.funcListThreadOrigin = minimock.CallerInfo(1)
+	return mmListThread.mock
+}
+
+// When sets expectation for the ChatService.ListThread which will trigger the result defined by the following
+// Then helper
+func (mmListThread *mChatServiceMockListThread) When(ctx context.Context, filter *model.ThreadFilter) *ChatServiceMockListThreadExpectation {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListThreadExpectation{
+		mock:               mmListThread.mock,
+		params:             &ChatServiceMockListThreadParams{ctx, filter},
+		expectationOrigins: ChatServiceMockListThreadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListThread.expectations = append(mmListThread.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListThread return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListThreadExpectation) Then(tp1 *model.Thread, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListThreadResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListThread should be invoked
+func (mmListThread *mChatServiceMockListThread) Times(n uint64) *mChatServiceMockListThread {
+	if n == 0 {
+		mmListThread.mock.t.Fatalf("Times of ChatServiceMock.ListThread mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListThread.expectedInvocations, n)
+	mmListThread.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListThread
+}
+
+func (mmListThread *mChatServiceMockListThread) invocationsDone() bool {
+	if len(mmListThread.expectations) == 0 && mmListThread.defaultExpectation == nil && mmListThread.mock.funcListThread == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListThread.mock.afterListThreadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListThread.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListThread implements mm_service.ChatService
+func (mmListThread *ChatServiceMock) ListThread(ctx context.Context, filter *model.ThreadFilter) (tp1 *model.Thread, err error) {
+	mm_atomic.AddUint64(&mmListThread.beforeListThreadCounter, 1)
+	defer mm_atomic.AddUint64(&mmListThread.afterListThreadCounter, 1)
+
+	mmListThread.t.Helper()
+
+	if mmListThread.inspectFuncListThread != nil {
+		mmListThread.inspectFuncListThread(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockListThreadParams{ctx, filter}
+
+	// Record call args
+	mmListThread.ListThreadMock.mutex.Lock()
+	mmListThread.ListThreadMock.callArgs = append(mmListThread.ListThreadMock.callArgs, &mm_params)
+	mmListThread.ListThreadMock.mutex.Unlock()
+
+	for _, e := range mmListThread.ListThreadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmListThread.ListThreadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListThread.ListThreadMock.defaultExpectation.Counter, 1)
+		mm_want := mmListThread.ListThreadMock.defaultExpectation.params
+		mm_want_ptrs := mmListThread.ListThreadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListThreadParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListThread.ListThreadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListThread.t.Fatal("No results are set for the ChatServiceMock.ListThread")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmListThread.funcListThread != nil {
+		return mmListThread.funcListThread(ctx, filter)
+	}
+	mmListThread.t.Fatalf("Unexpected call to ChatServiceMock.ListThread. %v %v", ctx, filter)
+	return
+}
+
+// ListThreadAfterCounter returns a count of finished ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.afterListThreadCounter)
+}
+
+// ListThreadBeforeCounter returns a count of ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.beforeListThreadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListThread.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListThread *mChatServiceMockListThread) Calls() []*ChatServiceMockListThreadParams {
+	mmListThread.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListThreadParams, len(mmListThread.callArgs))
+	copy(argCopy, mmListThread.callArgs)
+
+	mmListThread.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListThreadDone returns true if the count of the ListThread invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListThreadDone() bool {
+	if m.ListThreadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListThreadMock.invocationsDone()
+}
+
+// MinimockListThreadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListThreadInspect() {
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListThreadCounter := mm_atomic.LoadUint64(&m.afterListThreadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListThreadMock.defaultExpectation != nil && afterListThreadCounter < 1 {
+		if m.ListThreadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s", m.ListThreadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s with params: %#v", m.ListThreadMock.defaultExpectation.expectationOrigins.origin, *m.ListThreadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListThread != nil && afterListThreadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s", m.funcListThreadOrigin)
+	}
+
+	if !m.ListThreadMock.invocationsDone() && afterListThreadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListThread at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListThreadMock.expectedInvocations), m.ListThreadMock.expectedInvocationsOrigin, afterListThreadCounter)
+	}
+}
+
+type mChatServiceMockMarkRead struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockMarkReadExpectation
+	expectations       []*ChatServiceMockMarkReadExpectation
+
+	callArgs []*ChatServiceMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockMarkReadExpectation specifies expectation struct of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockMarkReadParams
+	paramPtrs          *ChatServiceMockMarkReadParamPtrs
+	expectationOrigins ChatServiceMockMarkReadExpectationOrigins
+	results            *ChatServiceMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockMarkReadParams contains parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParams struct {
+	ctx  context.Context
+	read *model.ChatRead
+}
+
+// ChatServiceMockMarkReadParamPtrs contains pointers to parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParamPtrs struct {
+	ctx  *context.Context
+	read **model.ChatRead
+}
+
+// ChatServiceMockMarkReadResults contains results of the ChatService.MarkRead
+type ChatServiceMockMarkReadResults struct {
+	err error
+}
+
+// ChatServiceMockMarkReadOrigins contains origins of expectations of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRead string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatServiceMockMarkRead) Optional() *mChatServiceMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Expect(ctx context.Context, read *model.ChatRead) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatServiceMockMarkReadParams{ctx, read}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectReadParam2 sets up expected param read for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectReadParam2(read *model.ChatRead) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.read = &read
+	mmMarkRead.defaultExpectation.expectationOrigins.originRead = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Inspect(f func(ctx context.Context, read *model.ChatRead)) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Return(err error) *ChatServiceMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatServiceMockMarkReadResults{err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatService.MarkRead method
+func (mmMarkRead *mChatServiceMockMarkRead) Set(f func(ctx context.Context, read *model.ChatRead) (err error)) *ChatServiceMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatService.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatService.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatService.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatServiceMockMarkRead) When(ctx context.Context, read *model.ChatRead) *ChatServiceMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &ChatServiceMockMarkReadParams{ctx, read},
+		expectationOrigins: ChatServiceMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockMarkReadExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockMarkReadResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.MarkRead should be invoked
+func (mmMarkRead *mChatServiceMockMarkRead) Times(n uint64) *mChatServiceMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatServiceMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatServiceMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_service.ChatService
+func (mmMarkRead *ChatServiceMock) MarkRead(ctx context.Context, read *model.ChatRead) (err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, read)
+	}
+
+	mm_params := ChatServiceMockMarkReadParams{ctx, read}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockMarkReadParams{ctx, read}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.read != nil && !minimock.Equal(*mm_want_ptrs.read, mm_got.read) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter read, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originRead, *mm_want_ptrs.read, mm_got.read, minimock.Diff(*mm_want_ptrs.read, mm_got.read))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatServiceMock.MarkRead")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, read)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatServiceMock.MarkRead. %v %v", ctx, read)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatServiceMockMarkRead) Calls() []*ChatServiceMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+type mChatServiceMockPinMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPinMessageExpectation
+	expectations       []*ChatServiceMockPinMessageExpectation
+
+	callArgs []*ChatServiceMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockPinMessageExpectation specifies expectation struct of the ChatService.PinMessage
+type ChatServiceMockPinMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockPinMessageParams
+	paramPtrs          *ChatServiceMockPinMessageParamPtrs
+	expectationOrigins ChatServiceMockPinMessageExpectationOrigins
+	results            *ChatServiceMockPinMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockPinMessageParams contains parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParams struct {
+	ctx context.Context
+	pin *model.MessagePin
+}
+
+// ChatServiceMockPinMessageParamPtrs contains pointers to parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParamPtrs struct {
+	ctx *context.Context
+	pin **model.MessagePin
+}
+
+// ChatServiceMockPinMessageResults contains results of the ChatService.PinMessage
+type ChatServiceMockPinMessageResults struct {
+	err error
+}
+
+// ChatServiceMockPinMessageOrigins contains origins of expectations of the ChatService.PinMessage
+type ChatServiceMockPinMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originPin string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mChatServiceMockPinMessage) Optional() *mChatServiceMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Expect(ctx context.Context, pin *model.MessagePin) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &ChatServiceMockPinMessageParams{ctx, pin}
+	mmPinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectPinParam2 sets up expected param pin for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectPinParam2(pin *model.MessagePin) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.pin = &pin
+	mmPinMessage.defaultExpectation.expectationOrigins.originPin = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Inspect(f func(ctx context.Context, pin *model.MessagePin)) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Return(err error) *ChatServiceMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &ChatServiceMockPinMessageResults{err}
+	mmPinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.PinMessage method
+func (mmPinMessage *mChatServiceMockPinMessage) Set(f func(ctx context.Context, pin *model.MessagePin) (err error)) *ChatServiceMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	mmPinMessage.mock.funcPinMessageOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the ChatService.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mChatServiceMockPinMessage) When(ctx context.Context, pin *model.MessagePin) *ChatServiceMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPinMessageExpectation{
+		mock:               mmPinMessage.mock,
+		params:             &ChatServiceMockPinMessageParams{ctx, pin},
+		expectationOrigins: ChatServiceMockPinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPinMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPinMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PinMessage should be invoked
+func (mmPinMessage *mChatServiceMockPinMessage) Times(n uint64) *mChatServiceMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of ChatServiceMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	mmPinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mChatServiceMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements mm_service.ChatService
+func (mmPinMessage *ChatServiceMock) PinMessage(ctx context.Context, pin *model.MessagePin) (err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	mmPinMessage.t.Helper()
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, pin)
+	}
+
+	mm_params := ChatServiceMockPinMessageParams{ctx, pin}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPinMessageParams{ctx, pin}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pin != nil && !minimock.Equal(*mm_want_ptrs.pin, mm_got.pin) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter pin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originPin, *mm_want_ptrs.pin, mm_got.pin, minimock.Diff(*mm_want_ptrs.pin, mm_got.pin))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the ChatServiceMock.PinMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, pin)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to ChatServiceMock.PinMessage. %v %v", ctx, pin)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mChatServiceMockPinMessage) Calls() []*ChatServiceMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s", m.PinMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s with params: %#v", m.PinMessageMock.defaultExpectation.expectationOrigins.origin, *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s", m.funcPinMessageOrigin)
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), m.PinMessageMock.expectedInvocationsOrigin, afterPinMessageCounter)
+	}
+}
+
+type mChatServiceMockRemoveMembers struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveMembersExpectation
+	expectations       []*ChatServiceMockRemoveMembersExpectation
+
+	callArgs []*ChatServiceMockRemoveMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRemoveMembersExpectation specifies expectation struct of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRemoveMembersParams
+	paramPtrs          *ChatServiceMockRemoveMembersParamPtrs
+	expectationOrigins ChatServiceMockRemoveMembersExpectationOrigins
+	results            *ChatServiceMockRemoveMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRemoveMembersParams contains parameters of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersParams struct {
+	ctx     context.Context
+	members *model.ChatMembers
+}
+
+// ChatServiceMockRemoveMembersParamPtrs contains pointers to parameters of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersParamPtrs struct {
+	ctx     *context.Context
+	members **model.ChatMembers
+}
+
+// ChatServiceMockRemoveMembersResults contains results of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersResults struct {
+	err error
+}
+
+// ChatServiceMockRemoveMembersOrigins contains origins of expectations of the ChatService.RemoveMembers
+type ChatServiceMockRemoveMembersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMembers string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Optional() *mChatServiceMockRemoveMembers {
+	mmRemoveMembers.optional = true
+	return mmRemoveMembers
+}
+
+// Expect sets up expected params for ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Expect(ctx context.Context, members *model.ChatMembers) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{}
+	}
+
+	if mmRemoveMembers.defaultExpectation.paramPtrs != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMembers.defaultExpectation.params = &ChatServiceMockRemoveMembersParams{ctx, members}
+	mmRemoveMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveMembers.expectations {
+		if minimock.Equal(e.params, mmRemoveMembers.defaultExpectation.params) {
+			mmRemoveMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{}
+	}
+
+	if mmRemoveMembers.defaultExpectation.params != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Expect")
+	}
+
+	if mmRemoveMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveMembers.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMembersParamPtrs{}
+	}
+	mmRemoveMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveMembers
+}
+
+// ExpectMembersParam2 sets up expected param members for ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) ExpectMembersParam2(members *model.ChatMembers) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{}
+	}
+
+	if mmRemoveMembers.defaultExpectation.params != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Expect")
+	}
+
+	if mmRemoveMembers.defaultExpectation.paramPtrs == nil {
+		mmRemoveMembers.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMembersParamPtrs{}
+	}
+	mmRemoveMembers.defaultExpectation.paramPtrs.members = &members
+	mmRemoveMembers.defaultExpectation.expectationOrigins.originMembers = minimock.CallerInfo(1)
+
+	return mmRemoveMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Inspect(f func(ctx context.Context, members *model.ChatMembers)) *mChatServiceMockRemoveMembers {
+	if mmRemoveMembers.mock.inspectFuncRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveMembers")
+	}
+
+	mmRemoveMembers.mock.inspectFuncRemoveMembers = f
+
+	return mmRemoveMembers
+}
+
+// Return sets up results that will be returned by ChatService.RemoveMembers
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Return(err error) *ChatServiceMock {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	if mmRemoveMembers.defaultExpectation == nil {
+		mmRemoveMembers.defaultExpectation = &ChatServiceMockRemoveMembersExpectation{mock: mmRemoveMembers.mock}
+	}
+	mmRemoveMembers.defaultExpectation.results = &ChatServiceMockRemoveMembersResults{err}
+	mmRemoveMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveMembers.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveMembers method
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Set(f func(ctx context.Context, members *model.ChatMembers) (err error)) *ChatServiceMock {
+	if mmRemoveMembers.defaultExpectation != nil {
+		mmRemoveMembers.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveMembers method")
+	}
+
+	if len(mmRemoveMembers.expectations) > 0 {
+		mmRemoveMembers.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveMembers method")
+	}
+
+	mmRemoveMembers.mock.funcRemoveMembers = f
+	mmRemoveMembers.mock.funcRemoveMembersOrigin = minimock.CallerInfo(1)
+	return mmRemoveMembers.mock
+}
+
+// When sets expectation for the ChatService.RemoveMembers which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) When(ctx context.Context, members *model.ChatMembers) *ChatServiceMockRemoveMembersExpectation {
+	if mmRemoveMembers.mock.funcRemoveMembers != nil {
+		mmRemoveMembers.mock.t.Fatalf("ChatServiceMock.RemoveMembers mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveMembersExpectation{
+		mock:               mmRemoveMembers.mock,
+		params:             &ChatServiceMockRemoveMembersParams{ctx, members},
+		expectationOrigins: ChatServiceMockRemoveMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveMembers.expectations = append(mmRemoveMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveMembers return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveMembersExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveMembersResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveMembers should be invoked
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Times(n uint64) *mChatServiceMockRemoveMembers {
+	if n == 0 {
+		mmRemoveMembers.mock.t.Fatalf("Times of ChatServiceMock.RemoveMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveMembers.expectedInvocations, n)
+	mmRemoveMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveMembers
+}
+
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) invocationsDone() bool {
+	if len(mmRemoveMembers.expectations) == 0 && mmRemoveMembers.defaultExpectation == nil && mmRemoveMembers.mock.funcRemoveMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveMembers.mock.afterRemoveMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveMembers implements mm_service.ChatService
+func (mmRemoveMembers *ChatServiceMock) RemoveMembers(ctx context.Context, members *model.ChatMembers) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMembers.beforeRemoveMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMembers.afterRemoveMembersCounter, 1)
+
+	mmRemoveMembers.t.Helper()
+
+	if mmRemoveMembers.inspectFuncRemoveMembers != nil {
+		mmRemoveMembers.inspectFuncRemoveMembers(ctx, members)
+	}
+
+	mm_params := ChatServiceMockRemoveMembersParams{ctx, members}
+
+	// Record call args
+	mmRemoveMembers.RemoveMembersMock.mutex.Lock()
+	mmRemoveMembers.RemoveMembersMock.callArgs = append(mmRemoveMembers.RemoveMembersMock.callArgs, &mm_params)
+	mmRemoveMembers.RemoveMembersMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMembers.RemoveMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMembers.RemoveMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMembers.RemoveMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMembers.RemoveMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMembers.RemoveMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveMembersParams{ctx, members}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMembers.t.Errorf("ChatServiceMock.RemoveMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMembers.RemoveMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.members != nil && !minimock.Equal(*mm_want_ptrs.members, mm_got.members) {
+				mmRemoveMembers.t.Errorf("ChatServiceMock.RemoveMembers got unexpected parameter members, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMembers.RemoveMembersMock.defaultExpectation.expectationOrigins.originMembers, *mm_want_ptrs.members, mm_got.members, minimock.Diff(*mm_want_ptrs.members, mm_got.members))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMembers.t.Errorf("ChatServiceMock.RemoveMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveMembers.RemoveMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMembers.RemoveMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMembers.t.Fatal("No results are set for the ChatServiceMock.RemoveMembers")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMembers.funcRemoveMembers != nil {
+		return mmRemoveMembers.funcRemoveMembers(ctx, members)
+	}
+	mmRemoveMembers.t.Fatalf("Unexpected call to ChatServiceMock.RemoveMembers. %v %v", ctx, members)
+	return
+}
+
+// RemoveMembersAfterCounter returns a count of finished ChatServiceMock.RemoveMembers invocations
+func (mmRemoveMembers *ChatServiceMock) RemoveMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMembers.afterRemoveMembersCounter)
+}
+
+// RemoveMembersBeforeCounter returns a count of ChatServiceMock.RemoveMembers invocations
+func (mmRemoveMembers *ChatServiceMock) RemoveMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMembers.beforeRemoveMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMembers *mChatServiceMockRemoveMembers) Calls() []*ChatServiceMockRemoveMembersParams {
+	mmRemoveMembers.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveMembersParams, len(mmRemoveMembers.callArgs))
+	copy(argCopy, mmRemoveMembers.callArgs)
+
+	mmRemoveMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMembersDone returns true if the count of the RemoveMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveMembersDone() bool {
+	if m.RemoveMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMembersMock.invocationsDone()
+}
+
+// MinimockRemoveMembersInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveMembersInspect() {
+	for _, e := range m.RemoveMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveMembersCounter := mm_atomic.LoadUint64(&m.afterRemoveMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMembersMock.defaultExpectation != nil && afterRemoveMembersCounter < 1 {
+		if m.RemoveMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMembers at\n%s", m.RemoveMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMembers at\n%s with params: %#v", m.RemoveMembersMock.defaultExpectation.expectationOrigins.origin, *m.RemoveMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMembers != nil && afterRemoveMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RemoveMembers at\n%s", m.funcRemoveMembersOrigin)
+	}
+
+	if !m.RemoveMembersMock.invocationsDone() && afterRemoveMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMembersMock.expectedInvocations), m.RemoveMembersMock.expectedInvocationsOrigin, afterRemoveMembersCounter)
+	}
+}
+
+type mChatServiceMockSendMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSendMessageExpectation
+	expectations       []*ChatServiceMockSendMessageExpectation
+
+	callArgs []*ChatServiceMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSendMessageParams
+	paramPtrs          *ChatServiceMockSendMessageParamPtrs
+	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
+	results            *ChatServiceMockSendMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParams struct {
+	ctx  context.Context
+	chat *model.ChatSendMessage
+}
+
+// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParamPtrs struct {
+	ctx  *context.Context
+	chat **model.ChatSendMessage
+}
+
+// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
+type ChatServiceMockSendMessageResults struct {
+	err error
+}
+
+// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, chat *model.ChatSendMessage) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, chat}
+	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectChatParam2(chat *model.ChatSendMessage) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.chat = &chat
+	mmSendMessage.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, chat *model.ChatSendMessage)) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatServiceMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{err}
+	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.SendMessage method
+func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, chat *model.ChatSendMessage) (err error)) *ChatServiceMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, chat *model.ChatSendMessage) *ChatServiceMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSendMessageExpectation{
+		mock:               mmSendMessage.mock,
+		params:             &ChatServiceMockSendMessageParams{ctx, chat},
+		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
 
-	if mmDeleteChat.defaultExpectation.params != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Expect")
+// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSendMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SendMessage should be invoked
+func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
 	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendMessage
+}
 
-	if mmDeleteChat.defaultExpectation.paramPtrs == nil {
-		mmDeleteChat.defaultExpectation.paramPtrs = &ChatServiceMockDeleteChatParamPtrs{}
+func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
 	}
-	mmDeleteChat.defaultExpectation.paramPtrs.id = &id
-	mmDeleteChat.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmDeleteChat
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockDeleteChat {
-	if mmDeleteChat.mock.inspectFuncDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteChat")
+// SendMessage implements mm_service.ChatService
+func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, chat *model.ChatSendMessage) (err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	mmSendMessage.t.Helper()
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, chat)
 	}
 
-	mmDeleteChat.mock.inspectFuncDeleteChat = f
+	mm_params := ChatServiceMockSendMessageParams{ctx, chat}
 
-	return mmDeleteChat
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSendMessageParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, chat)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, chat)
+	return
 }
 
-// Return sets up results that will be returned by ChatService.DeleteChat
-func (mmDeleteChat *mChatServiceMockDeleteChat) Return(err error) *ChatServiceMock {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmDeleteChat.defaultExpectation == nil {
-		mmDeleteChat.defaultExpectation = &ChatServiceMockDeleteChatExpectation{mock: mmDeleteChat.mock}
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
-	mmDeleteChat.defaultExpectation.results = &ChatServiceMockDeleteChatResults{err}
-	mmDeleteChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
+
+	return m.SendMessageMock.invocationsDone()
 }
 
-// Set uses given function f to mock the ChatService.DeleteChat method
-func (mmDeleteChat *mChatServiceMockDeleteChat) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
-	if mmDeleteChat.defaultExpectation != nil {
-		mmDeleteChat.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteChat method")
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if len(mmDeleteChat.expectations) > 0 {
-		mmDeleteChat.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteChat method")
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
 	}
 
-	mmDeleteChat.mock.funcDeleteChat = f
-	mmDeleteChat.mock.funcDeleteChatOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat.mock
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	}
 }
 
-// When sets expectation for the ChatService.DeleteChat which will trigger the result defined by the following
+type mChatServiceMockSetMemberRole struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSetMemberRoleExpectation
+	expectations       []*ChatServiceMockSetMemberRoleExpectation
+
+	callArgs []*ChatServiceMockSetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSetMemberRoleExpectation specifies expectation struct of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSetMemberRoleParams
+	paramPtrs          *ChatServiceMockSetMemberRoleParamPtrs
+	expectationOrigins ChatServiceMockSetMemberRoleExpectationOrigins
+	results            *ChatServiceMockSetMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSetMemberRoleParams contains parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParams struct {
+	ctx        context.Context
+	memberRole *model.ChatMemberRole
+}
+
+// ChatServiceMockSetMemberRoleParamPtrs contains pointers to parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParamPtrs struct {
+	ctx        *context.Context
+	memberRole **model.ChatMemberRole
+}
+
+// ChatServiceMockSetMemberRoleResults contains results of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleResults struct {
+	err error
+}
+
+// ChatServiceMockSetMemberRoleOrigins contains origins of expectations of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMemberRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Optional() *mChatServiceMockSetMemberRole {
+	mmSetMemberRole.optional = true
+	return mmSetMemberRole
+}
+
+// Expect sets up expected params for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Expect(ctx context.Context, memberRole *model.ChatMemberRole) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmSetMemberRole.defaultExpectation.params = &ChatServiceMockSetMemberRoleParams{ctx, memberRole}
+	mmSetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMemberRole.expectations {
+		if minimock.Equal(e.params, mmSetMemberRole.defaultExpectation.params) {
+			mmSetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectMemberRoleParam2 sets up expected param memberRole for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectMemberRoleParam2(memberRole *model.ChatMemberRole) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.memberRole = &memberRole
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originMemberRole = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Inspect(f func(ctx context.Context, memberRole *model.ChatMemberRole)) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetMemberRole")
+	}
+
+	mmSetMemberRole.mock.inspectFuncSetMemberRole = f
+
+	return mmSetMemberRole
+}
+
+// Return sets up results that will be returned by ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Return(err error) *ChatServiceMock {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{mock: mmSetMemberRole.mock}
+	}
+	mmSetMemberRole.defaultExpectation.results = &ChatServiceMockSetMemberRoleResults{err}
+	mmSetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatService.SetMemberRole method
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Set(f func(ctx context.Context, memberRole *model.ChatMemberRole) (err error)) *ChatServiceMock {
+	if mmSetMemberRole.defaultExpectation != nil {
+		mmSetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatService.SetMemberRole method")
+	}
+
+	if len(mmSetMemberRole.expectations) > 0 {
+		mmSetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatService.SetMemberRole method")
+	}
+
+	mmSetMemberRole.mock.funcSetMemberRole = f
+	mmSetMemberRole.mock.funcSetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// When sets expectation for the ChatService.SetMemberRole which will trigger the result defined by the following
 // Then helper
-func (mmDeleteChat *mChatServiceMockDeleteChat) When(ctx context.Context, id int64) *ChatServiceMockDeleteChatExpectation {
-	if mmDeleteChat.mock.funcDeleteChat != nil {
-		mmDeleteChat.mock.t.Fatalf("ChatServiceMock.DeleteChat mock is already set by Set")
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) When(ctx context.Context, memberRole *model.ChatMemberRole) *ChatServiceMockSetMemberRoleExpectation {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockDeleteChatExpectation{
-		mock:               mmDeleteChat.mock,
-		params:             &ChatServiceMockDeleteChatParams{ctx, id},
-		expectationOrigins: ChatServiceMockDeleteChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockSetMemberRoleExpectation{
+		mock:               mmSetMemberRole.mock,
+		params:             &ChatServiceMockSetMemberRoleParams{ctx, memberRole},
+		expectationOrigins: ChatServiceMockSetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteChat.expectations = append(mmDeleteChat.expectations, expectation)
+	mmSetMemberRole.expectations = append(mmSetMemberRole.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.DeleteChat return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockDeleteChatExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockDeleteChatResults{err}
+// Then sets up ChatService.SetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetMemberRoleExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetMemberRoleResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.DeleteChat should be invoked
-func (mmDeleteChat *mChatServiceMockDeleteChat) Times(n uint64) *mChatServiceMockDeleteChat {
+// Times sets number of times ChatService.SetMemberRole should be invoked
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Times(n uint64) *mChatServiceMockSetMemberRole {
 	if n == 0 {
-		mmDeleteChat.mock.t.Fatalf("Times of ChatServiceMock.DeleteChat mock can not be zero")
+		mmSetMemberRole.mock.t.Fatalf("Times of ChatServiceMock.SetMemberRole mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteChat.expectedInvocations, n)
-	mmDeleteChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteChat
+	mm_atomic.StoreUint64(&mmSetMemberRole.expectedInvocations, n)
+	mmSetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole
 }
 
-func (mmDeleteChat *mChatServiceMockDeleteChat) invocationsDone() bool {
-	if len(mmDeleteChat.expectations) == 0 && mmDeleteChat.defaultExpectation == nil && mmDeleteChat.mock.funcDeleteChat == nil {
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) invocationsDone() bool {
+	if len(mmSetMemberRole.expectations) == 0 && mmSetMemberRole.defaultExpectation == nil && mmSetMemberRole.mock.funcSetMemberRole == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChat.mock.afterDeleteChatCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChat.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.mock.afterSetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteChat implements mm_service.ChatService
-func (mmDeleteChat *ChatServiceMock) DeleteChat(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDeleteChat.beforeDeleteChatCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteChat.afterDeleteChatCounter, 1)
+// SetMemberRole implements mm_service.ChatService
+func (mmSetMemberRole *ChatServiceMock) SetMemberRole(ctx context.Context, memberRole *model.ChatMemberRole) (err error) {
+	mm_atomic.AddUint64(&mmSetMemberRole.beforeSetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMemberRole.afterSetMemberRoleCounter, 1)
 
-	mmDeleteChat.t.Helper()
+	mmSetMemberRole.t.Helper()
 
-	if mmDeleteChat.inspectFuncDeleteChat != nil {
-		mmDeleteChat.inspectFuncDeleteChat(ctx, id)
+	if mmSetMemberRole.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.inspectFuncSetMemberRole(ctx, memberRole)
 	}
 
-	mm_params := ChatServiceMockDeleteChatParams{ctx, id}
+	mm_params := ChatServiceMockSetMemberRoleParams{ctx, memberRole}
 
 	// Record call args
-	mmDeleteChat.DeleteChatMock.mutex.Lock()
-	mmDeleteChat.DeleteChatMock.callArgs = append(mmDeleteChat.DeleteChatMock.callArgs, &mm_params)
-	mmDeleteChat.DeleteChatMock.mutex.Unlock()
+	mmSetMemberRole.SetMemberRoleMock.mutex.Lock()
+	mmSetMemberRole.SetMemberRoleMock.callArgs = append(mmSetMemberRole.SetMemberRoleMock.callArgs, &mm_params)
+	mmSetMemberRole.SetMemberRoleMock.mutex.Unlock()
 
-	for _, e := range mmDeleteChat.DeleteChatMock.expectations {
+	for _, e := range mmSetMemberRole.SetMemberRoleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteChat.DeleteChatMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteChat.DeleteChatMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteChat.DeleteChatMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteChat.DeleteChatMock.defaultExpectation.paramPtrs
+	if mmSetMemberRole.SetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMemberRole.SetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockDeleteChatParams{ctx, id}
+		mm_got := ChatServiceMockSetMemberRoleParams{ctx, memberRole}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.memberRole != nil && !minimock.Equal(*mm_want_ptrs.memberRole, mm_got.memberRole) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter memberRole, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originMemberRole, *mm_want_ptrs.memberRole, mm_got.memberRole, minimock.Diff(*mm_want_ptrs.memberRole, mm_got.memberRole))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteChat.t.Errorf("ChatServiceMock.DeleteChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteChat.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteChat.DeleteChatMock.defaultExpectation.results
+		mm_results := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteChat.t.Fatal("No results are set for the ChatServiceMock.DeleteChat")
+			mmSetMemberRole.t.Fatal("No results are set for the ChatServiceMock.SetMemberRole")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteChat.funcDeleteChat != nil {
-		return mmDeleteChat.funcDeleteChat(ctx, id)
+	if mmSetMemberRole.funcSetMemberRole != nil {
+		return mmSetMemberRole.funcSetMemberRole(ctx, memberRole)
 	}
-	mmDeleteChat.t.Fatalf("Unexpected call to ChatServiceMock.DeleteChat. %v %v", ctx, id)
+	mmSetMemberRole.t.Fatalf("Unexpected call to ChatServiceMock.SetMemberRole. %v %v", ctx, memberRole)
 	return
 }
 
-// DeleteChatAfterCounter returns a count of finished ChatServiceMock.DeleteChat invocations
-func (mmDeleteChat *ChatServiceMock) DeleteChatAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.afterDeleteChatCounter)
+// SetMemberRoleAfterCounter returns a count of finished ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.afterSetMemberRoleCounter)
 }
 
-// DeleteChatBeforeCounter returns a count of ChatServiceMock.DeleteChat invocations
-func (mmDeleteChat *ChatServiceMock) DeleteChatBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteChat.beforeDeleteChatCounter)
+// SetMemberRoleBeforeCounter returns a count of ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.beforeSetMemberRoleCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteChat.
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetMemberRole.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteChat *mChatServiceMockDeleteChat) Calls() []*ChatServiceMockDeleteChatParams {
-	mmDeleteChat.mutex.RLock()
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Calls() []*ChatServiceMockSetMemberRoleParams {
+	mmSetMemberRole.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockDeleteChatParams, len(mmDeleteChat.callArgs))
-	copy(argCopy, mmDeleteChat.callArgs)
+	argCopy := make([]*ChatServiceMockSetMemberRoleParams, len(mmSetMemberRole.callArgs))
+	copy(argCopy, mmSetMemberRole.callArgs)
 
-	mmDeleteChat.mutex.RUnlock()
+	mmSetMemberRole.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteChatDone returns true if the count of the DeleteChat invocations corresponds
+// MinimockSetMemberRoleDone returns true if the count of the SetMemberRole invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockDeleteChatDone() bool {
-	if m.DeleteChatMock.optional {
+func (m *ChatServiceMock) MinimockSetMemberRoleDone() bool {
+	if m.SetMemberRoleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteChatMock.expectations {
+	for _, e := range m.SetMemberRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteChatMock.invocationsDone()
+	return m.SetMemberRoleMock.invocationsDone()
 }
 
-// MinimockDeleteChatInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockDeleteChatInspect() {
-	for _, e := range m.DeleteChatMock.expectations {
+// MinimockSetMemberRoleInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetMemberRoleInspect() {
+	for _, e := range m.SetMemberRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteChatCounter := mm_atomic.LoadUint64(&m.afterDeleteChatCounter)
+	afterSetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterSetMemberRoleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteChatMock.defaultExpectation != nil && afterDeleteChatCounter < 1 {
-		if m.DeleteChatMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.DeleteChatMock.defaultExpectation.returnOrigin)
+	if m.SetMemberRoleMock.defaultExpectation != nil && afterSetMemberRoleCounter < 1 {
+		if m.SetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s", m.SetMemberRoleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s with params: %#v", m.DeleteChatMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s with params: %#v", m.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.SetMemberRoleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteChat != nil && afterDeleteChatCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.DeleteChat at\n%s", m.funcDeleteChatOrigin)
+	if m.funcSetMemberRole != nil && afterSetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s", m.funcSetMemberRoleOrigin)
 	}
 
-	if !m.DeleteChatMock.invocationsDone() && afterDeleteChatCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteChat at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteChatMock.expectedInvocations), m.DeleteChatMock.expectedInvocationsOrigin, afterDeleteChatCounter)
+	if !m.SetMemberRoleMock.invocationsDone() && afterSetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberRoleMock.expectedInvocations), m.SetMemberRoleMock.expectedInvocationsOrigin, afterSetMemberRoleCounter)
 	}
 }
 
-type mChatServiceMockSendMessage struct {
+type mChatServiceMockTransferOwnership struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockSendMessageExpectation
-	expectations       []*ChatServiceMockSendMessageExpectation
+	defaultExpectation *ChatServiceMockTransferOwnershipExpectation
+	expectations       []*ChatServiceMockTransferOwnershipExpectation
 
-	callArgs []*ChatServiceMockSendMessageParams
+	callArgs []*ChatServiceMockTransferOwnershipParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectation struct {
+// ChatServiceMockTransferOwnershipExpectation specifies expectation struct of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockSendMessageParams
-	paramPtrs          *ChatServiceMockSendMessageParamPtrs
-	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
-	results            *ChatServiceMockSendMessageResults
+	params             *ChatServiceMockTransferOwnershipParams
+	paramPtrs          *ChatServiceMockTransferOwnershipParamPtrs
+	expectationOrigins ChatServiceMockTransferOwnershipExpectationOrigins
+	results            *ChatServiceMockTransferOwnershipResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParams struct {
-	ctx  context.Context
-	chat *model.ChatSendMessage
+// ChatServiceMockTransferOwnershipParams contains parameters of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipParams struct {
+	ctx      context.Context
+	transfer *model.ChatOwnershipTransfer
 }
 
-// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParamPtrs struct {
-	ctx  *context.Context
-	chat **model.ChatSendMessage
+// ChatServiceMockTransferOwnershipParamPtrs contains pointers to parameters of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipParamPtrs struct {
+	ctx      *context.Context
+	transfer **model.ChatOwnershipTransfer
 }
 
-// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
-type ChatServiceMockSendMessageResults struct {
+// ChatServiceMockTransferOwnershipResults contains results of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipResults struct {
 	err error
 }
 
-// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originChat string
+// ChatServiceMockTransferOwnershipOrigins contains origins of expectations of the ChatService.TransferOwnership
+type ChatServiceMockTransferOwnershipExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTransfer string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -801,292 +6470,292 @@ type ChatServiceMockSendMessageExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Optional() *mChatServiceMockTransferOwnership {
+	mmTransferOwnership.optional = true
+	return mmTransferOwnership
 }
 
-// Expect sets up expected params for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, chat *model.ChatSendMessage) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Expect(ctx context.Context, transfer *model.ChatOwnershipTransfer) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	if mmTransferOwnership.defaultExpectation.paramPtrs != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, chat}
-	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmTransferOwnership.defaultExpectation.params = &ChatServiceMockTransferOwnershipParams{ctx, transfer}
+	mmTransferOwnership.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransferOwnership.expectations {
+		if minimock.Equal(e.params, mmTransferOwnership.defaultExpectation.params) {
+			mmTransferOwnership.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferOwnership.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmTransferOwnership
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectCtxParam1(ctx context.Context) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmTransferOwnership.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransferOwnership.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmTransferOwnership
 }
 
-// ExpectChatParam2 sets up expected param chat for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectChatParam2(chat *model.ChatSendMessage) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectTransferParam2 sets up expected param transfer for ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) ExpectTransferParam2(transfer *model.ChatOwnershipTransfer) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmTransferOwnership.defaultExpectation.params != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmTransferOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferOwnership.defaultExpectation.paramPtrs = &ChatServiceMockTransferOwnershipParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.chat = &chat
-	mmSendMessage.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+	mmTransferOwnership.defaultExpectation.paramPtrs.transfer = &transfer
+	mmTransferOwnership.defaultExpectation.expectationOrigins.originTransfer = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmTransferOwnership
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, chat *model.ChatSendMessage)) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Inspect(f func(ctx context.Context, transfer *model.ChatOwnershipTransfer)) *mChatServiceMockTransferOwnership {
+	if mmTransferOwnership.mock.inspectFuncTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.TransferOwnership")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmTransferOwnership.mock.inspectFuncTransferOwnership = f
 
-	return mmSendMessage
+	return mmTransferOwnership
 }
 
-// Return sets up results that will be returned by ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatServiceMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatService.TransferOwnership
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Return(err error) *ChatServiceMock {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmTransferOwnership.defaultExpectation == nil {
+		mmTransferOwnership.defaultExpectation = &ChatServiceMockTransferOwnershipExpectation{mock: mmTransferOwnership.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{err}
-	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmTransferOwnership.defaultExpectation.results = &ChatServiceMockTransferOwnershipResults{err}
+	mmTransferOwnership.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransferOwnership.mock
 }
 
-// Set uses given function f to mock the ChatService.SendMessage method
-func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, chat *model.ChatSendMessage) (err error)) *ChatServiceMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+// Set uses given function f to mock the ChatService.TransferOwnership method
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Set(f func(ctx context.Context, transfer *model.ChatOwnershipTransfer) (err error)) *ChatServiceMock {
+	if mmTransferOwnership.defaultExpectation != nil {
+		mmTransferOwnership.mock.t.Fatalf("Default expectation is already set for the ChatService.TransferOwnership method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	if len(mmTransferOwnership.expectations) > 0 {
+		mmTransferOwnership.mock.t.Fatalf("Some expectations are already set for the ChatService.TransferOwnership method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmTransferOwnership.mock.funcTransferOwnership = f
+	mmTransferOwnership.mock.funcTransferOwnershipOrigin = minimock.CallerInfo(1)
+	return mmTransferOwnership.mock
 }
 
-// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatService.TransferOwnership which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, chat *model.ChatSendMessage) *ChatServiceMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) When(ctx context.Context, transfer *model.ChatOwnershipTransfer) *ChatServiceMockTransferOwnershipExpectation {
+	if mmTransferOwnership.mock.funcTransferOwnership != nil {
+		mmTransferOwnership.mock.t.Fatalf("ChatServiceMock.TransferOwnership mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockSendMessageExpectation{
-		mock:               mmSendMessage.mock,
-		params:             &ChatServiceMockSendMessageParams{ctx, chat},
-		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockTransferOwnershipExpectation{
+		mock:               mmTransferOwnership.mock,
+		params:             &ChatServiceMockTransferOwnershipParams{ctx, transfer},
+		expectationOrigins: ChatServiceMockTransferOwnershipExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmTransferOwnership.expectations = append(mmTransferOwnership.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockSendMessageExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockSendMessageResults{err}
+// Then sets up ChatService.TransferOwnership return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockTransferOwnershipExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockTransferOwnershipResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.SendMessage should be invoked
-func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+// Times sets number of times ChatService.TransferOwnership should be invoked
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Times(n uint64) *mChatServiceMockTransferOwnership {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+		mmTransferOwnership.mock.t.Fatalf("Times of ChatServiceMock.TransferOwnership mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmTransferOwnership.expectedInvocations, n)
+	mmTransferOwnership.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransferOwnership
 }
 
-func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) invocationsDone() bool {
+	if len(mmTransferOwnership.expectations) == 0 && mmTransferOwnership.defaultExpectation == nil && mmTransferOwnership.mock.funcTransferOwnership == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferOwnership.mock.afterTransferOwnershipCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferOwnership.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements mm_service.ChatService
-func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, chat *model.ChatSendMessage) (err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// TransferOwnership implements mm_service.ChatService
+func (mmTransferOwnership *ChatServiceMock) TransferOwnership(ctx context.Context, transfer *model.ChatOwnershipTransfer) (err error) {
+	mm_atomic.AddUint64(&mmTransferOwnership.beforeTransferOwnershipCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferOwnership.afterTransferOwnershipCounter, 1)
 
-	mmSendMessage.t.Helper()
+	mmTransferOwnership.t.Helper()
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, chat)
+	if mmTransferOwnership.inspectFuncTransferOwnership != nil {
+		mmTransferOwnership.inspectFuncTransferOwnership(ctx, transfer)
 	}
 
-	mm_params := ChatServiceMockSendMessageParams{ctx, chat}
+	mm_params := ChatServiceMockTransferOwnershipParams{ctx, transfer}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmTransferOwnership.TransferOwnershipMock.mutex.Lock()
+	mmTransferOwnership.TransferOwnershipMock.callArgs = append(mmTransferOwnership.TransferOwnershipMock.callArgs, &mm_params)
+	mmTransferOwnership.TransferOwnershipMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmTransferOwnership.TransferOwnershipMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmTransferOwnership.TransferOwnershipMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferOwnership.TransferOwnershipMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockSendMessageParams{ctx, chat}
+		mm_got := ChatServiceMockTransferOwnershipParams{ctx, transfer}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			if mm_want_ptrs.transfer != nil && !minimock.Equal(*mm_want_ptrs.transfer, mm_got.transfer) {
+				mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameter transfer, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.originTransfer, *mm_want_ptrs.transfer, mm_got.transfer, minimock.Diff(*mm_want_ptrs.transfer, mm_got.transfer))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmTransferOwnership.t.Errorf("ChatServiceMock.TransferOwnership got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransferOwnership.TransferOwnershipMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmTransferOwnership.TransferOwnershipMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+			mmTransferOwnership.t.Fatal("No results are set for the ChatServiceMock.TransferOwnership")
 		}
 		return (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, chat)
+	if mmTransferOwnership.funcTransferOwnership != nil {
+		return mmTransferOwnership.funcTransferOwnership(ctx, transfer)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, chat)
+	mmTransferOwnership.t.Fatalf("Unexpected call to ChatServiceMock.TransferOwnership. %v %v", ctx, transfer)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// TransferOwnershipAfterCounter returns a count of finished ChatServiceMock.TransferOwnership invocations
+func (mmTransferOwnership *ChatServiceMock) TransferOwnershipAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferOwnership.afterTransferOwnershipCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// TransferOwnershipBeforeCounter returns a count of ChatServiceMock.TransferOwnership invocations
+func (mmTransferOwnership *ChatServiceMock) TransferOwnershipBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferOwnership.beforeTransferOwnershipCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatServiceMock.TransferOwnership.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmTransferOwnership *mChatServiceMockTransferOwnership) Calls() []*ChatServiceMockTransferOwnershipParams {
+	mmTransferOwnership.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatServiceMockTransferOwnershipParams, len(mmTransferOwnership.callArgs))
+	copy(argCopy, mmTransferOwnership.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmTransferOwnership.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockTransferOwnershipDone returns true if the count of the TransferOwnership invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatServiceMock) MinimockTransferOwnershipDone() bool {
+	if m.TransferOwnershipMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.TransferOwnershipMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.TransferOwnershipMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockTransferOwnershipInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockTransferOwnershipInspect() {
+	for _, e := range m.TransferOwnershipMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterTransferOwnershipCounter := mm_atomic.LoadUint64(&m.afterTransferOwnershipCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+	if m.TransferOwnershipMock.defaultExpectation != nil && afterTransferOwnershipCounter < 1 {
+		if m.TransferOwnershipMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s", m.TransferOwnershipMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s with params: %#v", m.TransferOwnershipMock.defaultExpectation.expectationOrigins.origin, *m.TransferOwnershipMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	if m.funcTransferOwnership != nil && afterTransferOwnershipCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.TransferOwnership at\n%s", m.funcTransferOwnershipOrigin)
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	if !m.TransferOwnershipMock.invocationsDone() && afterTransferOwnershipCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.TransferOwnership at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferOwnershipMock.expectedInvocations), m.TransferOwnershipMock.expectedInvocationsOrigin, afterTransferOwnershipCounter)
 	}
 }
 
@@ -1094,11 +6763,43 @@ func (m *ChatServiceMock) MinimockSendMessageInspect() {
 func (m *ChatServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddMembersInspect()
+
+			m.MinimockConnectChatInspect()
+
 			m.MinimockCreateChatInspect()
 
 			m.MinimockDeleteChatInspect()
 
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockGetChatInspect()
+
+			m.MinimockGetReadReceiptsInspect()
+
+			m.MinimockLeaveChatInspect()
+
+			m.MinimockListChatsInspect()
+
+			m.MinimockListMembersInspect()
+
+			m.MinimockListMessagesInspect()
+
+			m.MinimockListThreadInspect()
+
+			m.MinimockMarkReadInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockRemoveMembersInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSetMemberRoleInspect()
+
+			m.MinimockTransferOwnershipInspect()
 		}
 	})
 }
@@ -1122,7 +6823,23 @@ func (m *ChatServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddMembersDone() &&
+		m.MinimockConnectChatDone() &&
 		m.MinimockCreateChatDone() &&
 		m.MinimockDeleteChatDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockGetChatDone() &&
+		m.MinimockGetReadReceiptsDone() &&
+		m.MinimockLeaveChatDone() &&
+		m.MinimockListChatsDone() &&
+		m.MinimockListMembersDone() &&
+		m.MinimockListMessagesDone() &&
+		m.MinimockListThreadDone() &&
+		m.MinimockMarkReadDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockRemoveMembersDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSetMemberRoleDone() &&
+		m.MinimockTransferOwnershipDone()
 }
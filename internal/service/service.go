@@ -9,6 +9,22 @@ import (
 // ChatService интерфейс описывающий сервисный слой
 type ChatService interface {
 	CreateChat(ctx context.Context, chat *model.ChatCreate) (int64, error)
-	DeleteChat(ctx context.Context, id int64) error
+	DeleteChat(ctx context.Context, chat *model.ChatDelete) error
 	SendMessage(ctx context.Context, chat *model.ChatSendMessage) error
+	ListMessages(ctx context.Context, filter *model.MessageListFilter) (*model.MessageList, error)
+	ConnectChat(ctx context.Context, chat *model.ChatConnect, send func(message *model.Message) error) error
+	AddMembers(ctx context.Context, members *model.ChatMembers) error
+	RemoveMembers(ctx context.Context, members *model.ChatMembers) error
+	LeaveChat(ctx context.Context, leave *model.ChatLeave) error
+	ListMembers(ctx context.Context, filter *model.MemberListFilter) (*model.MemberList, error)
+	SetMemberRole(ctx context.Context, memberRole *model.ChatMemberRole) error
+	TransferOwnership(ctx context.Context, transfer *model.ChatOwnershipTransfer) error
+	GetChat(ctx context.Context, id int64) (*model.Chat, error)
+	ListChats(ctx context.Context, filter *model.ChatListFilter) (*model.ChatList, error)
+	EditMessage(ctx context.Context, edit *model.MessageEdit) error
+	DeleteMessage(ctx context.Context, del *model.MessageDelete) error
+	PinMessage(ctx context.Context, pin *model.MessagePin) error
+	ListThread(ctx context.Context, filter *model.ThreadFilter) (*model.Thread, error)
+	MarkRead(ctx context.Context, read *model.ChatRead) error
+	GetReadReceipts(ctx context.Context, messageID int64) ([]*model.ReadReceipt, error)
 }
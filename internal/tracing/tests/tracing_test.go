@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/ipv02/chat-server/internal/tracing"
+)
+
+func TestInitStdoutFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.log")
+
+	shutdown, err := tracing.Init(ctx, tracing.Options{
+		ServiceName: "chat-server-test",
+		Exporter:    tracing.ExporterStdout,
+		StdoutPath:  path,
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	// Входящий W3C контекст продолжается: спан получает trace id из заголовка traceparent
+	carrier := propagation.MapCarrier{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+
+	_, span := tracing.Tracer().Start(ctx, "chat_repository.CreateChat")
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	tracing.End(span, errors.New("boom"))
+
+	require.NoError(t, shutdown(ctx))
+
+	out, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `"Name": "chat_repository.CreateChat"`)
+	require.Contains(t, string(out), `"Description": "boom"`)
+	require.Contains(t, string(out), "chat-server-test")
+}
+
+func TestInitUnknownExporter(t *testing.T) {
+	_, err := tracing.Init(context.Background(), tracing.Options{Exporter: "jaeger"})
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Экспортеры спанов
+const (
+	// ExporterNone спаны не экспортируются, но контекст трассировки передается дальше
+	ExporterNone = "none"
+	// ExporterOTLP спаны отправляются коллектору по OTLP/gRPC
+	ExporterOTLP = "otlp"
+	// ExporterStdout спаны пишутся в stdout или файл для локальной отладки
+	ExporterStdout = "stdout"
+)
+
+const instrumentationName = "github.com/ipv02/chat-server"
+
+// Options настройки трассировки
+type Options struct {
+	ServiceName string
+	Exporter    string
+	// OTLPEndpoint адрес коллектора для ExporterOTLP
+	OTLPEndpoint string
+	// StdoutPath файл для ExporterStdout, пустой путь означает stdout
+	StdoutPath string
+	// SampleRatio доля трассируемых запросов без входящего контекста трассировки
+	SampleRatio float64
+}
+
+// Init настраивает глобальный провайдер трассировки и W3C propagator.
+// Возвращает функцию, которая выгружает накопленные спаны и освобождает ресурсы экспортера
+func Init(ctx context.Context, opts Options) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if opts.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeOutput, err := newExporter(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(opts.ServiceName),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// Входящий контекст трассировки решает сам, иначе выбирается доля запросов
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if errClose := closeOutput(); err == nil {
+			err = errClose
+		}
+
+		return err
+	}, nil
+}
+
+func newExporter(ctx context.Context, opts Options) (sdktrace.SpanExporter, func() error, error) {
+	noClose := func() error { return nil }
+
+	switch opts.Exporter {
+	case ExporterOTLP:
+		exporter, err := otlptracegrpc.New(ctx,
+			otlptracegrpc.WithEndpoint(opts.OTLPEndpoint),
+			otlptracegrpc.WithInsecure(),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return exporter, noClose, nil
+	case ExporterStdout:
+		var (
+			out         io.Writer = os.Stdout
+			closeOutput           = noClose
+		)
+
+		if opts.StdoutPath != "" {
+			file, err := os.OpenFile(opts.StdoutPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			out, closeOutput = file, file.Close
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(out), stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return exporter, closeOutput, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter %q", opts.Exporter)
+	}
+}
+
+// Tracer возвращает трейсер сервиса из глобального провайдера
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End завершает спан, отмечая его ошибкой, если она есть
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,39 @@
+-- +goose Up
+-- Колонка добавляется без NOT NULL: у сообщений, сохраненных до ее появления, чата еще нет
+alter table messages add column chat_id int;
+
+-- Сообщения пользователя, который состоит ровно в одном чате, однозначно относятся к этому чату
+update messages m
+set chat_id = cu.chat_id
+from (
+    select user_id, min(chat_id) as chat_id
+    from chat_users
+    group by user_id
+    having count(*) = 1
+) cu
+where m.user_id = cu.user_id;
+
+-- Остальные сообщения отнести к чату нельзя, они переносятся в архив, а не удаляются
+create table messages_archive (
+    id int primary key,
+    user_id int not null,
+    message text not null,
+    created_at timestamp not null,
+    archived_at timestamp not null default now()
+);
+
+insert into messages_archive (id, user_id, message, created_at)
+select id, user_id, message, created_at
+from messages
+where chat_id is null;
+
+delete from messages where chat_id is null;
+
+-- +goose Down
+insert into messages (id, user_id, message, created_at)
+select id, user_id, message, created_at
+from messages_archive;
+
+drop table messages_archive;
+
+alter table messages drop column chat_id;
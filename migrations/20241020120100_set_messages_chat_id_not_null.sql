@@ -0,0 +1,11 @@
+-- +goose Up
+-- Ограничения включаются отдельным шагом, после того как все сообщения получили chat_id или ушли в архив
+alter table messages alter column chat_id set not null;
+
+alter table messages
+    add constraint messages_chat_id_fkey foreign key (chat_id) references chat (id) on delete cascade;
+
+-- +goose Down
+alter table messages drop constraint messages_chat_id_fkey;
+
+alter table messages alter column chat_id drop not null;
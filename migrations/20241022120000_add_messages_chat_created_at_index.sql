@@ -0,0 +1,5 @@
+-- +goose Up
+create index messages_chat_id_created_at_id_idx on messages (chat_id, created_at desc, id desc);
+
+-- +goose Down
+drop index messages_chat_id_created_at_id_idx;
@@ -0,0 +1,15 @@
+-- +goose Up
+alter table chat_users
+    add column role text not null default 'member'
+        check (role in ('owner', 'admin', 'member', 'read_only'));
+
+-- У существующих чатов создатель неизвестен, владельцем становится участник с наименьшим ID
+update chat_users cu
+set role = 'owner'
+where cu.user_id = (select min(user_id) from chat_users where chat_id = cu.chat_id);
+
+create unique index chat_users_owner_idx on chat_users (chat_id) where role = 'owner';
+
+-- +goose Down
+drop index chat_users_owner_idx;
+alter table chat_users drop column role;
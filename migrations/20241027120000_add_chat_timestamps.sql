@@ -0,0 +1,21 @@
+-- +goose Up
+alter table chat
+    add column created_at timestamp not null default now(),
+    add column updated_at timestamp not null default now(),
+    add column last_message_at timestamp;
+
+update chat c
+set last_message_at = (select max(m.created_at) from messages m where m.chat_id = c.id);
+
+-- Последняя активность чата: время последнего сообщения или создания, если сообщений еще нет
+create index chat_last_activity_idx on chat ((coalesce(last_message_at, created_at)) desc, id desc);
+
+create index chat_users_user_id_idx on chat_users (user_id);
+
+-- +goose Down
+drop index chat_users_user_id_idx;
+drop index chat_last_activity_idx;
+alter table chat
+    drop column last_message_at,
+    drop column updated_at,
+    drop column created_at;
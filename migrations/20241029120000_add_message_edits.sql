@@ -0,0 +1,21 @@
+-- +goose Up
+alter table messages
+    add column edited_at timestamp,
+    add column deleted_at timestamp;
+
+-- Предыдущие версии отредактированных сообщений. Удаляются вместе с текстом при мягком удалении
+-- сообщения и каскадно при удалении его строки
+create table message_edits (
+    id serial primary key,
+    message_id int not null references messages (id) on delete cascade,
+    message text not null,
+    edited_at timestamp not null default now()
+);
+
+create index message_edits_message_id_idx on message_edits (message_id);
+
+-- +goose Down
+drop table message_edits;
+alter table messages
+    drop column deleted_at,
+    drop column edited_at;
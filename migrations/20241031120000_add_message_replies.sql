@@ -0,0 +1,16 @@
+-- +goose Up
+alter table messages
+    add column reply_to_message_id int references messages (id) on delete cascade,
+    -- Счетчики ведутся только у корневых сообщений тредов
+    add column reply_count int not null default 0,
+    add column last_reply_at timestamp;
+
+create index messages_reply_to_created_at_id_idx on messages (reply_to_message_id, created_at, id)
+    where reply_to_message_id is not null;
+
+-- +goose Down
+drop index messages_reply_to_created_at_id_idx;
+alter table messages
+    drop column last_reply_at,
+    drop column reply_count,
+    drop column reply_to_message_id;
@@ -0,0 +1,24 @@
+-- +goose Up
+alter table chat_users
+    add column last_read_message_id int references messages (id) on delete set null,
+    -- Позиция последнего прочитанного сообщения в истории, сравнивается в паре с last_read_message_id
+    add column last_read_message_at timestamp,
+    add column read_at timestamp,
+    -- Поддерживается при отправке сообщений и пересчитывается при отметке о прочтении
+    add column unread_count int not null default 0;
+
+update chat_users cu
+set unread_count = (
+    select count(*)
+    from messages m
+    where m.chat_id = cu.chat_id
+      and m.user_id <> cu.user_id
+      and m.deleted_at is null
+);
+
+-- +goose Down
+alter table chat_users
+    drop column unread_count,
+    drop column read_at,
+    drop column last_read_message_at,
+    drop column last_read_message_id;
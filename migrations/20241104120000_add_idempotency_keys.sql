@@ -0,0 +1,19 @@
+-- +goose Up
+create table idempotency_keys (
+    -- Метод, для которого выдан ключ, один и тот же ключ в разных методах не пересекается
+    scope text not null,
+    user_id int not null,
+    key text not null,
+    -- ID созданной сущности, возвращается при повторе запроса
+    result_id int,
+    created_at timestamp not null default now(),
+    -- Просроченный ключ перезанимается следующим запросом с тем же ключом
+    expires_at timestamp not null,
+    primary key (scope, user_id, key)
+);
+
+-- Поддерживает периодическое удаление просроченных ключей
+create index idempotency_keys_expires_at_idx on idempotency_keys (expires_at);
+
+-- +goose Down
+drop table idempotency_keys;
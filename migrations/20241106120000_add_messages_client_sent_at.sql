@@ -0,0 +1,9 @@
+-- +goose Up
+-- Время отправки по часам клиента хранится только для справки: created_at выставляет сервер,
+-- от него считаются окно редактирования, порядок сообщений и счетчики непрочитанных
+alter table messages
+    add column client_sent_at timestamp;
+
+-- +goose Down
+alter table messages
+    drop column client_sent_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+alter table messages
+    add column pinned_at timestamp;
+
+-- +goose Down
+alter table messages
+    drop column pinned_at;
@@ -0,0 +1,10 @@
+package chat_v1
+
+import (
+	_ "embed"
+)
+
+// OpenAPIv2 документ OpenAPI v2 для REST API, сгенерированный protoc-gen-openapiv2 из chat.proto
+//
+//go:embed chat.swagger.json
+var OpenAPIv2 []byte
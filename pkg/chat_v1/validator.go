@@ -1,23 +1,36 @@
 package chat_v1
 
-import (
-	"github.com/pkg/errors"
-)
+// ValidationError ошибка валидации поля запроса
+type ValidationError struct {
+	// Field имя поля в протомодели
+	Field       string
+	Description string
+}
+
+func newValidationError(field, description string) error {
+	return &ValidationError{
+		Field:       field,
+		Description: description,
+	}
+}
+
+// Error реализует интерфейс error
+func (e *ValidationError) Error() string {
+	return "validation error: " + e.Description
+}
 
 // Validate валидация CreateChatRequest
 func (req *CreateChatRequest) Validate() error {
-	if len(req.UsersId) == 0 {
-		return errors.New("validation error: at least one user ID is required")
+	if err := validateUsersID(req.UsersId); err != nil {
+		return err
 	}
 
-	for _, userID := range req.UsersId {
-		if userID == "" {
-			return errors.New("validation error: user ID cannot be empty")
-		}
+	if req.ChatName == "" {
+		return newValidationError("chat_name", "chat name is required")
 	}
 
-	if req.ChatName == "" {
-		return errors.New("validation error: chat name is required")
+	if err := validateIdempotencyKey(req.IdempotencyKey); err != nil {
+		return err
 	}
 
 	return nil
@@ -26,7 +39,7 @@ func (req *CreateChatRequest) Validate() error {
 // Validate валидация DeleteChatRequest
 func (req *DeleteChatRequest) Validate() error {
 	if req.Id <= 0 {
-		return errors.New("validation error: id must be greater than 0")
+		return newValidationError("id", "id must be greater than 0")
 	}
 
 	return nil
@@ -34,16 +47,229 @@ func (req *DeleteChatRequest) Validate() error {
 
 // Validate валидация SendMessageRequest
 func (req *SendMessageRequest) Validate() error {
-	if req.From == "" {
-		return errors.New("validation error: sender ID is required")
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
 	}
 
 	if req.Text == "" {
-		return errors.New("validation error: message text is required")
+		return newValidationError("text", "message text is required")
 	}
 
 	if req.Timestamp == nil {
-		return errors.New("validation error: timestamp is required")
+		return newValidationError("timestamp", "timestamp is required")
+	}
+
+	if req.ReplyToMessageId < 0 {
+		return newValidationError("reply_to_message_id", "reply to message id cannot be negative")
+	}
+
+	if err := validateIdempotencyKey(req.IdempotencyKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validate валидация ListMessagesRequest
+func (req *ListMessagesRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	if req.PageSize < 0 {
+		return newValidationError("page_size", "page size cannot be negative")
+	}
+
+	if req.Before != nil && req.After != nil && !req.After.AsTime().Before(req.Before.AsTime()) {
+		return newValidationError("before", "after must be earlier than before")
+	}
+
+	return nil
+}
+
+// Validate валидация ConnectChatRequest
+func (req *ConnectChatRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate валидация AddMembersRequest
+func (req *AddMembersRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	return validateUsersID(req.UsersId)
+}
+
+// Validate валидация RemoveMembersRequest
+func (req *RemoveMembersRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	return validateUsersID(req.UsersId)
+}
+
+// Validate валидация LeaveChatRequest
+func (req *LeaveChatRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate валидация ListMembersRequest
+func (req *ListMembersRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	if req.PageSize < 0 {
+		return newValidationError("page_size", "page size cannot be negative")
+	}
+
+	return nil
+}
+
+// Validate валидация SetMemberRoleRequest
+func (req *SetMemberRoleRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	if req.MemberId == "" {
+		return newValidationError("member_id", "member ID is required")
+	}
+
+	if _, ok := Role_name[int32(req.Role)]; !ok || req.Role == Role_ROLE_UNSPECIFIED {
+		return newValidationError("role", "role is required")
+	}
+
+	return nil
+}
+
+// Validate валидация TransferOwnershipRequest
+func (req *TransferOwnershipRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	if req.NewOwnerId == "" {
+		return newValidationError("new_owner_id", "new owner ID is required")
+	}
+
+	return nil
+}
+
+// Validate валидация GetChatRequest
+func (req *GetChatRequest) Validate() error {
+	if req.Id <= 0 {
+		return newValidationError("id", "id must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate валидация ListChatsRequest
+func (req *ListChatsRequest) Validate() error {
+	if req.PageSize < 0 {
+		return newValidationError("page_size", "page size cannot be negative")
+	}
+
+	return nil
+}
+
+// Validate валидация EditMessageRequest
+func (req *EditMessageRequest) Validate() error {
+	if req.MessageId <= 0 {
+		return newValidationError("message_id", "message id must be greater than 0")
+	}
+
+	if req.Text == "" {
+		return newValidationError("text", "message text is required")
+	}
+
+	return nil
+}
+
+// Validate валидация DeleteMessageRequest
+func (req *DeleteMessageRequest) Validate() error {
+	if req.MessageId <= 0 {
+		return newValidationError("message_id", "message id must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate валидация PinMessageRequest
+func (req *PinMessageRequest) Validate() error {
+	if req.MessageId <= 0 {
+		return newValidationError("message_id", "message id must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate валидация ListThreadRequest
+func (req *ListThreadRequest) Validate() error {
+	if req.MessageId <= 0 {
+		return newValidationError("message_id", "message id must be greater than 0")
+	}
+
+	if req.PageSize < 0 {
+		return newValidationError("page_size", "page size cannot be negative")
+	}
+
+	return nil
+}
+
+// Validate валидация MarkReadRequest
+func (req *MarkReadRequest) Validate() error {
+	if req.ChatId <= 0 {
+		return newValidationError("chat_id", "chat id must be greater than 0")
+	}
+
+	if req.MessageId <= 0 {
+		return newValidationError("message_id", "message id must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate валидация GetReadReceiptsRequest
+func (req *GetReadReceiptsRequest) Validate() error {
+	if req.MessageId <= 0 {
+		return newValidationError("message_id", "message id must be greater than 0")
+	}
+
+	return nil
+}
+
+func validateUsersID(usersID []string) error {
+	if len(usersID) == 0 {
+		return newValidationError("users_id", "at least one user ID is required")
+	}
+
+	for _, userID := range usersID {
+		if userID == "" {
+			return newValidationError("users_id", "user ID cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// maxIdempotencyKeyLen ограничение длины ключа идемпотентности, UUID и подобные ключи укладываются с запасом
+const maxIdempotencyKeyLen = 128
+
+func validateIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLen {
+		return newValidationError("idempotency_key", "idempotency key is too long")
 	}
 
 	return nil